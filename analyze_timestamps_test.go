@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestAnalyzeTimestamps tests sorting, gap statistics and flagging of a mixed-format list
+func TestAnalyzeTimestamps(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	options := passageoftime.ParseOptions{Timezone: "UTC", ReferenceTime: now}
+	inputs := []string{
+		"2025-03-03 12:00",          // 0
+		"2025-03-01T12:00:00Z",      // 1
+		"March 2, 2025 12:00",       // 2
+		"1741003200",                // 3: 2025-03-03 12:00 UTC, duplicate of 0
+		"not a date",                // 4
+		"2025-03-05T12:00:00+00:00", // 5
+		"2031-01-01",                // 6: future and an outlier
+		"",                          // 7
+	}
+
+	analysis := passageoftime.AnalyzeTimestamps(inputs, options, now)
+
+	if analysis.ParsedCount != 6 || analysis.UnparseableCount != 2 {
+		t.Fatalf("parsed %d, unparseable %d, want 6 and 2", analysis.ParsedCount, analysis.UnparseableCount)
+	}
+	var order []int
+	for _, entry := range analysis.Sorted {
+		order = append(order, entry.Index)
+	}
+	if got := fmt.Sprint(order); got != "[1 2 0 3 5 6]" {
+		t.Errorf("sorted order = %s, want [1 2 0 3 5 6]", got)
+	}
+
+	if analysis.DuplicateCount != 1 || !hasFlag(analysis.Entries[3], "duplicate of index 0") {
+		t.Errorf("entry 3 flags = %v, want duplicate of index 0", analysis.Entries[3].Flags)
+	}
+	if analysis.FutureCount != 1 || !hasFlag(analysis.Entries[6], "in the future") || !hasFlag(analysis.Entries[6], "outlier") {
+		t.Errorf("entry 6 flags = %v, want future and outlier", analysis.Entries[6].Flags)
+	}
+	if analysis.OutlierCount != 1 {
+		t.Errorf("OutlierCount = %d, want 1", analysis.OutlierCount)
+	}
+
+	if analysis.MinGap != 0 || analysis.MedianGap != 24*time.Hour {
+		t.Errorf("MinGap = %v, MedianGap = %v, want 0 and 24h", analysis.MinGap, analysis.MedianGap)
+	}
+	if analysis.Sorted[analysis.MaxGapAfter].Index != 5 {
+		t.Errorf("largest gap follows index %d, want 5", analysis.Sorted[analysis.MaxGapAfter].Index)
+	}
+	if want := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC).Sub(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)); analysis.Span != want {
+		t.Errorf("Span = %v, want %v", analysis.Span, want)
+	}
+}
+
+// TestAnalyzeTimestampsFarInstants tests that instants outside the UnixNano
+// range are not mistaken for duplicates
+func TestAnalyzeTimestampsFarInstants(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	options := passageoftime.ParseOptions{Timezone: "UTC", ReferenceTime: now}
+	analysis := passageoftime.AnalyzeTimestamps([]string{
+		"1500-01-01T00:00:00Z",
+		"2000-01-01T00:00:00Z",
+		"1500-01-01T00:00:00Z",
+		"1415-06-13T00:25:26.290448384Z", // 2^64 ns before entry 1, the same UnixNano
+	}, options, now)
+
+	if analysis.ParsedCount != 4 {
+		t.Fatalf("parsed %d, want 4 (errors: %v)", analysis.ParsedCount, analysis.Entries)
+	}
+	if analysis.DuplicateCount != 1 || !hasFlag(analysis.Entries[2], "duplicate of index 0") {
+		t.Errorf("DuplicateCount = %d, entry 2 flags = %v, want one duplicate of index 0", analysis.DuplicateCount, analysis.Entries[2].Flags)
+	}
+	if hasFlag(analysis.Entries[1], "duplicate") || hasFlag(analysis.Entries[3], "duplicate") {
+		t.Errorf("entries 1 and 3 flags = %v, %v, want no duplicates", analysis.Entries[1].Flags, analysis.Entries[3].Flags)
+	}
+}
+
+func hasFlag(entry passageoftime.AnalyzedTimestamp, text string) bool {
+	for _, flag := range entry.Flags {
+		if strings.Contains(flag, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestHandleAnalyzeTimestamps tests the analyze_timestamps handler
+func TestHandleAnalyzeTimestamps(t *testing.T) {
+	args := AnalyzeTimestampsArgs{
+		Timestamps: []string{"2024-01-02 09:00", "2024-01-01 09:00", "garbage", "2024-01-04 09:00"},
+		Timezone:   "America/New_York",
+	}
+	got, err := handleAnalyzeTimestamps(context.Background(), nil, &mcp.CallToolParamsFor[AnalyzeTimestampsArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleAnalyzeTimestamps() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"earliest:2024-01-01T09:00:00-05:00", "latest:2024-01-04T09:00:00-05:00", "span:3d",
+		"median:1d 12h", "max:2d", "unparseable_count:1", "reason:unparseable:", "gap_from_previous:1d",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleAnalyzeTimestamps() = %v, want to contain %v", text, want)
+		}
+	}
+
+	if _, err := handleAnalyzeTimestamps(context.Background(), nil, &mcp.CallToolParamsFor[AnalyzeTimestampsArgs]{Arguments: AnalyzeTimestampsArgs{}}); err == nil {
+		t.Error("handleAnalyzeTimestamps() should reject an empty list")
+	}
+}
+
+// TestHandleAnalyzeTimestampsLocalTime tests that DST resolutions are reported per entry
+func TestHandleAnalyzeTimestampsLocalTime(t *testing.T) {
+	args := AnalyzeTimestampsArgs{
+		Timestamps:    []string{"2025-03-09 01:00", "2025-03-09 02:30", "2025-11-02 01:30"},
+		Timezone:      "America/New_York",
+		AmbiguousTime: "later",
+	}
+	got, err := handleAnalyzeTimestamps(context.Background(), nil, &mcp.CallToolParamsFor[AnalyzeTimestampsArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleAnalyzeTimestamps() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"nonexistent_local_time:true", "ambiguous_local_time:true",
+		"entry:timestamps[1]", "entry:timestamps[2]", "latest:2025-11-02T01:30:00-05:00",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleAnalyzeTimestamps() = %v, want to contain %v", text, want)
+		}
+	}
+	if strings.Contains(text, "entry:timestamps[0]") {
+		t.Errorf("handleAnalyzeTimestamps() = %v, want no adjustment for entry 0", text)
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestGetCalendarInfo tests week numbers, quarters and fiscal years
+func TestGetCalendarInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		t             time.Time
+		fiscalStart   time.Month
+		isoYear       int
+		isoWeek       int
+		usWeek        int
+		quarter       int
+		dayOfYear     int
+		daysInMonth   int
+		leap          bool
+		fiscalYear    int
+		fiscalQuarter int
+	}{
+		{"ISO week belongs to next year", time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC), time.October, 2025, 1, 53, 4, 365, 31, true, 2025, 1},
+		{"ISO week belongs to previous year", time.Date(2021, 1, 3, 9, 0, 0, 0, time.UTC), 0, 2020, 53, 2, 1, 3, 31, false, 2021, 1},
+		{"February in a leap year", time.Date(2028, 2, 29, 9, 0, 0, 0, time.UTC), time.April, 2028, 9, 10, 1, 60, 29, true, 2028, 4},
+		{"UK fiscal year start", time.Date(2025, 4, 6, 9, 0, 0, 0, time.UTC), time.April, 2025, 14, 15, 2, 96, 30, false, 2026, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := passageoftime.GetCalendarInfo(tt.t, tt.fiscalStart)
+			if err != nil {
+				t.Fatalf("GetCalendarInfo() error = %v", err)
+			}
+			if info.ISOYear != tt.isoYear || info.ISOWeek != tt.isoWeek {
+				t.Errorf("ISO week = %d-W%02d, want %d-W%02d", info.ISOYear, info.ISOWeek, tt.isoYear, tt.isoWeek)
+			}
+			if info.USWeek != tt.usWeek {
+				t.Errorf("USWeek = %d, want %d", info.USWeek, tt.usWeek)
+			}
+			if info.Quarter != tt.quarter || info.DayOfYear != tt.dayOfYear || info.DaysInMonth != tt.daysInMonth || info.IsLeapYear != tt.leap {
+				t.Errorf("info = %+v", info)
+			}
+			if info.FiscalYear != tt.fiscalYear || info.FiscalQuarter != tt.fiscalQuarter {
+				t.Errorf("fiscal = FY%d Q%d, want FY%d Q%d", info.FiscalYear, info.FiscalQuarter, tt.fiscalYear, tt.fiscalQuarter)
+			}
+		})
+	}
+
+	if _, err := passageoftime.GetCalendarInfo(time.Now(), 13); err == nil {
+		t.Error("GetCalendarInfo() should reject fiscal start month 13")
+	}
+}
+
+// TestFiscalYearLabel tests the fiscal year naming conventions
+func TestFiscalYearLabel(t *testing.T) {
+	tests := []struct {
+		fiscalStart time.Month
+		naming      string
+		want        string
+	}{
+		{time.October, "", "FY2026"},
+		{time.October, "end", "FY2026"},
+		{time.April, "start", "FY2025"},
+		{time.April, "span", "FY2025-26"},
+		{time.January, "start", "FY2025"},
+		{time.January, "span", "FY2025"},
+	}
+	for _, tt := range tests {
+		info, err := passageoftime.GetCalendarInfo(time.Date(2025, 11, 15, 12, 0, 0, 0, time.UTC), tt.fiscalStart)
+		if err != nil {
+			t.Fatalf("GetCalendarInfo() error = %v", err)
+		}
+		naming, err := passageoftime.ParseFiscalYearNaming(tt.naming)
+		if err != nil {
+			t.Fatalf("ParseFiscalYearNaming(%q) error = %v", tt.naming, err)
+		}
+		if got := info.FiscalYearLabel(naming); got != tt.want {
+			t.Errorf("FiscalYearLabel(%s start, %q) = %s, want %s", tt.fiscalStart, tt.naming, got, tt.want)
+		}
+	}
+
+	if _, err := passageoftime.ParseFiscalYearNaming("middle"); err == nil {
+		t.Error("ParseFiscalYearNaming() should reject an unknown convention")
+	}
+}
+
+// TestCalendarProgress tests percent elapsed of the containing periods
+func TestCalendarProgress(t *testing.T) {
+	info, err := passageoftime.GetCalendarInfo(time.Date(2025, 7, 2, 12, 0, 0, 0, time.UTC), 0)
+	if err != nil {
+		t.Fatalf("GetCalendarInfo() error = %v", err)
+	}
+
+	want := map[string]float64{
+		"day":   50,
+		"week":  (2.5 / 7) * 100, // Wednesday noon
+		"month": (1.5 / 31) * 100,
+		"year":  50, // July 2 noon is the midpoint of a 365-day year
+	}
+	for _, p := range info.Progress {
+		if w, ok := want[p.Period]; ok && math.Abs(p.Percent-w) > 1e-9 {
+			t.Errorf("%s progress = %v, want %v", p.Period, p.Percent, w)
+		}
+	}
+
+	// The spring-forward day has 23 real hours, 11 of which have passed at local noon
+	ny, _ := time.LoadLocation("America/New_York")
+	info, err = passageoftime.GetCalendarInfo(time.Date(2025, 3, 9, 12, 0, 0, 0, ny), 0)
+	if err != nil {
+		t.Fatalf("GetCalendarInfo() error = %v", err)
+	}
+	if day := info.Progress[0]; day.Period != "day" || math.Abs(day.Percent-11.0/23*100) > 1e-9 {
+		t.Errorf("DST day progress = %+v, want %v%%", day, 11.0/23*100)
+	}
+}
+
+// TestHandleCalendarInfo tests the calendar_info handler
+func TestHandleCalendarInfo(t *testing.T) {
+	params := &mcp.CallToolParamsFor[CalendarInfoArgs]{
+		Arguments: CalendarInfoArgs{
+			Timestamp:            "2025-11-15 12:00:00",
+			FiscalYearStartMonth: 10,
+			Timezone:             "America/Chicago",
+		},
+	}
+
+	got, err := handleCalendarInfo(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("handleCalendarInfo() error = %v", err)
+	}
+
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"iso_week:2025-W46", "quarter:Q4", "fiscal_year:FY2026", "fiscal_quarter:Q1", "fiscal_year_start_month:October", "day:50", "is_leap_year:false"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleCalendarInfo() = %v, want to contain %v", text, want)
+		}
+	}
+
+	params.Arguments.FiscalYearStartMonth = 4
+	params.Arguments.FiscalYearNaming = "span"
+	got, err = handleCalendarInfo(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("handleCalendarInfo() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"fiscal_year:FY2025-26", "fiscal_year_naming:span", "fiscal_quarter:Q3"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleCalendarInfo() = %v, want to contain %v", text, want)
+		}
+	}
+}
@@ -2,19 +2,55 @@ package main
 
 import (
 	"archive/zip"
+	"bufio"
 	"flag"
 	"fmt"
+	"go/format"
+	"math"
 	"os"
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
 func main() {
 	var outputFile = flag.String("output", "", "Output file for generated timezone function")
+	var linksOutput = flag.String("links-output", "", "Output file for generated timezone links (aliases)")
+	var tzdataFile = flag.String("tzdata", "/usr/share/zoneinfo/tzdata.zi", "tzdata.zi file to read links and the tz version from")
+	var zonetabOutput = flag.String("zonetab-output", "", "Output file for generated zone locations (countries, coordinates, comments) and country names")
+	var zoneinfoDir = flag.String("zoneinfo", "/usr/share/zoneinfo", "Directory holding zone.tab, zone1970.tab and iso3166.tab")
 	flag.Parse()
+
+	if *zonetabOutput != "" {
+		data, err := extractZoneTab(*zoneinfoDir)
+		if err != nil {
+			fmt.Printf("❌ Failed to read zone tables: %v\n", err)
+			os.Exit(1)
+		}
+		if err := generateZoneTabFile(*zonetabOutput, *zoneinfoDir, data); err != nil {
+			fmt.Printf("❌ Failed to generate zone tables: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Generated %d zones and %d countries in %s\n", len(data.zones), len(data.countryNames), *zonetabOutput)
+		return
+	}
+
+	if *linksOutput != "" {
+		links, version, err := extractLinksFromTzdata(*tzdataFile)
+		if err != nil {
+			fmt.Printf("❌ Failed to extract timezone links: %v\n", err)
+			os.Exit(1)
+		}
+		if err := generateLinksFile(*linksOutput, *tzdataFile, version, links); err != nil {
+			fmt.Printf("❌ Failed to generate timezone links: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ Generated %d timezone links (tzdata %s) in %s\n", len(links), version, *linksOutput)
+		return
+	}
 	
 	fmt.Println("🌍 Go Timezone Database Extractor")
 	fmt.Println("===================================")
@@ -347,4 +383,275 @@ func generateStandaloneFunction(outputFile string, timezones []string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+// extractLinksFromTzdata reads the "L target alias" lines and the "# version"
+// header of a tzdata.zi file (the compact zic input installed with the tz database)
+func extractLinksFromTzdata(path string) (map[string]string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	links := make(map[string]string)
+	version := "unknown"
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "# version ") {
+			version = strings.TrimSpace(strings.TrimPrefix(line, "# version "))
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 3 && fields[0] == "L" {
+			links[fields[2]] = fields[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(links) == 0 {
+		return nil, "", fmt.Errorf("no links found in %s", path)
+	}
+	return links, version, nil
+}
+
+// generateLinksFile writes the timezone links as a map in the internal package
+func generateLinksFile(outputFile, source, version string, links map[string]string) error {
+	aliases := make([]string, 0, len(links))
+	for alias := range links {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var content strings.Builder
+	content.WriteString("// Code generated by go:generate; DO NOT EDIT.\n")
+	content.WriteString("// This file contains timezone links (aliases) from the tz database\n")
+	content.WriteString(fmt.Sprintf("// Source: %s\n", filepath.ToSlash(source)))
+	content.WriteString(fmt.Sprintf("// Generated on: %s\n", time.Now().Format(time.RFC3339)))
+	content.WriteString(fmt.Sprintf("// Total links: %d\n\n", len(aliases)))
+	content.WriteString("package internal\n\n")
+
+	content.WriteString("// TzdataLinksVersion is the tz database release the links were read from\n")
+	content.WriteString(fmt.Sprintf("const TzdataLinksVersion = %q\n\n", version))
+
+	content.WriteString("// timezoneLinks maps each alias to the zone it links to\n")
+	content.WriteString("var timezoneLinks = map[string]string{\n")
+	for _, alias := range aliases {
+		content.WriteString(fmt.Sprintf("\t%q: %q,\n", alias, links[alias]))
+	}
+	content.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(content.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", outputFile, err)
+	}
+	if err := os.WriteFile(outputFile, formatted, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFile, err)
+	}
+	return nil
+}
+
+// zoneLocation is a zone's row in zone.tab / zone1970.tab
+type zoneLocation struct {
+	countries []string
+	latitude  float64
+	longitude float64
+	comment   string
+}
+
+// zoneTab is the country and location data read from the tz database's .tab files
+type zoneTab struct {
+	countryNames map[string]string
+	zones        map[string]*zoneLocation
+}
+
+// extractZoneTab reads iso3166.tab for country names, then zone.tab and
+// zone1970.tab for each zone's countries, coordinates and comment. zone.tab's
+// single country, coordinates and comment come first; zone1970.tab adds the
+// other countries sharing the zone and fills in missing comments.
+func extractZoneTab(dir string) (*zoneTab, error) {
+	data := &zoneTab{countryNames: make(map[string]string), zones: make(map[string]*zoneLocation)}
+
+	err := readTabFile(filepath.Join(dir, "iso3166.tab"), func(fields []string) error {
+		if len(fields) >= 2 {
+			data.countryNames[fields[0]] = fields[1]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range []string{"zone.tab", "zone1970.tab"} {
+		err := readTabFile(filepath.Join(dir, name), func(fields []string) error {
+			if len(fields) < 3 {
+				return nil
+			}
+			location, ok := data.zones[fields[2]]
+			if !ok {
+				latitude, longitude, err := parseISO6709(fields[1])
+				if err != nil {
+					return fmt.Errorf("%s: %w", fields[2], err)
+				}
+				location = &zoneLocation{latitude: latitude, longitude: longitude}
+				data.zones[fields[2]] = location
+			}
+			for _, code := range strings.Split(fields[0], ",") {
+				if !containsString(location.countries, code) {
+					location.countries = append(location.countries, code)
+				}
+			}
+			if location.comment == "" && len(fields) >= 4 {
+				location.comment = fields[3]
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(data.countryNames) == 0 || len(data.zones) == 0 {
+		return nil, fmt.Errorf("no zones or countries found in %s", dir)
+	}
+	return data, nil
+}
+
+// parseISO6709 converts zone.tab coordinates, ±DDMM±DDDMM or
+// ±DDMMSS±DDDMMSS, to decimal degrees
+func parseISO6709(coordinates string) (float64, float64, error) {
+	split := strings.IndexAny(coordinates[1:], "+-") + 1
+	if split == 0 {
+		return 0, 0, fmt.Errorf("invalid coordinates %q", coordinates)
+	}
+	latitude, err := parseISO6709Part(coordinates[:split], 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	longitude, err := parseISO6709Part(coordinates[split:], 3)
+	if err != nil {
+		return 0, 0, err
+	}
+	return latitude, longitude, nil
+}
+
+// parseISO6709Part converts one signed angle with degreeDigits digits of
+// degrees followed by minutes and optional seconds
+func parseISO6709Part(part string, degreeDigits int) (float64, error) {
+	digits := part[1:]
+	if len(digits) != degreeDigits+2 && len(digits) != degreeDigits+4 {
+		return 0, fmt.Errorf("invalid coordinate %q", part)
+	}
+	var value float64
+	scale := 1.0
+	for i := 0; i < len(digits); {
+		width := 2
+		if i == 0 {
+			width = degreeDigits
+		}
+		n, err := strconv.Atoi(digits[i : i+width])
+		if err != nil {
+			return 0, fmt.Errorf("invalid coordinate %q", part)
+		}
+		value += float64(n) / scale
+		scale *= 60
+		i += width
+	}
+	if part[0] == '-' {
+		value = -value
+	}
+	return math.Round(value*1e4) / 1e4, nil
+}
+
+// readTabFile calls fn with the tab-separated fields of each non-comment line
+func readTabFile(path string, fn func(fields []string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := fn(strings.Split(line, "\t")); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
+
+// containsString reports whether list holds s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// generateZoneTabFile writes the zone locations and country names as maps in
+// the internal package
+func generateZoneTabFile(outputFile, source string, data *zoneTab) error {
+	codes := make([]string, 0, len(data.countryNames))
+	for code := range data.countryNames {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	zones := make([]string, 0, len(data.zones))
+	for zone := range data.zones {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	var content strings.Builder
+	content.WriteString("// Code generated by go:generate; DO NOT EDIT.\n")
+	content.WriteString("// This file contains zone locations and country names from the tz database\n")
+	content.WriteString(fmt.Sprintf("// Source: %s/{zone.tab,zone1970.tab,iso3166.tab}\n", filepath.ToSlash(source)))
+	content.WriteString(fmt.Sprintf("// Generated on: %s\n", time.Now().Format(time.RFC3339)))
+	content.WriteString(fmt.Sprintf("// Total zones: %d, countries: %d\n\n", len(zones), len(codes)))
+	content.WriteString("package internal\n\n")
+
+	content.WriteString("// countryNames maps ISO 3166 alpha-2 codes to the names used by the tz database\n")
+	content.WriteString("var countryNames = map[string]string{\n")
+	for _, code := range codes {
+		content.WriteString(fmt.Sprintf("\t%q: %q,\n", code, data.countryNames[code]))
+	}
+	content.WriteString("}\n\n")
+
+	content.WriteString("// zoneLocations holds each zone's countries (its zone.tab country first),\n")
+	content.WriteString("// representative coordinates and tz comment\n")
+	content.WriteString("var zoneLocations = map[string]ZoneLocation{\n")
+	for _, zone := range zones {
+		location := data.zones[zone]
+		quoted := make([]string, len(location.countries))
+		for i, code := range location.countries {
+			quoted[i] = fmt.Sprintf("%q", code)
+		}
+		content.WriteString(fmt.Sprintf("\t%q: {CountryCodes: []string{%s}, Latitude: %s, Longitude: %s", zone, strings.Join(quoted, ", "),
+			strconv.FormatFloat(location.latitude, 'f', -1, 64), strconv.FormatFloat(location.longitude, 'f', -1, 64)))
+		if location.comment != "" {
+			content.WriteString(fmt.Sprintf(", Comment: %q", location.comment))
+		}
+		content.WriteString("},\n")
+	}
+	content.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(content.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", outputFile, err)
+	}
+	if err := os.WriteFile(outputFile, formatted, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outputFile, err)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"encoding/xml"
+	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"net/http"
 	"os"
@@ -22,8 +24,10 @@ type WindowsZones struct {
 }
 
 type MapTimezones struct {
-	XMLName  xml.Name  `xml:"mapTimezones"`
-	MapZones []MapZone `xml:"mapZone"`
+	XMLName      xml.Name  `xml:"mapTimezones"`
+	OtherVersion string    `xml:"otherVersion,attr"` // Windows time zone data version
+	TypeVersion  string    `xml:"typeVersion,attr"`  // tz database version
+	MapZones     []MapZone `xml:"mapZone"`
 }
 
 type MapZone struct {
@@ -33,34 +37,31 @@ type MapZone struct {
 	Type      string   `xml:"type,attr"`      // IANA timezone(s)
 }
 
-const cldrURL = "https://raw.githubusercontent.com/unicode-org/cldr/refs/heads/main/common/supplemental/windowsZones.xml"
+// cldrURL locates windowsZones.xml for a CLDR git ref (a release tag such as
+// release-46, a commit, or main)
+const cldrURL = "https://raw.githubusercontent.com/unicode-org/cldr/%s/common/supplemental/windowsZones.xml"
 
 func main() {
+	var inputFile = flag.String("input", "", "Local windowsZones.xml to read instead of downloading it from CLDR")
+	var outputFile = flag.String("output", "windows_mapping.go", "Output file for the generated mapping")
+	var release = flag.String("release", "main", "CLDR release tag or commit to download, or that -input was taken from")
+	flag.Parse()
+
 	fmt.Println("🌍 Unicode CLDR Windows Timezone Mapping Generator")
 	fmt.Println("=================================================")
 	
-	// Download the XML file
-	fmt.Printf("📥 Downloading: %s\n", cldrURL)
-	resp, err := http.Get(cldrURL)
-	if err != nil {
-		fmt.Printf("❌ Failed to download: %v\n", err)
-		os.Exit(1)
+	url := fmt.Sprintf(cldrURL, *release)
+	source := url
+	if *inputFile != "" {
+		source = *inputFile
 	}
-	defer resp.Body.Close()
-	
-	if resp.StatusCode != 200 {
-		fmt.Printf("❌ HTTP error: %d %s\n", resp.StatusCode, resp.Status)
-		os.Exit(1)
-	}
-	
-	// Read the XML content
-	xmlData, err := io.ReadAll(resp.Body)
+	xmlData, err := readWindowsZones(source)
 	if err != nil {
-		fmt.Printf("❌ Failed to read response: %v\n", err)
+		fmt.Printf("❌ %v\n", err)
 		os.Exit(1)
 	}
 	
-	fmt.Printf("✅ Downloaded %d bytes\n", len(xmlData))
+	fmt.Printf("✅ Read %d bytes\n", len(xmlData))
 	
 	// Parse the XML
 	var data SupplementalData
@@ -153,25 +154,63 @@ func main() {
 	fmt.Printf("\n✅ Ready to generate Go mapping file with %d timezone mappings\n", len(mappings))
 	
 	// Generate the Go source file
-	fmt.Println("\n📝 Generating windows_timezone_mapping.go...")
-	err = generateGoFile(mappings)
+	fmt.Printf("\n📝 Generating %s...\n", *outputFile)
+	err = generateGoFile(*outputFile, *release, url, data.WindowsZones.MapTimezones, mappings)
 	if err != nil {
 		fmt.Printf("❌ Failed to generate Go file: %v\n", err)
 		os.Exit(1)
 	}
 	
-	fmt.Println("✅ Successfully generated windows_timezone_mapping.go")
+	fmt.Printf("✅ Successfully generated %s\n", *outputFile)
+}
+
+// readWindowsZones reads windowsZones.xml from a local file, or downloads it
+// when source is a URL
+func readWindowsZones(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "https://") && !strings.HasPrefix(source, "http://") {
+		fmt.Printf("📁 Reading: %s\n", source)
+		xmlData, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		return xmlData, nil
+	}
+
+	fmt.Printf("📥 Downloading: %s\n", source)
+	resp, err := http.Get(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("HTTP error: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	xmlData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return xmlData, nil
 }
 
-func generateGoFile(mappings map[string]string) error {
+// generateGoFile writes the global WindowsToIANA map and every territory's
+// mapping, in CLDR order, to the internal package. The header records the
+// CLDR release and data versions so the file can be regenerated exactly.
+func generateGoFile(outputFile, release, url string, data MapTimezones, mappings map[string]string) error {
+	zones := data.MapZones
 	// Create the Go source file content
 	var content strings.Builder
 	
 	// File header
 	content.WriteString("// Code generated by generate-timezone-mapping tool. DO NOT EDIT.\n")
 	content.WriteString("// Generated from Unicode CLDR windowsZones.xml\n")
-	content.WriteString("// Source: https://raw.githubusercontent.com/unicode-org/cldr/refs/heads/main/common/supplemental/windowsZones.xml\n")
-	content.WriteString("\npackage main\n\n")
+	content.WriteString(fmt.Sprintf("// CLDR release: %s\n", release))
+	content.WriteString(fmt.Sprintf("// Source: %s\n", url))
+	if data.TypeVersion != "" || data.OtherVersion != "" {
+		content.WriteString(fmt.Sprintf("// Data versions: tz %s, Windows %s\n", data.TypeVersion, data.OtherVersion))
+	}
+	content.WriteString("\npackage internal\n\n")
 	
 	// Documentation
 	content.WriteString("// WindowsToIANA maps Windows timezone names to IANA timezone identifiers.\n")
@@ -181,7 +220,7 @@ func generateGoFile(mappings map[string]string) error {
 	content.WriteString("// Examples:\n")
 	content.WriteString("//   \"Eastern Standard Time\"     -> \"America/New_York\"\n")
 	content.WriteString("//   \"Pacific Standard Time\"     -> \"America/Los_Angeles\"\n")
-	content.WriteString("//   \"Central European Time\"     -> \"Europe/Berlin\"\n")
+	content.WriteString("//   \"W. Europe Standard Time\"   -> \"Europe/Berlin\"\n")
 	content.WriteString("var WindowsToIANA = map[string]string{\n")
 	
 	// Sort the keys for consistent output
@@ -199,6 +238,21 @@ func generateGoFile(mappings map[string]string) error {
 	
 	content.WriteString("}\n")
 	
+	// Territory-aware mapping, keeping CLDR's order so each Windows zone's
+	// "001" entry comes before its territories
+	content.WriteString("\n// WindowsZoneMappings holds every CLDR mapZone entry: for each Windows zone,\n")
+	content.WriteString("// the default (territory \"001\") zone, the zones used in each ISO 3166\n")
+	content.WriteString("// territory, and the fixed-offset Etc zones (territory \"ZZ\").\n")
+	content.WriteString("var WindowsZoneMappings = []WindowsZoneMapping{\n")
+	for _, zone := range zones {
+		quoted := make([]string, 0)
+		for _, iana := range strings.Fields(zone.Type) {
+			quoted = append(quoted, fmt.Sprintf("%q", iana))
+		}
+		content.WriteString(fmt.Sprintf("\t{%q, %q, []string{%s}},\n", zone.Other, zone.Territory, strings.Join(quoted, ", ")))
+	}
+	content.WriteString("}\n")
+	
 	// Additional helper function
 	content.WriteString("\n// GetIANATimezone converts a Windows timezone name to IANA timezone.\n")
 	content.WriteString("// Returns the IANA timezone and true if found, empty string and false if not found.\n")
@@ -209,15 +263,19 @@ func generateGoFile(mappings map[string]string) error {
 	
 	// Statistics comment
 	content.WriteString(fmt.Sprintf("\n// Generated with %d Windows timezone mappings\n", len(mappings)))
-	content.WriteString(fmt.Sprintf("// Total CLDR mappings processed: %d\n", len(mappings)))
+	content.WriteString(fmt.Sprintf("// Total CLDR mappings processed: %d\n", len(zones)))
+	
+	formatted, err := format.Source([]byte(content.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", outputFile, err)
+	}
 	
 	// Write to file
-	outputPath := "windows_timezone_mapping.go"
-	err := os.WriteFile(outputPath, []byte(content.String()), 0644)
+	err = os.WriteFile(outputFile, formatted, 0644)
 	if err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 	
-	fmt.Printf("📄 Generated file: %s (%d bytes)\n", outputPath, len(content.String()))
+	fmt.Printf("📄 Generated file: %s (%d bytes)\n", outputFile, len(formatted))
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/calendars"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestCalendarConversions tests parsing dates in each calendar and converting them to Gregorian and back
+func TestCalendarConversions(t *testing.T) {
+	tests := []struct {
+		calendar  string
+		input     string
+		gregorian string
+		formatted string
+	}{
+		{"persian", "1404/01/01", "2025-03-21", "1 Farvardin 1404 AP"},
+		{"jalali", "30 Esfand 1403", "2025-03-20", "30 Esfand 1403 AP"}, // 1403 is a leap year
+		{"solar_hijri", "۱۳۵۷/۱۱/۲۲", "1979-02-11", "22 Bahman 1357 AP"},
+		{"buddhist", "2568-03-15", "2025-03-15", "15 March 2568 BE"},
+		{"roc", "民國114年3月15日", "2025-03-15", "15 March ROC 114"},
+		{"minguo", "ROC 1/1/1", "1912-01-01", "1 January ROC 1"},
+		{"japanese", "令和7年3月15日", "2025-03-15", "15 March Reiwa 7 (令和7年3月15日)"},
+		{"japanese", "R1.5.1", "2019-05-01", "1 May Reiwa 1 (令和元年5月1日)"},
+		{"japanese", "7 January Showa 64", "1989-01-07", "7 January Showa 64 (昭和64年1月7日)"},
+		{"ethiopian", "29 Tahsas 2017", "2025-01-07", "29 Tahsas 2017 EC"},
+		{"ethiopian", "2015-13-06", "2023-09-11", "6 Pagume 2015 EC"},
+		{"coptic", "1742-01-01", "2025-09-11", "1 Thout 1742 AM"},
+		{"hebrew", "1 Tishri 5786", "2025-09-23", "1 Tishri 5786 AM"},
+		{"jewish", "14 Adar II 5784", "2024-03-24", "14 Adar II 5784 AM"},
+		{"hebrew", "5785-07-15", "2025-04-13", "15 Nisan 5785 AM"}, // months count from Tishri
+		{"hebrew", "25 Kislev 5786", "2025-12-15", "25 Kislev 5786 AM"},
+		{"islamic", "1 Ramadan 1446", "2025-03-01", "1 Ramadan 1446 AH"},
+		{"hijri", "1 Shawwal 1446", "2025-03-30", "1 Shawwal 1446 AH"},
+		{"umm_al_qura", "Dhul-Hijjah 10, 1446", "2025-06-06", "10 Dhu al-Hijjah 1446 AH"},
+		{"islamic_umalqura", "1 Muharram 1447", "2025-06-26", "1 Muharram 1447 AH"},
+		{"islamic_umalqura", "1444-10-01", "2023-04-21", "1 Shawwal 1444 AH"},
+		{"chinese", "2025-01-01", "2025-01-29", "1 Zhengyue 2025 (Yisi, Year of the Snake)"},
+		{"lunar", "2025-L06-01", "2025-07-25", "1 Leap Liuyue 2025 (Yisi, Year of the Snake)"},
+		{"chinese", "2023年闰2月1日", "2023-03-22", "1 Leap Eryue 2023 (Guimao, Year of the Rabbit)"},
+		{"chinese", "15 Bayue 2025", "2025-10-06", "15 Bayue 2025 (Yisi, Year of the Snake)"},
+		{"chinese", "2033-L11-01", "2033-12-22", "1 Leap Dongyue 2033 (Guichou, Year of the Ox)"}, // leap month after the solstice
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.calendar+" "+tt.input, func(t *testing.T) {
+			cal, err := calendars.Get(tt.calendar)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			date, err := cal.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			jdn, err := cal.ToJDN(date)
+			if err != nil {
+				t.Fatalf("ToJDN(%v) error = %v", date, err)
+			}
+			y, m, d := calendars.JDNToGregorian(jdn)
+			if got := fmt.Sprintf("%04d-%02d-%02d", y, m, d); got != tt.gregorian {
+				t.Errorf("%s = %s, want %s", tt.input, got, tt.gregorian)
+			}
+
+			back, err := cal.FromJDN(calendars.GregorianToJDN(y, m, d))
+			if err != nil {
+				t.Fatalf("FromJDN() error = %v", err)
+			}
+			if got := cal.Format(back); got != tt.formatted {
+				t.Errorf("Format() = %s, want %s", got, tt.formatted)
+			}
+		})
+	}
+}
+
+// TestCalendarRoundTrip converts a day a week from 1900 to 2100 through each calendar and back
+func TestCalendarRoundTrip(t *testing.T) {
+	start := calendars.GregorianToJDN(1900, 1, 1)
+	end := calendars.GregorianToJDN(2100, 1, 1)
+	for _, id := range calendars.IDs() {
+		cal, _ := calendars.Get(id)
+		for jdn := start; jdn < end; jdn += 7 {
+			date, err := cal.FromJDN(jdn)
+			if err != nil {
+				continue // before the calendar's epoch (ROC, Japanese)
+			}
+			back, err := cal.ToJDN(date)
+			if err != nil || back != jdn {
+				t.Fatalf("%s: JDN %d -> %v -> %d (%v)", id, jdn, date, back, err)
+			}
+		}
+	}
+}
+
+// TestCalendarInvalidDates tests rejection of dates that do not exist
+func TestCalendarInvalidDates(t *testing.T) {
+	tests := []struct {
+		calendar string
+		input    string
+	}{
+		{"persian", "1404/12/30"}, // 1404 is not a leap year
+		{"japanese", "Showa 64-03-15"},
+		{"japanese", "Meiji 5-01-01"}, // before the Gregorian calendar was adopted
+		{"ethiopian", "2016-13-06"},
+		{"gregorian", "2025-02-29"},
+		{"hebrew", "14 Adar II 5785"}, // 5785 is not a leap year
+		{"chinese", "2024-L06-01"},    // 2024 has no leap month
+		{"chinese", "2025-L05-01"},    // 2025's leap month is the sixth
+		{"islamic", "1446-09-31"},
+	}
+
+	for _, tt := range tests {
+		cal, _ := calendars.Get(tt.calendar)
+		date, err := cal.Parse(tt.input)
+		if err != nil {
+			continue
+		}
+		if _, err := cal.ToJDN(date); err == nil {
+			t.Errorf("%s %q should be invalid", tt.calendar, tt.input)
+		}
+	}
+
+	if _, err := calendars.Get("klingon"); err == nil {
+		t.Error("Get() should reject unknown calendars")
+	}
+}
+
+// TestHandleConvertCalendar tests the convert_calendar handler
+func TestHandleConvertCalendar(t *testing.T) {
+	tests := []struct {
+		name string
+		args ConvertCalendarArgs
+		want []string
+	}{
+		{
+			name: "Gregorian to all",
+			args: ConvertCalendarArgs{Date: "2025-03-21"},
+			want: []string{"gregorian:2025-03-21", "formatted:1 Farvardin 1404 AP", "formatted:21 March Reiwa 7", "day_of_week:Friday"},
+		},
+		{
+			name: "era year",
+			args: ConvertCalendarArgs{Date: "Reiwa 1", From: "japanese"},
+			want: []string{"gregorian_start:2019-05-01", "gregorian_end:2019-12-31"},
+		},
+		{
+			name: "Persian year",
+			args: ConvertCalendarArgs{Date: "1404", From: "persian"},
+			want: []string{"gregorian_start:2025-03-21", "gregorian_end:2026-03-20", "days:365"},
+		},
+		{
+			name: "Persian date to selected calendars",
+			args: ConvertCalendarArgs{Date: "1404/01/01", From: "solar hijri", To: []string{"gregorian", "ethiopian"}},
+			want: []string{"gregorian:2025-03-21", "formatted:12 Megabit 2017 EC"},
+		},
+		{
+			name: "outside a calendar's range",
+			args: ConvertCalendarArgs{Date: "1850-06-01", To: []string{"japanese"}},
+			want: []string{"error:Japanese era dates are supported from 1 January 1873"},
+		},
+		{
+			name: "Gregorian to lunar calendars",
+			args: ConvertCalendarArgs{Date: "2025-03-30", To: []string{"hijri", "hebrew", "chinese"}},
+			want: []string{"formatted:1 Shawwal 1446 AH", "formatted:1 Nisan 5785 AM", "formatted:2 Sanyue 2025"},
+		},
+		{
+			name: "midnight skipped by a DST gap",
+			args: ConvertCalendarArgs{Date: "2025-09-07 00:00", Timezone: "America/Santiago", NonexistentTime: "shift_back", To: []string{"gregorian"}},
+			want: []string{"nonexistent_local_time:true", "gregorian:2025-09-06", "day_of_week:Saturday"},
+		},
+		{
+			name: "Chinese year",
+			args: ConvertCalendarArgs{Date: "2025", From: "chinese"},
+			want: []string{"gregorian_start:2025-01-29", "gregorian_end:2026-02-16", "days:384"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handleConvertCalendar(context.Background(), nil, &mcp.CallToolParamsFor[ConvertCalendarArgs]{Arguments: tt.args})
+			if err != nil {
+				t.Fatalf("handleConvertCalendar() error = %v", err)
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleConvertCalendar() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestConvertTimezones tests offsets, abbreviations and day shifts
+func TestConvertTimezones(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	instant := time.Date(2025, 6, 10, 21, 30, 0, 0, newYork)
+
+	rows, err := passageoftime.ConvertTimezones(instant, []string{"America/Los_Angeles", "Europe/Berlin", "Asia/Kolkata", "Pacific/Honolulu"}, newYork)
+	if err != nil {
+		t.Fatalf("ConvertTimezones() error = %v", err)
+	}
+
+	tests := []struct {
+		zone     string
+		local    string
+		offset   string
+		abbr     string
+		dayShift int
+	}{
+		{"America/Los_Angeles", "2025-06-10 18:30", "-07:00", "PDT", 0},
+		{"Europe/Berlin", "2025-06-11 03:30", "+02:00", "CEST", 1},
+		{"Asia/Kolkata", "2025-06-11 07:00", "+05:30", "IST", 1},
+		{"Pacific/Honolulu", "2025-06-10 15:30", "-10:00", "HST", 0},
+	}
+
+	for i, tt := range tests {
+		row := rows[i]
+		if row.Timezone != tt.zone {
+			t.Fatalf("row %d timezone = %s, want %s", i, row.Timezone, tt.zone)
+		}
+		if got := row.Local.Format("2006-01-02 15:04"); got != tt.local {
+			t.Errorf("%s local = %s, want %s", tt.zone, got, tt.local)
+		}
+		if row.OffsetString != tt.offset || row.Abbreviation != tt.abbr || row.DayShift != tt.dayShift {
+			t.Errorf("%s = %s %s shift %d, want %s %s shift %d", tt.zone, row.OffsetString, row.Abbreviation, row.DayShift, tt.offset, tt.abbr, tt.dayShift)
+		}
+	}
+
+	// Reference in Tokyo: New York is the previous day
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	rows, err = passageoftime.ConvertTimezones(instant, []string{"America/New_York"}, tokyo)
+	if err != nil {
+		t.Fatalf("ConvertTimezones() error = %v", err)
+	}
+	if rows[0].DayShiftLabel() != "previous day" {
+		t.Errorf("DayShiftLabel() = %s, want previous day", rows[0].DayShiftLabel())
+	}
+
+	// Kiritimati (UTC+14) is 25 hours ahead of Pago Pago (UTC-11)
+	pagoPago, _ := time.LoadLocation("Pacific/Pago_Pago")
+	rows, err = passageoftime.ConvertTimezones(time.Date(2025, 6, 10, 23, 30, 0, 0, pagoPago), []string{"Pacific/Kiritimati"}, pagoPago)
+	if err != nil {
+		t.Fatalf("ConvertTimezones() error = %v", err)
+	}
+	if rows[0].DayShift != 2 || rows[0].DayShiftLabel() != "+2 days" {
+		t.Errorf("Kiritimati shift = %d (%s), want 2 (+2 days)", rows[0].DayShift, rows[0].DayShiftLabel())
+	}
+	if table := passageoftime.RenderZoneTable(rows); !strings.Contains(table, "+2") {
+		t.Errorf("RenderZoneTable() = %q, want a +2 day column", table)
+	}
+
+	if _, err := passageoftime.ConvertTimezones(instant, []string{"Mars/Olympus_Mons"}, nil); err == nil {
+		t.Error("ConvertTimezones() should reject unknown timezones")
+	}
+}
+
+// TestZoneGroups tests built-in and environment-defined timezone groups
+func TestZoneGroups(t *testing.T) {
+	t.Setenv(passageoftime.ZoneGroupsEnv, "team=Australia/Sydney, Europe/London ,America/Los_Angeles;ops=UTC")
+
+	team, err := passageoftime.GetZoneGroup("Team")
+	if err != nil {
+		t.Fatalf("GetZoneGroup(team) error = %v", err)
+	}
+	if strings.Join(team, ",") != "Australia/Sydney,Europe/London,America/Los_Angeles" {
+		t.Errorf("GetZoneGroup(team) = %v", team)
+	}
+
+	europe, err := passageoftime.GetZoneGroup("europe")
+	if err != nil || len(europe) == 0 {
+		t.Errorf("GetZoneGroup(europe) = %v, %v", europe, err)
+	}
+
+	if _, err := passageoftime.GetZoneGroup("nope"); err == nil || !strings.Contains(err.Error(), "team") {
+		t.Errorf("GetZoneGroup(nope) error = %v, want list of available groups", err)
+	}
+}
+
+// TestHandleConvertTimezones tests the convert_timezones handler
+func TestHandleConvertTimezones(t *testing.T) {
+	t.Setenv(passageoftime.ZoneGroupsEnv, "team=Australia/Sydney,Europe/London")
+
+	tests := []struct {
+		name         string
+		args         ConvertTimezonesArgs
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name: "explicit zones",
+			args: ConvertTimezonesArgs{
+				Timestamp:      "2025-01-15 09:00:00",
+				SourceTimezone: "America/Los_Angeles",
+				Zones:          []string{"Asia/Tokyo"},
+			},
+			wantContains: []string{"2025-01-16T02:00:00+09:00", "day_shift:next day", "abbreviation:JST", "Zone", "Local time"},
+		},
+		{
+			name:         "named group",
+			args:         ConvertTimezonesArgs{Timestamp: "2025-01-15T00:00:00Z", Group: "team"},
+			wantContains: []string{"Australia/Sydney", "Europe/London", "AEDT", "GMT"},
+		},
+		{
+			name:    "no zones",
+			args:    ConvertTimezonesArgs{Timestamp: "2025-01-15"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &mcp.CallToolParamsFor[ConvertTimezonesArgs]{Arguments: tt.args}
+			got, err := handleConvertTimezones(context.Background(), nil, params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handleConvertTimezones() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.wantContains {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleConvertTimezones() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
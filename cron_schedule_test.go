@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/schedule"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestScheduleNextFireTimes tests cron and OnCalendar evaluation across DST changes
+func TestScheduleNextFireTimes(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		name       string
+		expression string
+		from       time.Time
+		loc        *time.Location
+		kind       schedule.Kind
+		want       []string
+	}{
+		{
+			name:       "every 6 hours on weekdays across spring forward",
+			expression: "0 */6 * * 1-5",
+			from:       time.Date(2025, 3, 28, 13, 0, 0, 0, berlin),
+			loc:        berlin,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-03-28T18:00:00+01:00",
+				"2025-03-31T00:00:00+02:00",
+				"2025-03-31T06:00:00+02:00",
+			},
+		},
+		{
+			name:       "nonexistent wall-clock time is skipped",
+			expression: "30 2 * * *",
+			from:       time.Date(2025, 3, 29, 12, 0, 0, 0, berlin),
+			loc:        berlin,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-03-31T02:30:00+02:00",
+			},
+		},
+		{
+			name:       "ambiguous wall-clock time fires once at the earlier instant",
+			expression: "30 1 * * *",
+			from:       time.Date(2025, 11, 1, 12, 0, 0, 0, newYork),
+			loc:        newYork,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-11-02T01:30:00-04:00",
+				"2025-11-03T01:30:00-05:00",
+			},
+		},
+		{
+			name:       "macro",
+			expression: "@monthly",
+			from:       time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+			loc:        time.UTC,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-02-01T00:00:00Z",
+				"2025-03-01T00:00:00Z",
+			},
+		},
+		{
+			name:       "day-of-month or day-of-week",
+			expression: "0 0 1 * FRI",
+			from:       time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC),
+			loc:        time.UTC,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-01-31T00:00:00Z",
+				"2025-02-01T00:00:00Z",
+				"2025-02-07T00:00:00Z",
+			},
+		},
+		{
+			name:       "six fields with seconds",
+			expression: "*/20 0 12 * * *",
+			from:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			loc:        time.UTC,
+			kind:       schedule.KindCron,
+			want: []string{
+				"2025-01-01T12:00:00Z",
+				"2025-01-01T12:00:20Z",
+				"2025-01-01T12:00:40Z",
+			},
+		},
+		{
+			name:       "OnCalendar weekdays",
+			expression: "Mon..Fri *-*-* 09:00",
+			from:       time.Date(2025, 3, 28, 10, 0, 0, 0, berlin),
+			loc:        berlin,
+			kind:       schedule.KindOnCalendar,
+			want: []string{
+				"2025-03-31T09:00:00+02:00",
+				"2025-04-01T09:00:00+02:00",
+			},
+		},
+		{
+			name:       "OnCalendar shortcut",
+			expression: "quarterly",
+			from:       time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			loc:        time.UTC,
+			kind:       schedule.KindOnCalendar,
+			want: []string{
+				"2025-04-01T00:00:00Z",
+				"2025-07-01T00:00:00Z",
+			},
+		},
+		{
+			name:       "OnCalendar embedded timezone wins",
+			expression: "Sat,Sun 10:30 America/New_York",
+			from:       time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC),
+			loc:        berlin,
+			kind:       schedule.KindOnCalendar,
+			want: []string{
+				"2025-03-29T10:30:00-04:00",
+				"2025-03-30T10:30:00-04:00",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := schedule.Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			if s.Kind != tt.kind {
+				t.Errorf("Parse(%q) kind = %v, want %v", tt.expression, s.Kind, tt.kind)
+			}
+
+			got, err := s.Next(tt.from, len(tt.want), tt.loc)
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Next() returned %d times, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if got[i].Format(time.RFC3339) != tt.want[i] {
+					t.Errorf("Next()[%d] = %s, want %s", i, got[i].Format(time.RFC3339), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSchedulePreviousAndErrors tests backwards search and invalid expressions
+func TestSchedulePreviousAndErrors(t *testing.T) {
+	s, err := schedule.Parse("0 9 * * MON")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, err := s.Previous(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), 2, time.UTC)
+	if err != nil {
+		t.Fatalf("Previous() error = %v", err)
+	}
+	if got[0].Format(time.RFC3339) != "2025-01-13T09:00:00Z" || got[1].Format(time.RFC3339) != "2025-01-06T09:00:00Z" {
+		t.Errorf("Previous() = %v, want 2025-01-13 and 2025-01-06", got)
+	}
+
+	never, err := schedule.Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if _, err := never.Next(time.Now(), 1, time.UTC); err == nil {
+		t.Errorf("Next() for February 30 should fail")
+	}
+
+	for _, expression := range []string{"", "61 * * * *", "* * * *", "@reboot", "0 0 L * *", "Mon..Fri *-*~01"} {
+		if _, err := schedule.Parse(expression); err == nil {
+			t.Errorf("Parse(%q) should fail", expression)
+		}
+	}
+}
+
+// TestScheduleDescription tests the English rendering of schedules
+func TestScheduleDescription(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"* * * * *", "Every minute"},
+		{"@daily", "At 00:00"},
+		{"0 9,17 * * *", "At 09:00 and 17:00"},
+		{"*/15 9-17 * * MON-FRI", "Every 15 minutes, every hour from 9 through 17, on Monday through Friday"},
+		{"0 0 1,15 * 5", "At 00:00, on days 1 and 15 of the month or on Friday"},
+		{"0 0 * * 5-7", "At 00:00, on Friday through Saturday and Sunday"},
+		{"Sat,Sun 10:30", "At 10:30, on Saturday and Sunday"},
+		{"*-01-01 00:00:00 UTC", "At 00:00, on day 1 of the month, in January, UTC time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			s, err := schedule.Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := s.Description(); got != tt.want {
+				t.Errorf("Description() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHandleCronSchedule tests the cron_schedule handler
+func TestHandleCronSchedule(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         CronScheduleArgs
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name: "next and previous in Berlin",
+			args: CronScheduleArgs{
+				Expression: "0 */6 * * 1-5",
+				Timezone:   "Europe/Berlin",
+				From:       "2025-03-28 13:00:00",
+				Count:      2,
+				Direction:  "both",
+			},
+			wantContains: []string{"type:cron", "2025-03-28T18:00:00+01:00", "2025-03-31T00:00:00+02:00", "2025-03-28T12:00:00+01:00", "previous:"},
+		},
+		{
+			name:         "OnCalendar default direction",
+			args:         CronScheduleArgs{Expression: "daily", From: "2025-01-01 12:00:00"},
+			wantContains: []string{"type:oncalendar", "2025-01-02T00:00:00Z", "timezone:UTC"},
+		},
+		{
+			name:    "invalid expression",
+			args:    CronScheduleArgs{Expression: "not a schedule"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid direction",
+			args:    CronScheduleArgs{Expression: "@hourly", Direction: "sideways"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &mcp.CallToolParamsFor[CronScheduleArgs]{Arguments: tt.args}
+			got, err := handleCronSchedule(context.Background(), nil, params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handleCronSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.wantContains {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleCronSchedule() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestDecodeIDTimestamp tests detection and decoding against published example IDs
+func TestDecodeIDTimestamp(t *testing.T) {
+	twitter, _ := passageoftime.ParseSnowflakeEpoch("", passageoftime.ParseOptions{})
+	discord, _ := passageoftime.ParseSnowflakeEpoch("discord", passageoftime.ParseOptions{})
+	tests := []struct {
+		id    string
+		epoch time.Time
+		kind  passageoftime.IDKind
+		want  string
+	}{
+		// RFC 9562 appendix examples all encode 2022-02-22 14:22:22 -05:00
+		{"C232AB00-9414-11EC-B3C8-9F6BDECED846", twitter, passageoftime.IDKindUUID, "2022-02-22T19:22:22Z"},
+		{"1EC9414C-232A-6B00-B3C8-9F6BDECED846", twitter, passageoftime.IDKindUUID, "2022-02-22T19:22:22Z"},
+		// The largest v6 timestamp lies beyond the range of time.Duration
+		{"ffffffff-ffff-6fff-bfff-ffffffffffff", twitter, passageoftime.IDKindUUID, "5236-03-31T21:21:00.6846975Z"},
+		{"{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}", twitter, passageoftime.IDKindUUID, "2022-02-22T19:22:22Z"},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", twitter, passageoftime.IDKindULID, "2016-07-30T23:54:10.259Z"},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", twitter, passageoftime.IDKindKSUID, "2017-10-10T04:00:47Z"},
+		{"507f1f77bcf86cd799439011", twitter, passageoftime.IDKindObjectID, "2012-10-17T21:13:27Z"},
+		{"175928847299117063", discord, passageoftime.IDKindSnowflake, "2016-04-30T11:18:25.796Z"},
+	}
+
+	for _, tt := range tests {
+		decoded, err := passageoftime.DecodeIDTimestamp(tt.id, "", tt.epoch)
+		if err != nil {
+			t.Errorf("DecodeIDTimestamp(%q) error = %v", tt.id, err)
+			continue
+		}
+		if decoded.Kind != tt.kind {
+			t.Errorf("DecodeIDTimestamp(%q) kind = %s, want %s", tt.id, decoded.Kind, tt.kind)
+		}
+		if got := decoded.Timestamp.Format(time.RFC3339Nano); got != tt.want {
+			t.Errorf("DecodeIDTimestamp(%q) = %s, want %s", tt.id, got, tt.want)
+		}
+	}
+
+	if _, err := passageoftime.DecodeIDTimestamp("9b2c4a6e-8f1d-4c3b-a2e5-7d9f0b1c3e5a", "", twitter); err == nil || !strings.Contains(err.Error(), "version 4") {
+		t.Errorf("DecodeIDTimestamp(UUIDv4) error = %v, want a version 4 error", err)
+	}
+	if _, err := passageoftime.DecodeIDTimestamp("hello", "", twitter); err == nil {
+		t.Error("DecodeIDTimestamp() should reject an unrecognized ID")
+	}
+}
+
+// TestHandleDecodeIDTimestamp tests the decode_id_timestamp handler
+func TestHandleDecodeIDTimestamp(t *testing.T) {
+	args := DecodeIDTimestampArgs{ID: "175928847299117063", SnowflakeEpoch: "discord", Timezone: "Asia/Tokyo"}
+	got, err := handleDecodeIDTimestamp(context.Background(), nil, &mcp.CallToolParamsFor[DecodeIDTimestampArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleDecodeIDTimestamp() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"kind:snowflake", "timestamp:2016-04-30T20:18:25.796+09:00", "sequence:7", "precision:1ms", "time_since:map[",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleDecodeIDTimestamp() = %v, want to contain %v", text, want)
+		}
+	}
+
+	// 1<<22 is one millisecond after the epoch, given here as a local time in the DST overlap
+	args = DecodeIDTimestampArgs{ID: "4194304", Kind: "snowflake", SnowflakeEpoch: "2025-11-02 01:30", Timezone: "America/New_York", AmbiguousTime: "later"}
+	got, err = handleDecodeIDTimestamp(context.Background(), nil, &mcp.CallToolParamsFor[DecodeIDTimestampArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleDecodeIDTimestamp() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"ambiguous_local_time:true", "utc:2025-11-02T06:30:00.001Z"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleDecodeIDTimestamp() = %v, want to contain %v", text, want)
+		}
+	}
+}
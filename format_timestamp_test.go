@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestFormatTimestamp tests the named formats and fractional precision
+func TestFormatTimestamp(t *testing.T) {
+	loc, _ := time.LoadLocation("America/New_York")
+	at := time.Date(2025, 3, 9, 14, 5, 6, 123456789, loc)
+
+	tests := []struct {
+		format    string
+		precision int
+		want      string
+	}{
+		{"rfc3339", -1, "2025-03-09T14:05:06-04:00"},
+		{"RFC3339", 3, "2025-03-09T14:05:06.123-04:00"},
+		{"iso8601", 9, "2025-03-09T14:05:06.123456789-04:00"},
+		{"rfc2822", -1, "Sun, 09 Mar 2025 14:05:06 -0400"},
+		{"rfc1123", -1, "Sun, 09 Mar 2025 14:05:06 EDT"},
+		{"http-date", -1, "Sun, 09 Mar 2025 18:05:06 GMT"},
+		{"rfc850", -1, "Sunday, 09-Mar-25 14:05:06 EDT"},
+		{"ansic", -1, "Sun Mar  9 14:05:06 2025"},
+		{"sql_datetime", 6, "2025-03-09 14:05:06.123456"},
+		{"iso_week_date", -1, "2025-W10-7"},
+		{"kitchen", -1, "2:05PM"},
+		{"unix", -1, "1741543506"},
+		{"unix_ms", -1, "1741543506123"},
+		{"unix_float", 3, "1741543506.123"},
+		{"json", -1, "2025-03-09T18:05:06.123Z"},
+		{"clf", -1, "09/Mar/2025:14:05:06 -0400"},
+	}
+	for _, tt := range tests {
+		got, err := passageoftime.FormatTimestamp(at, tt.format, tt.precision)
+		if err != nil {
+			t.Errorf("FormatTimestamp(%s) error = %v", tt.format, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("FormatTimestamp(%s, %d) = %q, want %q", tt.format, tt.precision, got, tt.want)
+		}
+	}
+
+	// Before 1970 the fraction must not be added to the negative whole seconds
+	for _, tt := range []struct {
+		at   time.Time
+		want string
+	}{
+		{time.Unix(-1, 5e8), "-0.500000"},
+		{time.Unix(-2, 25e7), "-1.750000"},
+		{time.Unix(-3, 0), "-3.000000"},
+	} {
+		if got, _ := passageoftime.FormatTimestamp(tt.at, "unix_float", -1); got != tt.want {
+			t.Errorf("FormatTimestamp(%v, unix_float) = %q, want %q", tt.at.UTC(), got, tt.want)
+		}
+	}
+
+	// RFC 5424 allows at most 6 fractional digits
+	if got, _ := passageoftime.FormatTimestamp(at, "syslog_rfc5424", 9); got != "2025-03-09T14:05:06.123456-04:00" {
+		t.Errorf("FormatTimestamp(syslog_rfc5424, 9) = %q, want 6 fractional digits", got)
+	}
+
+	// Unix nanoseconds only fit an int64 between 1677 and 2262
+	if got, err := passageoftime.FormatTimestamp(time.Date(2262, 4, 11, 0, 0, 0, 0, time.UTC), "unix_ns", -1); err != nil || got != "9223286400000000000" {
+		t.Errorf("FormatTimestamp(2262-04-11, unix_ns) = %q, %v", got, err)
+	}
+	for _, far := range []time.Time{time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		if got, err := passageoftime.FormatTimestamp(far, "unix_ns", -1); err == nil {
+			t.Errorf("FormatTimestamp(%v, unix_ns) = %q, want an out of range error", far, got)
+		}
+	}
+
+	if _, err := passageoftime.FormatTimestamp(at, "rfc9999", -1); err == nil {
+		t.Error("FormatTimestamp() should reject an unknown format")
+	}
+	if _, err := passageoftime.FormatTimestamp(at, "rfc3339", 10); err == nil {
+		t.Error("FormatTimestamp() should reject precision above 9")
+	}
+}
+
+// TestHandleFormatTimestamp tests the format_timestamp handler
+func TestHandleFormatTimestamp(t *testing.T) {
+	precision := 3
+	args := FormatTimestampArgs{
+		Timestamp: "2025-07-04 09:30:00",
+		Formats:   []string{"rfc3339", "http_date", "iso_week_date"},
+		Precision: &precision,
+		Timezone:  "Europe/Berlin",
+	}
+	got, err := handleFormatTimestamp(context.Background(), nil, &mcp.CallToolParamsFor[FormatTimestampArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleFormatTimestamp() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"rfc3339:2025-07-04T09:30:00.000+02:00", "http_date:Fri, 04 Jul 2025 07:30:00 GMT", "iso_week_date:2025-W27-5"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleFormatTimestamp() = %v, want to contain %v", text, want)
+		}
+	}
+	if strings.Contains(text, "kitchen") {
+		t.Errorf("handleFormatTimestamp() = %v, should render only the requested formats", text)
+	}
+
+	args = FormatTimestampArgs{Timestamp: "2025-07-04 09:30:00", Formats: []string{"julian"}}
+	if _, err := handleFormatTimestamp(context.Background(), nil, &mcp.CallToolParamsFor[FormatTimestampArgs]{Arguments: args}); err == nil {
+		t.Error("handleFormatTimestamp() should reject an unknown format")
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestFindFreeSlots tests free slot discovery around busy intervals and working hours
+func TestFindFreeSlots(t *testing.T) {
+	utc := func(hour, minute int) time.Time {
+		return time.Date(2025, 6, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	// London and New York share 13:00-16:00 UTC on Tuesday 10 June 2025
+	slots, err := passageoftime.FindFreeSlots(passageoftime.FreeSlotOptions{
+		Participants: []passageoftime.Participant{
+			{Name: "London", Timezone: "Europe/London", Busy: []passageoftime.Interval{{Start: utc(13, 30), End: utc(14, 0)}}},
+			{Name: "New York", Timezone: "America/New_York", Busy: []passageoftime.Interval{{Start: utc(15, 0), End: utc(15, 15)}}},
+		},
+		Start:       utc(0, 0),
+		End:         utc(23, 59),
+		MinDuration: 30 * time.Minute,
+		Buffer:      10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("FindFreeSlots() error = %v", err)
+	}
+
+	// Free: 13:00-13:20 (too short), 14:10-14:50, 15:25-16:00
+	var got []string
+	for _, slot := range slots {
+		got = append(got, slot.Start.Format("15:04")+"-"+slot.End.Format("15:04"))
+		if slot.Suggested.End.Sub(slot.Suggested.Start) != 30*time.Minute || slot.Suggested.Start.Before(slot.Start) || slot.Suggested.End.After(slot.End) {
+			t.Errorf("suggested meeting %v-%v does not fit slot %v-%v", slot.Suggested.Start, slot.Suggested.End, slot.Start, slot.End)
+		}
+	}
+	if strings.Join(got, ",") != "14:10-14:50,15:25-16:00" {
+		t.Errorf("FindFreeSlots() = %v, want [14:10-14:50 15:25-16:00] ranked by score", got)
+	}
+	if slots[0].Score < slots[1].Score {
+		t.Errorf("slots not ranked by score: %v then %v", slots[0].Score, slots[1].Score)
+	}
+
+	// Without working hours the whole day is searched
+	slots, err = passageoftime.FindFreeSlots(passageoftime.FreeSlotOptions{
+		Participants:       []passageoftime.Participant{{Timezone: "UTC", Busy: []passageoftime.Interval{{Start: utc(1, 0), End: utc(23, 0)}}}},
+		Start:              utc(0, 0),
+		End:                utc(23, 59),
+		MinDuration:        time.Hour,
+		IgnoreWorkingHours: true,
+	})
+	if err != nil {
+		t.Fatalf("FindFreeSlots() error = %v", err)
+	}
+	if len(slots) != 1 || !slots[0].Start.Equal(utc(0, 0)) || slots[0].Score != 0 {
+		t.Errorf("FindFreeSlots(ignore hours) = %+v, want only 00:00-01:00 with score 0", slots)
+	}
+}
+
+// TestFindFreeSlotsAlignment tests that suggested starts align on the local clock of the search window
+func TestFindFreeSlotsAlignment(t *testing.T) {
+	kathmandu, _ := time.LoadLocation("Asia/Kathmandu")
+	local := func(hour, minute int) time.Time {
+		return time.Date(2025, 6, 10, hour, minute, 0, 0, kathmandu)
+	}
+
+	slots, err := passageoftime.FindFreeSlots(passageoftime.FreeSlotOptions{
+		Participants: []passageoftime.Participant{{
+			Timezone: "Asia/Kathmandu",
+			Busy:     []passageoftime.Interval{{Start: local(9, 0), End: local(9, 10)}, {Start: local(12, 0), End: local(17, 0)}},
+		}},
+		Start:       local(0, 0),
+		End:         local(23, 59),
+		MinDuration: time.Hour,
+		Step:        30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("FindFreeSlots() error = %v", err)
+	}
+	if len(slots) != 1 {
+		t.Fatalf("FindFreeSlots() returned %d slots, want 1: %+v", len(slots), slots)
+	}
+	if start := slots[0].Suggested.Start.In(kathmandu); !start.Equal(slots[0].Start) && start.Minute()%30 != 0 {
+		t.Errorf("suggested start %s is neither the slot start nor on a half hour", start.Format("15:04"))
+	}
+}
+
+// TestHandleFindFreeSlots tests the find_free_slots handler
+func TestHandleFindFreeSlots(t *testing.T) {
+	args := FindFreeSlotsArgs{
+		Participants: []FreeSlotParticipantArgs{
+			{Name: "Ana", Timezone: "Europe/Berlin", Busy: []string{"2025-06-10 09:00 to 2025-06-10 12:00"}},
+			{Name: "Raj", Timezone: "Asia/Kolkata", WorkStart: "10:00", WorkEnd: "19:00", Busy: []string{"2025-06-10 13:00/60m"}},
+		},
+		StartDate:       "2025-06-10",
+		EndDate:         "2025-06-10",
+		DurationMinutes: 45,
+		Timezone:        "Europe/Berlin",
+	}
+	got, err := handleFindFreeSlots(context.Background(), nil, &mcp.CallToolParamsFor[FindFreeSlotsArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleFindFreeSlots() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	// Raj works until 19:00 IST (15:30 Berlin); Ana is free from 12:00 except Raj's 13:00-14:00
+	for _, want := range []string{"free_slot_count:2", "start:2025-06-10T12:00:00+02:00", "end:2025-06-10T13:00:00+02:00", "start:2025-06-10T14:00:00+02:00", "end:2025-06-10T15:30:00+02:00", "name:Raj"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleFindFreeSlots() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args.Participants[0].Busy = []string{"not an interval"}
+	if _, err := handleFindFreeSlots(context.Background(), nil, &mcp.CallToolParamsFor[FindFreeSlotsArgs]{Arguments: args}); err == nil || !strings.Contains(err.Error(), "Ana busy") {
+		t.Errorf("handleFindFreeSlots() error = %v, want a busy interval error naming Ana", err)
+	}
+}
@@ -4,7 +4,6 @@ go 1.24.5
 
 require (
 	github.com/araddon/dateparse v0.0.0-20210429162001-6b43995a97de
-	github.com/dustin/go-humanize v1.0.1
 	github.com/modelcontextprotocol/go-sdk v0.2.0
 	github.com/olebedev/when v1.1.0
 	golang.org/x/sys v0.26.0
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/araddon/dateparse"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -580,21 +579,40 @@ func TestHandleFormatDuration(t *testing.T) {
 					t.Errorf("handleFormatDuration() = %v, want to contain %v", text, tt.wantPattern)
 				}
 				
-				// Extract and validate the precise timestamp in parentheses
-				startParen := strings.Index(text, "(")
-				endParen := strings.LastIndex(text, ")")
-				if startParen == -1 || endParen == -1 || startParen >= endParen {
-					t.Errorf("handleFormatDuration() = %v, expected precise timestamp in parentheses", text)
-				} else {
-					timestampStr := text[startParen+1 : endParen]
-					// Use dateparse to validate the timestamp
-					if _, err := dateparse.ParseAny(timestampStr); err != nil {
-						t.Errorf("handleFormatDuration() = %v, timestamp in parentheses '%s' is not a valid date/time: %v", text, timestampStr, err)
-					}
+				if strings.Contains(text, "(") {
+					t.Errorf("handleFormatDuration() = %v, want no timestamp without a reference", text)
 				}
 			}
 		})
 	}
+
+	// A reference anchors the duration and appends the end time
+	args := FormatDurationArgs{Seconds: 5400.5, Reference: "2024-01-15 09:00:00", Timezone: "Asia/Kolkata"}
+	got, err := handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleFormatDuration() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; text != "1 hour, 30 minutes (2024-01-15 10:30:00 IST)" {
+		t.Errorf("handleFormatDuration() = %v", text)
+	}
+
+	// A reference in a DST gap is resolved and flagged
+	args = FormatDurationArgs{Seconds: 3600, Reference: "2025-03-09 02:30", Timezone: "America/New_York"}
+	got, err = handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleFormatDuration() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"1 hour (2025-03-09 04:30:00 EDT)", "nonexistent_local_time:true"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleFormatDuration() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args.NonexistentTime = "error"
+	if _, err := handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: args}); err == nil {
+		t.Error("handleFormatDuration() should reject a reference in a DST gap with nonexistent_time=error")
+	}
 }
 
 // TestHandleListTimezones tests the list_timezones handler with pagination functionality
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/calendars"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestHolidayOccurrences tests resolving holidays to Gregorian dates
+func TestHolidayOccurrences(t *testing.T) {
+	tests := []struct {
+		holiday string
+		year    int
+		want    []string
+	}{
+		{"rosh_hashanah", 2025, []string{"2025-09-23"}},
+		{"Yom Kippur", 2025, []string{"2025-10-02"}},
+		{"passover", 2024, []string{"2024-04-23"}}, // leap year: Nisan is month 8
+		{"purim", 2024, []string{"2024-03-24"}},    // Adar II
+		{"purim", 2025, []string{"2025-03-14"}},
+		{"eid_al_fitr", 2025, []string{"2025-03-30"}},
+		{"eid-al-adha", 2025, []string{"2025-06-06"}},
+		{"ramadan", 2024, []string{"2024-03-11"}},
+		{"eid_al_fitr", 2033, []string{"2033-01-02", "2033-12-23"}}, // twice in one year
+		{"Chinese New Year", 2024, []string{"2024-02-10"}},
+		{"lunar_new_year", 2026, []string{"2026-02-17"}},
+		{"mid_autumn_festival", 2024, []string{"2024-09-17"}},
+		{"nowruz", 2025, []string{"2025-03-21"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %d", tt.holiday, tt.year), func(t *testing.T) {
+			h, err := calendars.GetHoliday(tt.holiday)
+			if err != nil {
+				t.Fatalf("GetHoliday() error = %v", err)
+			}
+			occurrences, err := h.Occurrences(tt.year)
+			if err != nil {
+				t.Fatalf("Occurrences() error = %v", err)
+			}
+			var got []string
+			for _, o := range occurrences {
+				y, m, d := calendars.JDNToGregorian(o.First)
+				got = append(got, fmt.Sprintf("%04d-%02d-%02d", y, m, d))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Occurrences(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := calendars.GetHoliday("festivus"); err == nil {
+		t.Error("GetHoliday() should reject unknown holidays")
+	}
+}
+
+// TestHandleHolidayDates tests the holiday_dates handler
+func TestHandleHolidayDates(t *testing.T) {
+	tests := []struct {
+		name string
+		args HolidayDatesArgs
+		want []string
+	}{
+		{
+			name: "multi-day holiday",
+			args: HolidayDatesArgs{Holiday: "hanukkah", Year: 2025},
+			want: []string{"start:2025-12-15", "end:2025-12-22", "calendar_date:25 Kislev 5786 AM", "note:Begins at sunset"},
+		},
+		{
+			name: "lunar new year",
+			args: HolidayDatesArgs{Holiday: "lunar new year", Year: 2025},
+			want: []string{"start:2025-01-29", "day_of_week:Wednesday", "Year of the Snake"},
+		},
+		{
+			name: "current year",
+			args: HolidayDatesArgs{Holiday: "eid_al_adha"},
+			want: []string{"holiday:Eid al-Adha", "calendar:Islamic (Umm al-Qura)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handleHolidayDates(context.Background(), nil, &mcp.CallToolParamsFor[HolidayDatesArgs]{Arguments: tt.args})
+			if err != nil {
+				t.Fatalf("handleHolidayDates() error = %v", err)
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleHolidayDates() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestIntervalAlgebra tests the interval set operations
+func TestIntervalAlgebra(t *testing.T) {
+	at := func(hhmm string) time.Time {
+		parsed, _ := time.Parse("2006-01-02 15:04", "2025-03-10 "+hhmm)
+		return parsed
+	}
+	iv := func(start, end string) passageoftime.Interval {
+		return passageoftime.Interval{Start: at(start), End: at(end)}
+	}
+	render := func(ivs []passageoftime.Interval) string {
+		var parts []string
+		for _, i := range ivs {
+			parts = append(parts, i.Start.Format("15:04")+"-"+i.End.Format("15:04"))
+		}
+		return strings.Join(parts, ",")
+	}
+
+	busy := []passageoftime.Interval{iv("11:00", "12:00"), iv("09:00", "10:00"), iv("09:30", "10:30"), iv("10:30", "10:45")}
+	other := []passageoftime.Interval{iv("10:00", "11:30"), iv("14:00", "15:00")}
+
+	tests := []struct {
+		name string
+		got  []passageoftime.Interval
+		want string
+	}{
+		{"merge joins overlapping and touching", passageoftime.MergeIntervals(busy), "09:00-10:45,11:00-12:00"},
+		{"union", passageoftime.UnionIntervals(busy, other), "09:00-12:00,14:00-15:00"},
+		{"intersection", passageoftime.IntersectIntervals(busy, other), "10:00-10:45,11:00-11:30"},
+		{"subtract", passageoftime.SubtractIntervals(busy, other), "09:00-10:00,11:30-12:00"},
+		{"subtract everything", passageoftime.SubtractIntervals(other, []passageoftime.Interval{iv("08:00", "18:00")}), ""},
+		{"gaps", passageoftime.FindGaps(busy, iv("08:00", "13:00")), "08:00-09:00,10:45-11:00,12:00-13:00"},
+		{"gaps clipped to window", passageoftime.FindGaps(busy, iv("09:45", "11:30")), "10:45-11:00"},
+		{"no gaps", passageoftime.FindGaps(busy, iv("09:15", "10:15")), ""},
+	}
+	for _, tt := range tests {
+		if got := render(tt.got); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if _, ok := passageoftime.IntervalsContain(busy, iv("09:10", "10:40")); !ok {
+		t.Error("IntervalsContain() should find 09:10-10:40 within merged busy time")
+	}
+	if _, ok := passageoftime.IntervalsContain(busy, iv("10:40", "11:10")); ok {
+		t.Error("IntervalsContain() should reject an interval spanning a gap")
+	}
+	if iv("09:00", "10:00").Contains(at("10:00")) {
+		t.Error("Contains() should exclude the end instant")
+	}
+	if got := passageoftime.TotalDuration(busy); got != 165*time.Minute {
+		t.Errorf("TotalDuration() = %v, want 2h45m", got)
+	}
+}
+
+// TestParseInterval tests the accepted interval notations
+func TestParseInterval(t *testing.T) {
+	options := passageoftime.ParseOptions{Timezone: "UTC", ReferenceTime: time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"2025-03-10T09:00:00Z/2025-03-10T10:30:00Z", "2025-03-10T09:00:00Z/2025-03-10T10:30:00Z"},
+		{"2025-03-10 09:00 to 2025-03-10 10:30", "2025-03-10T09:00:00Z/2025-03-10T10:30:00Z"},
+		{"2025-03-10 09:00 - 2025-03-10 10:30", "2025-03-10T09:00:00Z/2025-03-10T10:30:00Z"},
+		{"2025-03-10T09:00:00Z/90m", "2025-03-10T09:00:00Z/2025-03-10T10:30:00Z"},
+		{"2025-03-10 until 2025-03-11", "2025-03-10T00:00:00Z/2025-03-12T00:00:00Z"}, // bare end date is inclusive
+		{"2025/03/10 09:00 to 2025/03/10 10:30", "2025-03-10T09:00:00Z/2025-03-10T10:30:00Z"},
+	}
+	for _, tt := range tests {
+		got, err := passageoftime.ParseInterval(tt.input, options)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseInterval(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	newYork := passageoftime.ParseOptions{Timezone: "America/New_York", ReferenceTime: options.ReferenceTime}
+	parsed, err := passageoftime.ParseIntervalDetailed("2025-11-02 01:30 to 2025-11-02 03:00", newYork)
+	if err != nil {
+		t.Fatalf("ParseIntervalDetailed() error = %v", err)
+	}
+	if !parsed.StartResolution.Ambiguous || parsed.EndResolution.Ambiguous {
+		t.Errorf("ParseIntervalDetailed() resolutions = %+v, %+v; want only the start ambiguous", parsed.StartResolution, parsed.EndResolution)
+	}
+
+	for _, input := range []string{"2025-03-10T09:00:00Z", "2025-03-10 10:00 to 2025-03-10 09:00"} {
+		if _, err := passageoftime.ParseInterval(input, options); err == nil {
+			t.Errorf("ParseInterval(%q) should fail", input)
+		}
+	}
+}
+
+// TestHandleIntervalOps tests the interval_ops handler
+func TestHandleIntervalOps(t *testing.T) {
+	busy := []string{"2025-03-10T09:00:00Z/2025-03-10T10:00:00Z", "2025-03-10T09:30:00Z/2025-03-10T11:00:00Z", "2025-03-10T13:00:00Z/60m"}
+	tests := []struct {
+		name    string
+		args    IntervalOpsArgs
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "merge",
+			args: IntervalOpsArgs{Operation: "merge", Intervals: busy},
+			want: []string{"count:2", "end:2025-03-10T11:00:00Z", "total_duration:3h"},
+		},
+		{
+			name: "gaps in another timezone",
+			args: IntervalOpsArgs{Operation: "gaps", Intervals: busy, Window: "2025-03-10T08:00:00Z/2025-03-10T15:00:00Z", Timezone: "Europe/Berlin"},
+			want: []string{"start:2025-03-10T09:00:00+01:00", "start:2025-03-10T12:00:00+01:00", "duration:2h", "busy_duration:3h"},
+		},
+		{
+			name: "contains",
+			args: IntervalOpsArgs{Operation: "contains", Intervals: busy, Timestamp: "2025-03-10T10:15:00Z", OtherIntervals: []string{"2025-03-10T10:30:00Z/2025-03-10T13:30:00Z"}},
+			want: []string{"contained:true", "contained:false", "overlap:1h", "all_contained:false"},
+		},
+		{
+			name: "DST gap in an interval",
+			args: IntervalOpsArgs{Operation: "merge", Intervals: []string{"2025-03-09 01:00 to 2025-03-09 02:30"}, Timezone: "America/New_York"},
+			want: []string{"nonexistent_local_time:true", "entry:intervals[0]", "2025-03-09 02:30:00 does not exist", "duration:1h 30m"},
+		},
+		{
+			name:    "gaps without window",
+			args:    IntervalOpsArgs{Operation: "gaps", Intervals: busy},
+			wantErr: true,
+		},
+		{
+			name:    "bad interval",
+			args:    IntervalOpsArgs{Operation: "merge", Intervals: []string{"yesterday"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			args:    IntervalOpsArgs{Operation: "xor", Intervals: busy},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handleIntervalOps(context.Background(), nil, &mcp.CallToolParamsFor[IntervalOpsArgs]{Arguments: tt.args})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("handleIntervalOps() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("handleIntervalOps() error = %v", err)
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleIntervalOps() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestLocalTimePolicies tests DST gap and overlap resolution in both parsing paths
+func TestLocalTimePolicies(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		nonexistent passageoftime.NonexistentTimePolicy
+		ambiguous   passageoftime.AmbiguousTimePolicy
+		want        string // RFC3339, empty when an error is expected
+		flag        string // "nonexistent", "ambiguous" or ""
+	}{
+		{"gap default shifts forward", "2025-03-09 02:30:00", "", "", "2025-03-09T03:30:00-04:00", "nonexistent"},
+		{"gap shift back", "2025-03-09 02:30:00", passageoftime.NonexistentShiftBack, "", "2025-03-09T01:30:00-05:00", "nonexistent"},
+		{"gap error", "2025-03-09 02:30:00", passageoftime.NonexistentError, "", "", "nonexistent"},
+		{"overlap default earlier", "2025-11-02 01:30:00", "", "", "2025-11-02T01:30:00-04:00", "ambiguous"},
+		{"overlap later", "2025-11-02 01:30:00", "", passageoftime.AmbiguousLater, "2025-11-02T01:30:00-05:00", "ambiguous"},
+		{"overlap error", "2025-11-02 01:30:00", "", passageoftime.AmbiguousError, "", "ambiguous"},
+		{"ordinary time", "2025-07-04 12:00:00", passageoftime.NonexistentError, passageoftime.AmbiguousError, "2025-07-04T12:00:00-04:00", ""},
+		{"natural date format", "March 9, 2025 2:30 AM", "", "", "2025-03-09T03:30:00-04:00", "nonexistent"},
+		{"explicit offset is kept", "2025-11-02T01:30:00-05:00", "", passageoftime.AmbiguousError, "2025-11-02T01:30:00-05:00", ""},
+		{"unix timestamp is an instant", "1762061400", "", passageoftime.AmbiguousError, "2025-11-02T01:30:00-04:00", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := passageoftime.ParseOptions{
+				EnableFuzzyParsing: true,
+				Timezone:           "America/New_York",
+				ReferenceTime:      time.Now(),
+				NonexistentTime:    tt.nonexistent,
+				AmbiguousTime:      tt.ambiguous,
+			}
+
+			parsed, err := passageoftime.ParseFuzzyTimestampDetailed(tt.input, options)
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("ParseFuzzyTimestampDetailed(%q) = %v, want error", tt.input, parsed.Time)
+				}
+				if !strings.Contains(err.Error(), "DST") {
+					t.Errorf("error = %v, want to mention DST", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFuzzyTimestampDetailed(%q) error = %v", tt.input, err)
+			}
+			if got := parsed.Time.Format(time.RFC3339); got != tt.want {
+				t.Errorf("ParseFuzzyTimestampDetailed(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+			if parsed.Resolution.Nonexistent != (tt.flag == "nonexistent") || parsed.Resolution.Ambiguous != (tt.flag == "ambiguous") {
+				t.Errorf("Resolution = %+v, want flag %q", parsed.Resolution, tt.flag)
+			}
+
+			// The strict parser used by time_difference must agree
+			if _, err := time.Parse("2006-01-02 15:04:05", tt.input); err == nil {
+				strict, err := passageoftime.ParseTimestamp(tt.input, options)
+				if err != nil || strict.Format(time.RFC3339) != tt.want {
+					t.Errorf("ParseTimestamp(%q) = %s, %v, want %s", tt.input, strict.Format(time.RFC3339), err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestParseLocalTimePolicyNames tests policy name validation
+func TestParseLocalTimePolicyNames(t *testing.T) {
+	if p, err := passageoftime.ParseNonexistentTimePolicy(""); err != nil || p != passageoftime.NonexistentShiftForward {
+		t.Errorf("ParseNonexistentTimePolicy(\"\") = %v, %v", p, err)
+	}
+	if p, err := passageoftime.ParseAmbiguousTimePolicy("LATER"); err != nil || p != passageoftime.AmbiguousLater {
+		t.Errorf("ParseAmbiguousTimePolicy(\"LATER\") = %v, %v", p, err)
+	}
+	if _, err := passageoftime.ParseNonexistentTimePolicy("nearest"); err == nil {
+		t.Error("ParseNonexistentTimePolicy() should reject unknown policies")
+	}
+	if _, err := passageoftime.ParseAmbiguousTimePolicy("both"); err == nil {
+		t.Error("ParseAmbiguousTimePolicy() should reject unknown policies")
+	}
+}
+
+// TestLocalTimeFlagsInTools tests that tools report resolved DST gaps and overlaps
+func TestLocalTimeFlagsInTools(t *testing.T) {
+	parse := &mcp.CallToolParamsFor[ParseTimestampArgs]{
+		Arguments: ParseTimestampArgs{
+			Timestamp:       "2025-03-09 02:30:00",
+			TargetTimezone:  "America/New_York",
+			NonexistentTime: "shift_back",
+		},
+	}
+	got, err := handleParseTimestamp(context.Background(), nil, parse)
+	if err != nil {
+		t.Fatalf("handleParseTimestamp() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"nonexistent_local_time:true", "time:01:30:00", "shifted back to 2025-03-09 01:30:00 EST"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleParseTimestamp() = %v, want to contain %v", text, want)
+		}
+	}
+
+	parse.Arguments.NonexistentTime = "sideways"
+	if _, err := handleParseTimestamp(context.Background(), nil, parse); err == nil {
+		t.Error("handleParseTimestamp() should reject an invalid nonexistent_time policy")
+	}
+
+	diff := &mcp.CallToolParamsFor[TimeDifferenceArgs]{
+		Arguments: TimeDifferenceArgs{
+			Timestamp1:    "2025-11-02 00:30:00",
+			Timestamp2:    "2025-11-02 01:30:00",
+			Timezone:      "America/New_York",
+			AmbiguousTime: "later",
+		},
+	}
+	got, err = handleTimeDifference(context.Background(), nil, diff)
+	if err != nil {
+		t.Fatalf("handleTimeDifference() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"seconds:7200", "later occurrence 01:30:00 EST"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimeDifference() = %v, want to contain %v", text, want)
+		}
+	}
+
+	diff.Arguments.AmbiguousTime = "error"
+	if _, err := handleTimeDifference(context.Background(), nil, diff); err == nil {
+		t.Error("handleTimeDifference() should fail for an ambiguous time with ambiguous_time=error")
+	}
+
+	cron := &mcp.CallToolParamsFor[CronScheduleArgs]{
+		Arguments: CronScheduleArgs{Expression: "@hourly", Timezone: "America/New_York", From: "2025-03-09 02:30:00"},
+	}
+	got, err = handleCronSchedule(context.Background(), nil, cron)
+	if err != nil {
+		t.Fatalf("handleCronSchedule() error = %v", err)
+	}
+	if text = got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "nonexistent_local_time:true") {
+		t.Errorf("handleCronSchedule() = %v, want a nonexistent_local_time flag", text)
+	}
+
+	meeting := &mcp.CallToolParamsFor[FindMeetingTimesArgs]{
+		Arguments: FindMeetingTimesArgs{
+			Participants: []MeetingParticipantArgs{{Timezone: "America/New_York"}},
+			Timezone:     "America/New_York",
+			StartDate:    "2025-03-08 01:30:00",
+			EndDate:      "2025-03-09 02:30:00",
+		},
+	}
+	got, err = handleFindMeetingTimes(context.Background(), nil, meeting)
+	if err != nil {
+		t.Fatalf("handleFindMeetingTimes() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"nonexistent_local_time:true", "2025-03-09 02:30:00 does not exist"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleFindMeetingTimes() = %v, want to contain %v", text, want)
+		}
+	}
+
+	solar := &mcp.CallToolParamsFor[SolarTimesArgs]{
+		Arguments: SolarTimesArgs{Latitude: 40.7, Longitude: -74, Timezone: "America/New_York", Date: "2025-11-02 01:30:00"},
+	}
+	got, err = handleSolarTimes(context.Background(), nil, solar)
+	if err != nil {
+		t.Fatalf("handleSolarTimes() error = %v", err)
+	}
+	if text = got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "ambiguous_local_time:true") {
+		t.Errorf("handleSolarTimes() = %v, want an ambiguous_local_time flag", text)
+	}
+}
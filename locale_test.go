@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestLocaleFormatting tests dates, clocks and plural forms across locales
+func TestLocaleFormatting(t *testing.T) {
+	at := time.Date(2025, 3, 1, 15, 4, 0, 0, time.UTC)
+	tests := []struct {
+		tag      string
+		dateTime string
+		duration string
+	}{
+		{"en", "March 1, 2025 at 3:04 PM", "2 days, 1 hour, 3 minutes"},
+		{"en_GB", "1 March 2025 at 15:04", "2 days, 1 hour, 3 minutes"},
+		{"de-AT", "1. März 2025 um 15:04", "2 Tage, 1 Stunde, 3 Minuten"},
+		{"fr", "1 mars 2025 à 15:04", "2 jours, 1 heure, 3 minutes"},
+		{"es", "1 de marzo de 2025, 15:04", "2 días, 1 hora, 3 minutos"},
+		{"pt-BR", "1 de março de 2025 às 15:04", "2 dias, 1 hora, 3 minutos"},
+		{"ru", "1 марта 2025 г. в 15:04", "2 дня, 1 час, 3 минуты"},
+		{"zh", "2025年3月1日 15:04", "2天1小时3分钟"},
+		{"ja", "2025年3月1日 15:04", "2 日 1 時間 3 分"},
+	}
+	for _, tt := range tests {
+		locale, err := passageoftime.GetLocale(tt.tag)
+		if err != nil {
+			t.Fatalf("GetLocale(%q) error = %v", tt.tag, err)
+		}
+		if got := locale.FormatDateTime(at); got != tt.dateTime {
+			t.Errorf("%s FormatDateTime() = %q, want %q", tt.tag, got, tt.dateTime)
+		}
+		if got := locale.FormatDuration(2*86400+3600+180, "full", false); got != tt.duration {
+			t.Errorf("%s FormatDuration() = %q, want %q", tt.tag, got, tt.duration)
+		}
+	}
+
+	if _, err := passageoftime.GetLocale("tlh"); err == nil {
+		t.Error("GetLocale() should reject an unsupported language")
+	}
+}
+
+// TestLocaleRelative tests case changes in past and future phrases
+func TestLocaleRelative(t *testing.T) {
+	tests := []struct {
+		tag     string
+		amounts []passageoftime.UnitAmount
+		past    bool
+		want    string
+	}{
+		{"en", []passageoftime.UnitAmount{{Unit: passageoftime.UnitDay, Value: 3}}, true, "3 days ago"},
+		{"de", []passageoftime.UnitAmount{{Unit: passageoftime.UnitDay, Value: 3}}, true, "vor 3 Tagen"},
+		{"ru", []passageoftime.UnitAmount{{Unit: passageoftime.UnitMinute, Value: 21}}, false, "через 21 минуту"},
+		{"ru", []passageoftime.UnitAmount{{Unit: passageoftime.UnitHour, Value: 12}}, true, "12 часов назад"},
+		{"fr", []passageoftime.UnitAmount{{Unit: passageoftime.UnitWeek, Value: 1}}, false, "dans 1 semaine"},
+	}
+	for _, tt := range tests {
+		locale, _ := passageoftime.GetLocale(tt.tag)
+		if got := locale.FormatRelative(tt.amounts, tt.past); got != tt.want {
+			t.Errorf("%s FormatRelative() = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+// TestLocaleDescribeTime tests that English matches GetTimeDescription and
+// weekday phrases agree in gender
+func TestLocaleDescribeTime(t *testing.T) {
+	now := time.Date(2025, 3, 5, 9, 0, 0, 0, time.UTC)     // Wednesday
+	later := time.Date(2025, 3, 8, 18, 30, 0, 0, time.UTC) // Saturday
+
+	english, _ := passageoftime.GetLocale("")
+	if got, want := english.DescribeTime(later, now, false), passageoftime.GetTimeDescription(later, now, false); got != want {
+		t.Errorf("English DescribeTime() = %q, want %q", got, want)
+	}
+	russian, _ := passageoftime.GetLocale("ru")
+	if got := russian.DescribeTime(later, now, false); got != "в следующую субботу в 18:30" {
+		t.Errorf("Russian DescribeTime() = %q", got)
+	}
+}
+
+// TestHandlersWithLocale tests the locale argument on the formatting tools
+func TestHandlersWithLocale(t *testing.T) {
+	got, err := handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: FormatDurationArgs{Seconds: 90061, Locale: "de"}})
+	if err != nil {
+		t.Fatalf("handleFormatDuration() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; text != "1 Tag, 1 Stunde, 1 Minute, 1 Sekunde" {
+		t.Errorf("handleFormatDuration() = %v", text)
+	}
+
+	got, err = handleParseTimestamp(context.Background(), nil, &mcp.CallToolParamsFor[ParseTimestampArgs]{Arguments: ParseTimestampArgs{Timestamp: "2025-03-01 15:04:00", Locale: "fr"}})
+	if err != nil {
+		t.Fatalf("handleParseTimestamp() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "human:1 mars 2025 à 15:04 UTC") {
+		t.Errorf("handleParseTimestamp() = %v", text)
+	}
+
+	got, err = handleTimeDifference(context.Background(), nil, &mcp.CallToolParamsFor[TimeDifferenceArgs]{Arguments: TimeDifferenceArgs{Timestamp1: "2025-03-01 00:00:00", Timestamp2: "2025-03-03 05:00:00", Locale: "ja"}})
+	if err != nil {
+		t.Fatalf("handleTimeDifference() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "formatted:2 日 5 時間 (2025-03-03 05:00:00 UTC)") {
+		t.Errorf("handleTimeDifference() = %v", text)
+	}
+
+	if _, err := handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: FormatDurationArgs{Seconds: 60, Locale: "xx"}}); err == nil {
+		t.Error("handleFormatDuration() should reject an unsupported locale")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // TestMCPServerValidation tests the full MCP server functionality
@@ -224,4 +225,16 @@ func TestMCPResponseFormat(t *testing.T) {
 	if mcpResponse["timezone"] != "Australia/Melbourne" {
 		t.Errorf("MCP response missing correct timezone")
 	}
-}
\ No newline at end of file
+}
+
+// TestRegisterTools verifies every tool's argument struct yields a valid input schema
+func TestRegisterTools(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("registerTools() panicked: %v", r)
+		}
+	}()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: serverName, Version: serverVersion}, nil)
+	registerTools(server)
+}
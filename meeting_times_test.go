@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestFindMeetingTimes tests ranking of meeting slots across timezones
+func TestFindMeetingTimes(t *testing.T) {
+	// London 09:00-17:00 and New York 09:00-17:00 overlap 14:00-17:00 London time
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{
+			{Name: "London", Timezone: "Europe/London"},
+			{Name: "New York", Timezone: "America/New_York"},
+		},
+		Start:      time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC),
+		Duration:   time.Hour,
+		RequireAll: true,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+
+	// Overlap is 13:00-16:00 UTC, so one-hour meetings can start 13:00 through 15:00
+	if len(slots) != 5 {
+		t.Fatalf("FindMeetingTimes() returned %d slots, want 5: %+v", len(slots), slots)
+	}
+	for _, slot := range slots {
+		if slot.Start.Before(time.Date(2025, 6, 10, 13, 0, 0, 0, time.UTC)) || slot.End.After(time.Date(2025, 6, 10, 16, 0, 0, 0, time.UTC)) {
+			t.Errorf("slot %v-%v is outside the shared working hours", slot.Start, slot.End)
+		}
+		if len(slot.Flags) != 0 {
+			t.Errorf("slot %v has unexpected flags %v", slot.Start, slot.Flags)
+		}
+	}
+
+	// Ranked best-first: the slot centred on the overlap scores highest
+	if slots[0].Score < slots[len(slots)-1].Score {
+		t.Errorf("slots not ranked by score: first %v, last %v", slots[0].Score, slots[len(slots)-1].Score)
+	}
+}
+
+// TestFindMeetingTimesFlags tests flagging of out-of-hours and weekend slots
+func TestFindMeetingTimesFlags(t *testing.T) {
+	// Sydney, London and San Francisco have no common working hour
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{
+			{Name: "Sydney", Timezone: "Australia/Sydney"},
+			{Name: "London", Timezone: "Europe/London"},
+			{Name: "San Francisco", Timezone: "America/Los_Angeles"},
+		},
+		Start:    time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC),
+		Duration: 30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	if len(slots) == 0 {
+		t.Fatal("FindMeetingTimes() returned no slots")
+	}
+
+	best := slots[0]
+	if best.WithinHoursCount != 2 {
+		t.Errorf("best slot has %d participants within hours, want 2", best.WithinHoursCount)
+	}
+	if len(best.Flags) == 0 || !strings.Contains(best.Flags[0], "outside working hours") {
+		t.Errorf("best slot flags = %v, want an outside working hours flag", best.Flags)
+	}
+
+	// Friday evening in San Francisco is Saturday in Sydney
+	weekendSlots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{
+			{Name: "Sydney", Timezone: "Australia/Sydney"},
+			{Name: "San Francisco", Timezone: "America/Los_Angeles", WorkStart: "16:00", WorkEnd: "18:00"},
+		},
+		Start:    time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 6, 14, 0, 30, 0, 0, time.UTC),
+		Duration: 30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	if !weekendSlots[0].Participants[0].IsWeekend {
+		t.Errorf("expected Sydney to be flagged as weekend: %+v", weekendSlots[0])
+	}
+}
+
+// TestFindMeetingTimesWorkDays tests that the weekend flag follows each participant's work week
+func TestFindMeetingTimesWorkDays(t *testing.T) {
+	sunThu := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}
+	for _, tt := range []struct {
+		day         time.Time
+		wantWeekend bool
+	}{
+		{time.Date(2025, 6, 13, 9, 0, 0, 0, time.UTC), true},  // Friday
+		{time.Date(2025, 6, 15, 9, 0, 0, 0, time.UTC), false}, // Sunday
+	} {
+		slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+			Participants: []passageoftime.Participant{{Name: "Dubai", Timezone: "Asia/Dubai", WorkDays: sunThu}},
+			Start:        tt.day,
+			End:          tt.day.Add(time.Hour),
+			Duration:     time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("FindMeetingTimes() error = %v", err)
+		}
+		if got := slots[0].Participants[0].IsWeekend; got != tt.wantWeekend {
+			t.Errorf("%s: IsWeekend = %v, want %v (flags %v)", tt.day.Weekday(), got, tt.wantWeekend, slots[0].Flags)
+		}
+	}
+}
+
+// TestFindMeetingTimesHalfHourZone tests that candidates align on the requester's wall clock
+func TestFindMeetingTimesHalfHourZone(t *testing.T) {
+	kathmandu, _ := time.LoadLocation("Asia/Kathmandu")
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{{Name: "Kathmandu", Timezone: "Asia/Kathmandu"}},
+		Start:        time.Date(2025, 6, 10, 8, 50, 0, 0, kathmandu),
+		End:          time.Date(2025, 6, 10, 17, 0, 0, 0, kathmandu),
+		Duration:     time.Hour,
+		MaxResults:   100,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	for _, slot := range slots {
+		if local := slot.Start.In(kathmandu); local.Minute()%30 != 0 {
+			t.Errorf("slot starts at %s local time, want :00 or :30", local.Format("15:04"))
+		}
+	}
+	// Starts 09:00 through 16:00
+	if len(slots) != 15 {
+		t.Errorf("FindMeetingTimes() returned %d slots, want 15", len(slots))
+	}
+}
+
+// TestFindMeetingTimesOvernightShift tests working windows that cross midnight
+func TestFindMeetingTimesOvernightShift(t *testing.T) {
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{
+			{Name: "Night", Timezone: "UTC", WorkStart: "22:00", WorkEnd: "06:00"},
+		},
+		Start:      time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC),
+		Duration:   time.Hour,
+		MaxResults: 100,
+		RequireAll: true,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	// Half-hourly starts 00:00-05:00 (shift from Monday night) and 22:00-23:00
+	if len(slots) != 14 {
+		t.Errorf("FindMeetingTimes() returned %d slots, want 14", len(slots))
+	}
+}
+
+// TestFindMeetingTimesUnevenStep tests that a step not dividing the hour still counts from midnight
+func TestFindMeetingTimesUnevenStep(t *testing.T) {
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{{Name: "Anyone", Timezone: "UTC", WorkStart: "00:00", WorkEnd: "24:00"}},
+		Start:        time.Date(2025, 6, 10, 0, 40, 0, 0, time.UTC),
+		End:          time.Date(2025, 6, 10, 1, 40, 0, 0, time.UTC),
+		Duration:     25 * time.Minute,
+		Step:         25 * time.Minute,
+		MaxResults:   100,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	var starts []string
+	for _, slot := range slots {
+		starts = append(starts, slot.Start.Format("15:04"))
+	}
+	sort.Strings(starts)
+	if got := strings.Join(starts, " "); got != "00:50 01:15" {
+		t.Errorf("FindMeetingTimes() starts = %s, want 00:50 01:15", got)
+	}
+}
+
+// TestFindMeetingTimesOvernightDayOff tests that a shift opened on a work day
+// is not flagged as a day off after midnight
+func TestFindMeetingTimesOvernightDayOff(t *testing.T) {
+	// Friday 22:00 to Saturday 06:00; Saturday is not a work day
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{{Name: "Night", Timezone: "UTC", WorkStart: "22:00", WorkEnd: "06:00"}},
+		Start:        time.Date(2025, 6, 14, 2, 0, 0, 0, time.UTC),
+		End:          time.Date(2025, 6, 14, 3, 0, 0, 0, time.UTC),
+		Duration:     time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	if p := slots[0].Participants[0]; !p.WithinHours || p.IsWeekend || len(slots[0].Flags) != 0 {
+		t.Errorf("Saturday 02:00 in a Friday night shift = %+v, flags %v; want within hours and no day off", p, slots[0].Flags)
+	}
+
+	// Saturday night's shift never opens, so Sunday 02:00 is a day off
+	slots, err = passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: []passageoftime.Participant{{Name: "Night", Timezone: "UTC", WorkStart: "22:00", WorkEnd: "06:00"}},
+		Start:        time.Date(2025, 6, 15, 2, 0, 0, 0, time.UTC),
+		End:          time.Date(2025, 6, 15, 3, 0, 0, 0, time.UTC),
+		Duration:     time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("FindMeetingTimes() error = %v", err)
+	}
+	if p := slots[0].Participants[0]; p.WithinHours || !p.IsWeekend {
+		t.Errorf("Sunday 02:00 = %+v, want outside hours on a day off", p)
+	}
+}
+
+// TestHandleFindMeetingTimes tests the find_meeting_times handler
+func TestHandleFindMeetingTimes(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         FindMeetingTimesArgs
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name: "two participants",
+			args: FindMeetingTimesArgs{
+				Participants: []MeetingParticipantArgs{
+					{Name: "Berlin", Timezone: "Europe/Berlin"},
+					{Name: "Tokyo", Timezone: "Asia/Tokyo", WorkStart: "08:00", WorkEnd: "18:00", WorkDays: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}},
+				},
+				StartDate:       "2025-06-10",
+				EndDate:         "2025-06-10",
+				DurationMinutes: 60,
+				RequireAll:      true,
+			},
+			wantContains: []string{"all_within_hours:true", "rank:1", "JST", "CEST"},
+		},
+		{
+			name:    "no participants",
+			args:    FindMeetingTimesArgs{StartDate: "2025-06-10"},
+			wantErr: true,
+		},
+		{
+			name: "invalid weekday",
+			args: FindMeetingTimesArgs{
+				Participants: []MeetingParticipantArgs{{Timezone: "UTC", WorkDays: []string{"Funday"}}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &mcp.CallToolParamsFor[FindMeetingTimesArgs]{Arguments: tt.args}
+			got, err := handleFindMeetingTimes(context.Background(), nil, params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handleFindMeetingTimes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			text := got.Content[0].(*mcp.TextContent).Text
+			for _, want := range tt.wantContains {
+				if !strings.Contains(text, want) {
+					t.Errorf("handleFindMeetingTimes() = %v, want to contain %v", text, want)
+				}
+			}
+		})
+	}
+}
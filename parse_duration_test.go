@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestParseDuration tests the accepted syntaxes and languages
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		seconds float64
+		iso     string
+	}{
+		{"2 hours 30 minutes", 9000, "PT2H30M"},
+		{"1d 4h", 100800, "P1DT4H"},
+		{"a fortnight", 1209600, "P14D"},
+		{"half an hour", 1800, "PT30M"},
+		{"an hour and a half", 5400, "PT1H30M"},
+		{"one and a half hours", 5400, "PT1H30M"},
+		{"two and a half days", 216000, "P2DT12H"},
+		{"1 and a half hour", 5400, "PT1H30M"},
+		{"2 hours and a half", 9000, "PT2H30M"},
+		{"1:15:30", 4530, "PT1H15M30S"},
+		{"PT2H", 7200, "PT2H"},
+		{"-P1W", -604800, "-P7D"},
+		{"2h30m", 9000, "PT2H30M"},
+		{"3 days ago", -259200, "-P3D"},
+		{"vor 3 Tagen", -259200, "-P3D"},
+		{"1,5 Stunden", 5400, "PT1H30M"},
+		{"через 2 часа", 7200, "PT2H"},
+		{"2小时30分钟", 9000, "PT2H30M"},
+		{"une demi-heure", 1800, "PT30M"},
+		{"1 año y 2 meses", 31556952 + 2*2629746, "P1Y2M"},
+	}
+	for _, tt := range tests {
+		d, err := passageoftime.ParseDuration(tt.input)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) error = %v", tt.input, err)
+			continue
+		}
+		if d.Seconds() != tt.seconds || d.ISO8601() != tt.iso {
+			t.Errorf("ParseDuration(%q) = %v s, %s; want %v s, %s", tt.input, d.Seconds(), d.ISO8601(), tt.seconds, tt.iso)
+		}
+	}
+}
+
+// TestParseDurationAmbiguous tests that ambiguous input is rejected with a reason
+func TestParseDurationAmbiguous(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"90", "needs a unit"},
+		{"1:30", "hours:minutes or minutes:seconds"},
+		{"a few days", "not a definite amount"},
+		{"1.5 months", "vary in length"},
+		{"2 hours 3 hours", "more than once"},
+		{"3 parsecs", "unknown unit"},
+		{"10000000000 hours", "out of range"},
+		{"10000000000:00:00", "out of range"},
+		{"PT9999999999H", "out of range"},
+	}
+	for _, tt := range tests {
+		_, err := passageoftime.ParseDuration(tt.input)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseDuration(%q) error = %v, want it to mention %q", tt.input, err, tt.want)
+		}
+	}
+}
+
+// TestHandleParseDuration tests the parse_duration handler with calendar components
+func TestHandleParseDuration(t *testing.T) {
+	args := ParseDurationArgs{Duration: "1 month 2 days", Reference: "2025-01-31 00:00:00"}
+	got, err := handleParseDuration(context.Background(), nil, &mcp.CallToolParamsFor[ParseDurationArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleParseDuration() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"months:1", "days:2", "exact:false", "note:", "end:2025-03-05T00:00:00Z", "seconds_from_reference:2.8512e+06"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleParseDuration() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args = ParseDurationArgs{Duration: "1 hour", Reference: "2025-03-09 02:30", Timezone: "America/New_York"}
+	got, err = handleParseDuration(context.Background(), nil, &mcp.CallToolParamsFor[ParseDurationArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleParseDuration() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"nonexistent_local_time:true", "reference:2025-03-09T03:30:00-04:00", "end:2025-03-09T04:30:00-04:00"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleParseDuration() = %v, want to contain %v", text, want)
+		}
+	}
+
+	if _, err := handleParseDuration(context.Background(), nil, &mcp.CallToolParamsFor[ParseDurationArgs]{Arguments: ParseDurationArgs{Duration: "1:30"}}); err == nil {
+		t.Error("handleParseDuration() should reject an ambiguous duration")
+	}
+}
@@ -0,0 +1,199 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AnalyzedTimestamp is one entry of a timestamp list
+type AnalyzedTimestamp struct {
+	// Index is the entry's 0-based position in the input
+	Index int
+
+	// Input is the text as given
+	Input string
+
+	// Time is the parsed instant (zero when Error is set)
+	Time time.Time
+
+	// Error explains why the entry could not be parsed
+	Error string
+
+	// Resolution describes any DST gap or overlap resolved while parsing
+	Resolution LocalTimeResolution
+
+	// Flags lists problems with the entry: duplicate, future, outlier
+	Flags []string
+}
+
+// Parsed reports whether the entry was parsed
+func (a AnalyzedTimestamp) Parsed() bool {
+	return a.Error == ""
+}
+
+// TimestampAnalysis summarizes a list of timestamps
+type TimestampAnalysis struct {
+	// Entries holds every input in its original order
+	Entries []AnalyzedTimestamp
+
+	// Sorted holds the parsed entries in chronological order (stable for ties)
+	Sorted []AnalyzedTimestamp
+
+	// Gaps are the durations between consecutive sorted entries
+	Gaps []time.Duration
+
+	MinGap, MaxGap, MeanGap, MedianGap time.Duration
+
+	// MaxGapAfter is the position in Sorted of the entry before the largest gap
+	MaxGapAfter int
+
+	// Span is the time from the earliest to the latest entry
+	Span time.Duration
+
+	ParsedCount, UnparseableCount, DuplicateCount, FutureCount, OutlierCount int
+}
+
+// AnalyzeTimestamps parses each input with ParseFuzzyTimestampDetailed, sorts the
+// parsed instants and computes gap statistics. Entries are flagged when they
+// repeat an earlier instant, lie after now, or fall outside the Tukey fences
+// (1.5 interquartile ranges beyond the quartiles) of the list.
+func AnalyzeTimestamps(inputs []string, options ParseOptions, now time.Time) *TimestampAnalysis {
+	analysis := &TimestampAnalysis{Entries: make([]AnalyzedTimestamp, len(inputs)), MaxGapAfter: -1}
+
+	// Duplicates are keyed on seconds and nanoseconds separately; UnixNano is
+	// undefined outside 1678-2262
+	type instant struct {
+		sec  int64
+		nsec int
+	}
+	seen := map[instant]int{}
+	for i, input := range inputs {
+		entry := AnalyzedTimestamp{Index: i, Input: input}
+		parsed, err := ParseFuzzyTimestampDetailed(strings.TrimSpace(input), options)
+		switch {
+		case strings.TrimSpace(input) == "":
+			entry.Error = "empty entry"
+		case err != nil:
+			entry.Error = err.Error()
+		default:
+			t := parsed.Time
+			entry.Time = t
+			entry.Resolution = parsed.Resolution
+			key := instant{t.Unix(), t.Nanosecond()}
+			if first, ok := seen[key]; ok {
+				entry.Flags = append(entry.Flags, fmt.Sprintf("duplicate of index %d", first))
+				analysis.DuplicateCount++
+			} else {
+				seen[key] = i
+			}
+			if t.After(now) {
+				entry.Flags = append(entry.Flags, fmt.Sprintf("in the future (%s after now)", formatDuration(t.Sub(now).Seconds(), "compact", false)))
+				analysis.FutureCount++
+			}
+		}
+		if entry.Error != "" {
+			analysis.UnparseableCount++
+		} else {
+			analysis.ParsedCount++
+		}
+		analysis.Entries[i] = entry
+	}
+
+	flagOutliers(analysis)
+
+	for _, entry := range analysis.Entries {
+		if entry.Parsed() {
+			analysis.Sorted = append(analysis.Sorted, entry)
+		}
+	}
+	sort.SliceStable(analysis.Sorted, func(i, j int) bool {
+		return analysis.Sorted[i].Time.Before(analysis.Sorted[j].Time)
+	})
+
+	n := len(analysis.Sorted)
+	if n == 0 {
+		return analysis
+	}
+	analysis.Span = analysis.Sorted[n-1].Time.Sub(analysis.Sorted[0].Time)
+	if n < 2 {
+		return analysis
+	}
+
+	var total time.Duration
+	for i := 1; i < n; i++ {
+		gap := analysis.Sorted[i].Time.Sub(analysis.Sorted[i-1].Time)
+		analysis.Gaps = append(analysis.Gaps, gap)
+		total += gap
+		if i == 1 || gap < analysis.MinGap {
+			analysis.MinGap = gap
+		}
+		if i == 1 || gap > analysis.MaxGap {
+			analysis.MaxGap = gap
+			analysis.MaxGapAfter = i - 1
+		}
+	}
+	analysis.MeanGap = total / time.Duration(len(analysis.Gaps))
+
+	sorted := append([]time.Duration{}, analysis.Gaps...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	if m := len(sorted); m%2 == 1 {
+		analysis.MedianGap = sorted[m/2]
+	} else {
+		analysis.MedianGap = (sorted[m/2-1] + sorted[m/2]) / 2
+	}
+	return analysis
+}
+
+// flagOutliers marks parsed entries beyond the Tukey fences. At least four
+// entries are needed for meaningful quartiles.
+func flagOutliers(analysis *TimestampAnalysis) {
+	var values []float64
+	for _, entry := range analysis.Entries {
+		if entry.Parsed() {
+			values = append(values, float64(entry.Time.Unix()))
+		}
+	}
+	if len(values) < 4 {
+		return
+	}
+	sort.Float64s(values)
+	q1, q3 := quantile(values, 0.25), quantile(values, 0.75)
+	iqr := q3 - q1
+	if iqr == 0 {
+		return
+	}
+	low, high := q1-1.5*iqr, q3+1.5*iqr
+
+	for i, entry := range analysis.Entries {
+		if !entry.Parsed() {
+			continue
+		}
+		v := float64(entry.Time.Unix())
+		var reason string
+		switch {
+		case v < low:
+			reason = fmt.Sprintf("outlier (%s before the first quartile; interquartile range %s)", formatDuration(q1-v, "compact", false), formatDuration(iqr, "compact", false))
+		case v > high:
+			reason = fmt.Sprintf("outlier (%s after the third quartile; interquartile range %s)", formatDuration(v-q3, "compact", false), formatDuration(iqr, "compact", false))
+		default:
+			continue
+		}
+		analysis.Entries[i].Flags = append(analysis.Entries[i].Flags, reason)
+		analysis.OutlierCount++
+	}
+}
+
+// quantile returns the q-quantile of sorted values by linear interpolation
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lower := math.Floor(pos)
+	frac := pos - lower
+	i := int(lower)
+	if i+1 >= len(sorted) {
+		return sorted[i]
+	}
+	return sorted[i] + frac*(sorted[i+1]-sorted[i])
+}
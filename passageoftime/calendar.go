@@ -0,0 +1,150 @@
+package passageoftime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarInfo describes where a date falls in the calendar
+type CalendarInfo struct {
+	// ISOYear and ISOWeek are the ISO 8601 week-year and week number (weeks
+	// start on Monday; week 1 contains the year's first Thursday)
+	ISOYear int
+	ISOWeek int
+
+	// USWeek is the US week number (weeks start on Sunday; week 1 contains January 1)
+	USWeek int
+
+	// Quarter is the calendar quarter (1-4)
+	Quarter int
+
+	// DayOfYear is the ordinal day (1-366)
+	DayOfYear int
+
+	DaysInMonth int
+	DaysInYear  int
+	IsLeapYear  bool
+
+	// FiscalYearStartMonth is the first month of the fiscal year
+	FiscalYearStartMonth time.Month
+
+	// FiscalYear is the calendar year in which the fiscal year ends (a fiscal
+	// year starting October 2025 is FY2026); with a January start it equals the
+	// calendar year. FiscalYearLabel names it under other conventions.
+	FiscalYear int
+
+	// FiscalQuarter is the quarter within the fiscal year (1-4)
+	FiscalQuarter int
+
+	// Progress reports how far through the day, week, month, quarter and year t is
+	Progress []PeriodProgress
+}
+
+// FiscalYearNaming selects which calendar year a fiscal year is named after
+type FiscalYearNaming string
+
+const (
+	// FiscalYearNamedByEnd names the fiscal year after the year it ends in, as
+	// the US federal government and Australia do (October 2025 starts FY2026)
+	FiscalYearNamedByEnd FiscalYearNaming = "end"
+
+	// FiscalYearNamedByStart names the fiscal year after the year it starts in,
+	// as Japan does (April 2025 starts fiscal 2025)
+	FiscalYearNamedByStart FiscalYearNaming = "start"
+
+	// FiscalYearNamedBySpan names both years, as India and the UK do (FY2025-26)
+	FiscalYearNamedBySpan FiscalYearNaming = "span"
+)
+
+// ParseFiscalYearNaming validates a naming convention; an empty name selects end
+func ParseFiscalYearNaming(name string) (FiscalYearNaming, error) {
+	switch naming := FiscalYearNaming(strings.ToLower(strings.TrimSpace(name))); naming {
+	case "":
+		return FiscalYearNamedByEnd, nil
+	case FiscalYearNamedByEnd, FiscalYearNamedByStart, FiscalYearNamedBySpan:
+		return naming, nil
+	}
+	return "", fmt.Errorf("invalid fiscal year naming '%s' (expected end, start, or span)", name)
+}
+
+// FiscalYearLabel names the fiscal year under the given convention, e.g.
+// FY2026, FY2025 or FY2025-26 for the year starting October 2025. A fiscal
+// year starting in January is the calendar year under every convention.
+func (c *CalendarInfo) FiscalYearLabel(naming FiscalYearNaming) string {
+	startYear := c.FiscalYear
+	if c.FiscalYearStartMonth != time.January {
+		startYear--
+	}
+	switch {
+	case naming == FiscalYearNamedByStart:
+		return fmt.Sprintf("FY%d", startYear)
+	case naming == FiscalYearNamedBySpan && startYear != c.FiscalYear:
+		return fmt.Sprintf("FY%d-%02d", startYear, c.FiscalYear%100)
+	}
+	return fmt.Sprintf("FY%d", c.FiscalYear)
+}
+
+// PeriodProgress is the elapsed fraction of a calendar period
+type PeriodProgress struct {
+	// Period is "day", "week", "month", "quarter" or "year"
+	Period string
+
+	Start time.Time
+	End   time.Time
+
+	// Percent is the elapsed share of the period in real (absolute) time, 0-100
+	Percent float64
+}
+
+// GetCalendarInfo returns calendar facts for t on the wall clock of t's location.
+// A fiscalStart of 0 selects January.
+func GetCalendarInfo(t time.Time, fiscalStart time.Month) (*CalendarInfo, error) {
+	if fiscalStart == 0 {
+		fiscalStart = time.January
+	}
+	if fiscalStart < time.January || fiscalStart > time.December {
+		return nil, fmt.Errorf("invalid fiscal year start month: %d", fiscalStart)
+	}
+
+	year, month, _ := t.Date()
+	isoYear, isoWeek := t.ISOWeek()
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	daysInYear := int(jan1.AddDate(1, 0, 0).Sub(jan1).Hours() / 24)
+
+	fiscalMonth := (int(month) - int(fiscalStart) + 12) % 12
+	fiscalYear := fiscalYearStart(civilTime(t), fiscalStart).Year()
+	if fiscalStart != time.January {
+		fiscalYear++
+	}
+
+	info := &CalendarInfo{
+		ISOYear:              isoYear,
+		ISOWeek:              isoWeek,
+		USWeek:               (t.YearDay()-1+int(jan1.Weekday()))/7 + 1,
+		Quarter:              (int(month)-1)/3 + 1,
+		DayOfYear:            t.YearDay(),
+		DaysInMonth:          time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(),
+		DaysInYear:           daysInYear,
+		IsLeapYear:           daysInYear == 366,
+		FiscalYearStartMonth: fiscalStart,
+		FiscalYear:           fiscalYear,
+		FiscalQuarter:        fiscalMonth/3 + 1,
+	}
+
+	for _, unit := range []RoundUnit{RoundDay, RoundWeek, RoundMonth, RoundQuarter, RoundYear} {
+		period, err := RoundTimestamp(t, RoundOptions{Unit: unit})
+		if err != nil {
+			return nil, err
+		}
+		length := period.PeriodEnd.Sub(period.PeriodStart)
+		info.Progress = append(info.Progress, PeriodProgress{
+			Period:  string(unit),
+			Start:   period.PeriodStart,
+			End:     period.PeriodEnd,
+			Percent: float64(t.Sub(period.PeriodStart)) / float64(length) * 100,
+		})
+	}
+
+	return info, nil
+}
@@ -0,0 +1,342 @@
+// Package calendars converts dates between the Gregorian calendar and other
+// calendar systems.
+//
+// Every calendar maps its dates to and from a Julian Day Number (JDN), the
+// count of days since 1 January 4713 BC (Julian), so any two calendars can be
+// converted through it. All calculations work offline: most calendars are
+// arithmetic, while the Chinese and Umm al-Qura calendars are computed from
+// the positions of the sun and moon.
+package calendars
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Date is a date in a particular calendar
+type Date struct {
+	// Calendar is the ID of the calendar the date belongs to
+	Calendar string
+
+	// Era names the era the year is counted in (e.g. "Reiwa", "BE", "AP")
+	Era string
+
+	// Year is the year within the era
+	Year int
+
+	// Month is the 1-based month number, or 0 when only the year is known
+	Month int
+
+	// Day is the 1-based day of the month (0 when only the year is known)
+	Day int
+
+	// LeapMonth marks an intercalary month that repeats the number of the
+	// month before it (Chinese calendar)
+	LeapMonth bool
+}
+
+// IsYearOnly reports whether the date names a whole year
+func (d Date) IsYearOnly() bool {
+	return d.Month == 0
+}
+
+// String renders the date numerically, e.g. "1404-01-01" or "Reiwa 7-03-15"
+func (d Date) String() string {
+	year := strconv.Itoa(d.Year)
+	if d.Era != "" && eraIsPartOfYear(d.Calendar) {
+		year = d.Era + " " + year
+	}
+	if d.IsYearOnly() {
+		return year
+	}
+	leap := ""
+	if d.LeapMonth {
+		leap = "L"
+	}
+	return fmt.Sprintf("%s-%s%02d-%02d", year, leap, d.Month, d.Day)
+}
+
+// Calendar converts dates of one calendar system to and from Julian Day Numbers
+type Calendar interface {
+	// ID is the canonical identifier (e.g. "persian")
+	ID() string
+
+	// Name is the display name (e.g. "Persian (Solar Hijri)")
+	Name() string
+
+	// FromJDN returns the date for a Julian Day Number
+	FromJDN(jdn int) (Date, error)
+
+	// ToJDN returns the Julian Day Number of a full date, validating it
+	ToJDN(d Date) (int, error)
+
+	// MonthName returns the name of the date's month
+	MonthName(d Date) string
+
+	// Format renders the date in words (e.g. "1 Farvardin 1404 AP")
+	Format(d Date) string
+
+	// Parse reads a date written in this calendar. A year on its own yields
+	// a year-only date (Month 0).
+	Parse(text string) (Date, error)
+}
+
+// yearSpanner is implemented by calendars whose years do not simply run from
+// month 1 day 1 to the day before the next year's month 1 day 1
+type yearSpanner interface {
+	yearSpan(d Date) (first, last int, err error)
+}
+
+var (
+	registry = map[string]Calendar{}
+	aliases  = map[string]string{}
+)
+
+// register adds a calendar under its ID and any aliases
+func register(c Calendar, names ...string) {
+	registry[c.ID()] = c
+	aliases[c.ID()] = c.ID()
+	for _, name := range names {
+		aliases[name] = c.ID()
+	}
+}
+
+// Get returns a calendar by ID or alias (e.g. "persian", "jalali", "solar_hijri")
+func Get(name string) (Calendar, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+	if id, ok := aliases[key]; ok {
+		return registry[id], nil
+	}
+	return nil, fmt.Errorf("unknown calendar '%s' (available: %s)", name, strings.Join(IDs(), ", "))
+}
+
+// IDs returns the canonical IDs of all calendars, sorted
+func IDs() []string {
+	ids := make([]string, 0, len(registry))
+	for id := range registry {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// FromTime returns the date in c of t's calendar day (in t's location)
+func FromTime(c Calendar, t time.Time) (Date, error) {
+	y, m, d := t.Date()
+	return c.FromJDN(GregorianToJDN(y, int(m), d))
+}
+
+// ToTime returns midnight in loc of the Gregorian day of d
+func ToTime(c Calendar, d Date, loc *time.Location) (time.Time, error) {
+	jdn, err := c.ToJDN(d)
+	if err != nil {
+		return time.Time{}, err
+	}
+	y, m, day := JDNToGregorian(jdn)
+	return time.Date(y, time.Month(m), day, 0, 0, 0, 0, loc), nil
+}
+
+// YearSpan returns the Julian Day Numbers of the first and last day of d's year
+func YearSpan(c Calendar, d Date) (first, last int, err error) {
+	if s, ok := c.(yearSpanner); ok {
+		return s.yearSpan(d)
+	}
+	first, err = c.ToJDN(Date{Calendar: d.Calendar, Era: d.Era, Year: d.Year, Month: 1, Day: 1})
+	if err != nil {
+		return 0, 0, err
+	}
+	next, err := c.ToJDN(Date{Calendar: d.Calendar, Era: d.Era, Year: d.Year + 1, Month: 1, Day: 1})
+	if err != nil {
+		return 0, 0, err
+	}
+	return first, next - 1, nil
+}
+
+// GregorianToJDN returns the Julian Day Number of a proleptic Gregorian date
+func GregorianToJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// JDNToGregorian returns the proleptic Gregorian date of a Julian Day Number
+func JDNToGregorian(jdn int) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = 100*b + d - 4800 + m/10
+	return year, month, day
+}
+
+// gregorianDaysInMonth returns the length of a Gregorian month
+func gregorianDaysInMonth(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// eraIsPartOfYear reports whether a calendar's years are meaningless without
+// the era (Japanese eras restart at 1)
+func eraIsPartOfYear(calendar string) bool {
+	return calendar == "japanese"
+}
+
+var gregorianMonthNames = []string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+// dateParts are the pieces of a written date
+type dateParts struct {
+	year, month, day int
+	yearOnly         bool
+}
+
+// digitReplacer maps Persian, Arabic-Indic and fullwidth digits to ASCII
+var digitReplacer = strings.NewReplacer(
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4", "۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9",
+	"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+	"０", "0", "１", "1", "２", "2", "３", "3", "４", "4", "５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+)
+
+// tokenize lowercases text, normalizes digits and splits it into runs of
+// letters and runs of digits; CJK date markers (年月日) act as separators
+func tokenize(text string) []string {
+	text = strings.ToLower(digitReplacer.Replace(text))
+	var tokens []string
+	var current []rune
+	kind := 0 // 1 = digits, 2 = letters
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, string(current))
+			current = current[:0]
+		}
+		kind = 0
+	}
+	for _, r := range text {
+		switch {
+		case r == '年' || r == '月' || r == '日':
+			flush()
+		case unicode.IsDigit(r):
+			if kind != 1 {
+				flush()
+			}
+			kind = 1
+			current = append(current, r)
+		case unicode.IsLetter(r) || unicode.Is(unicode.Mn, r):
+			if kind != 2 {
+				flush()
+			}
+			kind = 2
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// parseDateParts reads year, month and day from text. Month names are matched
+// case-insensitively (or by an unambiguous prefix of at least three letters),
+// words listed in ignore (era markers) are skipped. Numeric dates are read as
+// year-month-day, or day-month-year when the last number is the longest.
+func parseDateParts(text string, monthNames []string, ignore []string) (dateParts, error) {
+	var nums []string
+	month := 0
+	for _, tok := range tokenize(text) {
+		if tok[0] >= '0' && tok[0] <= '9' {
+			nums = append(nums, tok)
+			continue
+		}
+		if containsString(ignore, tok) {
+			continue
+		}
+		m := matchMonthName(tok, monthNames)
+		if m == 0 || month != 0 {
+			return dateParts{}, fmt.Errorf("unrecognized word '%s' in date '%s'", tok, text)
+		}
+		month = m
+	}
+
+	values := make([]int, len(nums))
+	for i, n := range nums {
+		v, err := strconv.Atoi(n)
+		if err != nil {
+			return dateParts{}, fmt.Errorf("invalid number '%s' in date '%s'", n, text)
+		}
+		values[i] = v
+	}
+
+	switch {
+	case month != 0 && len(values) == 2:
+		// "1 Farvardin 1404" or "Farvardin 1, 1404" or "1404 Farvardin 1"
+		if len(nums[0]) >= 3 {
+			return dateParts{year: values[0], month: month, day: values[1]}, nil
+		}
+		return dateParts{year: values[1], month: month, day: values[0]}, nil
+	case month == 0 && len(values) == 1:
+		return dateParts{year: values[0], yearOnly: true}, nil
+	case month == 0 && len(values) == 3:
+		if len(nums[2]) > len(nums[0]) {
+			return dateParts{year: values[2], month: values[1], day: values[0]}, nil
+		}
+		return dateParts{year: values[0], month: values[1], day: values[2]}, nil
+	}
+	return dateParts{}, fmt.Errorf("cannot read a date from '%s' (expected year-month-day, a month name with day and year, or a year)", text)
+}
+
+// matchMonthName returns the 1-based month number matching word, or 0
+func matchMonthName(word string, names []string) int {
+	match := 0
+	for i, name := range names {
+		lower := strings.ToLower(name)
+		if word == lower || strings.ReplaceAll(lower, " ", "") == word {
+			return i + 1
+		}
+		if len([]rune(word)) >= 3 && strings.HasPrefix(lower, word) {
+			if match != 0 {
+				return 0 // ambiguous prefix
+			}
+			match = i + 1
+		}
+	}
+	return match
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// toDate builds a Date from parsed parts
+func (p dateParts) toDate(calendar, era string) Date {
+	if p.yearOnly {
+		return Date{Calendar: calendar, Era: era, Year: p.year}
+	}
+	return Date{Calendar: calendar, Era: era, Year: p.year, Month: p.month, Day: p.day}
+}
+
+// checkMonthDay validates month and day against a month-length function
+func checkMonthDay(d Date, months int, daysIn func(year, month int) int) error {
+	if d.Month < 1 || d.Month > months {
+		return fmt.Errorf("invalid month %d (expected 1-%d)", d.Month, months)
+	}
+	if n := daysIn(d.Year, d.Month); d.Day < 1 || d.Day > n {
+		return fmt.Errorf("invalid day %d (month %d of year %d has %d days)", d.Day, d.Month, d.Year, n)
+	}
+	return nil
+}
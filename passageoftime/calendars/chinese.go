@@ -0,0 +1,261 @@
+package calendars
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// chineseCalendar is the Chinese lunisolar calendar (农历) under the modern
+// rules (GB/T 33661-2017), computed for China Standard Time (UTC+8):
+//
+//   - each month starts on the day of a new moon
+//   - month 11 contains the December solstice
+//   - when 13 months separate two such month 11s, the first of them without
+//     a major solar term (zhongqi) is a leap month and repeats the number of
+//     the month before it
+//
+// Years are numbered by the Gregorian year in which they begin, so 2025 is
+// the year that starts on Lunar New Year 2025 (29 January).
+type chineseCalendar struct{}
+
+var chineseMonthNames = []string{
+	"Zhengyue", "Eryue", "Sanyue", "Siyue", "Wuyue", "Liuyue",
+	"Qiyue", "Bayue", "Jiuyue", "Shiyue", "Dongyue", "Layue",
+}
+
+var (
+	chineseStems    = []string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"}
+	chineseBranches = []string{"Zi", "Chou", "Yin", "Mao", "Chen", "Si", "Wu", "Wei", "Shen", "You", "Xu", "Hai"}
+	chineseAnimals  = []string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+)
+
+// chineseLeapWords mark the month that follows them as a leap month
+var chineseLeapWords = []string{"leap", "l", "run", "intercalary", "闰", "閏"}
+
+// Chinese dates are computed for years 1900-2100; the simplified astronomy
+// is not accurate enough to date new moons near midnight outside that range
+const (
+	chineseFirstYear = 1900
+	chineseLastYear  = 2100
+)
+
+// chineseMonth is a month of a Chinese year
+type chineseMonth struct {
+	start, days int
+	number      int
+	leap        bool
+}
+
+var chineseYearCache sync.Map // year -> []chineseMonth
+
+func init() {
+	register(chineseCalendar{}, "lunar", "chinese_lunar", "nongli", "lunisolar")
+}
+
+func (chineseCalendar) ID() string   { return "chinese" }
+func (chineseCalendar) Name() string { return "Chinese (lunisolar)" }
+
+// beijingDay returns the Julian Day Number of the calendar day in UTC+8 on
+// which the instant jd falls
+func beijingDay(jd float64) int {
+	return int(math.Floor(jd + 0.5 + 8.0/24))
+}
+
+// newMoonOnOrBefore returns the index of the new moon that starts the
+// Chinese month containing day
+func newMoonOnOrBefore(day int) float64 {
+	endOfDay := float64(day) + 0.5 - 8.0/24
+	return internal.NewMoonIndex(endOfDay - 1e-9)
+}
+
+// solstice returns the instant of the December solstice of year
+func solstice(year int) float64 {
+	return internal.SolarTerm(270, float64(GregorianToJDN(year, 12, 21)))
+}
+
+// chineseSui returns the months from the month 11 containing the December
+// solstice of year-1 up to (excluding) the month 11 of year, numbered and
+// with the leap month marked
+func chineseSui(year int) []chineseMonth {
+	first, last := solstice(year-1), solstice(year)
+	k0 := newMoonOnOrBefore(beijingDay(first))
+	k1 := newMoonOnOrBefore(beijingDay(last))
+	n := int(k1 - k0)
+
+	starts := make([]int, n+1)
+	for i := range starts {
+		starts[i] = beijingDay(internal.NewMoon(k0 + float64(i)))
+	}
+
+	leapIndex := -1
+	if n == 13 {
+		// Days of the major solar terms, every 30° from the first solstice
+		var terms []int
+		for j := 0; j <= 13; j++ {
+			terms = append(terms, beijingDay(internal.SolarTerm(math.Mod(270+30*float64(j), 360), first+30.44*float64(j))))
+		}
+		for i := 0; i < n && leapIndex < 0; i++ {
+			hasTerm := false
+			for _, term := range terms {
+				if term >= starts[i] && term < starts[i+1] {
+					hasTerm = true
+					break
+				}
+			}
+			if !hasTerm {
+				leapIndex = i
+			}
+		}
+	}
+
+	months := make([]chineseMonth, n)
+	number := 11
+	for i := range months {
+		leap := i == leapIndex
+		if i > 0 && !leap {
+			number = number%12 + 1
+		}
+		months[i] = chineseMonth{start: starts[i], days: starts[i+1] - starts[i], number: number, leap: leap}
+	}
+	return months
+}
+
+// chineseYear returns the months of the Chinese year beginning in year
+func chineseYear(year int) ([]chineseMonth, error) {
+	if year < chineseFirstYear || year > chineseLastYear {
+		return nil, fmt.Errorf("Chinese calendar dates are supported for years %d-%d", chineseFirstYear, chineseLastYear)
+	}
+	if months, ok := chineseYearCache.Load(year); ok {
+		return months.([]chineseMonth), nil
+	}
+
+	var months []chineseMonth
+	started := false
+	for _, m := range append(chineseSui(year), chineseSui(year+1)...) {
+		if m.number == 1 && !m.leap {
+			if started {
+				break
+			}
+			started = true
+		}
+		if started {
+			months = append(months, m)
+		}
+	}
+
+	chineseYearCache.Store(year, months)
+	return months, nil
+}
+
+// findChineseMonth returns the month of year with the given number
+func findChineseMonth(year, number int, leap bool) (chineseMonth, error) {
+	months, err := chineseYear(year)
+	if err != nil {
+		return chineseMonth{}, err
+	}
+	leapNumber := 0
+	for _, m := range months {
+		if m.number == number && m.leap == leap {
+			return m, nil
+		}
+		if m.leap {
+			leapNumber = m.number
+		}
+	}
+	if number < 1 || number > 12 {
+		return chineseMonth{}, fmt.Errorf("invalid month %d (expected 1-12)", number)
+	}
+	if leapNumber == 0 {
+		return chineseMonth{}, fmt.Errorf("Chinese year %d has no leap month", year)
+	}
+	return chineseMonth{}, fmt.Errorf("Chinese year %d has no leap month %d (its leap month is %d)", year, number, leapNumber)
+}
+
+func (chineseCalendar) FromJDN(jdn int) (Date, error) {
+	gy, _, _ := JDNToGregorian(jdn)
+	for _, year := range []int{gy, gy - 1} {
+		months, err := chineseYear(year)
+		if err != nil {
+			return Date{}, err
+		}
+		if jdn < months[0].start {
+			continue
+		}
+		for _, m := range months {
+			if jdn < m.start+m.days {
+				return Date{Calendar: "chinese", Year: year, Month: m.number, Day: jdn - m.start + 1, LeapMonth: m.leap}, nil
+			}
+		}
+	}
+	return Date{}, fmt.Errorf("no Chinese date for Julian Day %d", jdn)
+}
+
+func (chineseCalendar) ToJDN(d Date) (int, error) {
+	m, err := findChineseMonth(d.Year, d.Month, d.LeapMonth)
+	if err != nil {
+		return 0, err
+	}
+	if d.Day < 1 || d.Day > m.days {
+		return 0, fmt.Errorf("invalid day %d (month %d of Chinese year %d has %d days)", d.Day, d.Month, d.Year, m.days)
+	}
+	return m.start + d.Day - 1, nil
+}
+
+func (chineseCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	if d.LeapMonth {
+		return "Leap " + chineseMonthNames[d.Month-1]
+	}
+	return chineseMonthNames[d.Month-1]
+}
+
+// chineseYearName returns the sexagenary name and zodiac animal of year,
+// e.g. "Yisi, Year of the Snake" for 2025
+func chineseYearName(year int) string {
+	stem := ((year-4)%10 + 10) % 10
+	branch := ((year-4)%12 + 12) % 12
+	return fmt.Sprintf("%s%s, Year of the %s", chineseStems[stem], strings.ToLower(chineseBranches[branch]), chineseAnimals[branch])
+}
+
+func (c chineseCalendar) Format(d Date) string {
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%d (%s)", d.Year, chineseYearName(d.Year))
+	}
+	return fmt.Sprintf("%d %s %d (%s)", d.Day, c.MonthName(d), d.Year, chineseYearName(d.Year))
+}
+
+// Parse reads dates such as "2025-01-01", "2025-L06-01", "1 Leap Liuyue 2025"
+// or "2025年闰6月1日"
+func (chineseCalendar) Parse(text string) (Date, error) {
+	leap := false
+	var kept []string
+	for _, tok := range tokenize(text) {
+		if containsString(chineseLeapWords, tok) {
+			leap = true
+			continue
+		}
+		for _, word := range chineseLeapWords[4:] {
+			if rest, ok := strings.CutPrefix(tok, word); ok {
+				leap, tok = true, rest
+			}
+		}
+		kept = append(kept, tok)
+	}
+
+	parts, err := parseDateParts(strings.Join(kept, " "), chineseMonthNames, []string{"nongli", "lunar"})
+	if err != nil {
+		return Date{}, err
+	}
+	if leap && parts.yearOnly {
+		return Date{}, fmt.Errorf("'%s' marks a leap month but has no month", text)
+	}
+	date := parts.toDate("chinese", "")
+	date.LeapMonth = leap
+	return date, nil
+}
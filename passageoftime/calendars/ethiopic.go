@@ -0,0 +1,91 @@
+package calendars
+
+import "fmt"
+
+// ethiopicCalendar covers the Ethiopian and Coptic calendars, which share the
+// same structure: twelve 30-day months followed by a 5-day (6 in leap years)
+// thirteenth month, with a leap year every fourth year. They differ only in epoch.
+type ethiopicCalendar struct {
+	id, name, era string
+
+	// epoch is the Julian Day Number of 1 Meskerem / 1 Thout of year 1
+	epoch int
+
+	monthNames []string
+	eraWords   []string
+}
+
+func init() {
+	register(&ethiopicCalendar{
+		id: "ethiopian", name: "Ethiopian", era: "EC", epoch: 1724221,
+		monthNames: []string{
+			"Meskerem", "Tikimt", "Hidar", "Tahsas", "Tir", "Yekatit", "Megabit",
+			"Miyazya", "Ginbot", "Sene", "Hamle", "Nehase", "Pagume",
+		},
+		eraWords: []string{"ec", "e", "c", "am", "a", "m"},
+	}, "ethiopic", "amete_mihret")
+	register(&ethiopicCalendar{
+		id: "coptic", name: "Coptic", era: "AM", epoch: 1825030,
+		monthNames: []string{
+			"Thout", "Paopi", "Hathor", "Koiak", "Tobi", "Meshir", "Paremhat",
+			"Parmouti", "Pashons", "Paoni", "Epip", "Mesori", "Pi Kogi Enavot",
+		},
+		eraWords: []string{"am", "a", "m"},
+	}, "alexandrian")
+}
+
+func (c *ethiopicCalendar) ID() string   { return c.id }
+func (c *ethiopicCalendar) Name() string { return c.name }
+
+func (c *ethiopicCalendar) daysInMonth(year, month int) int {
+	if month < 13 {
+		return 30
+	}
+	if year%4 == 3 {
+		return 6
+	}
+	return 5
+}
+
+func (c *ethiopicCalendar) FromJDN(jdn int) (Date, error) {
+	if jdn < c.epoch {
+		return Date{}, fmt.Errorf("date is before year 1 of the %s calendar", c.name)
+	}
+	year := (4*(jdn-c.epoch) + 1463) / 1461
+	start := c.epoch + 365*(year-1) + year/4
+	month := (jdn-start)/30 + 1
+	day := jdn - start - 30*(month-1) + 1
+	return Date{Calendar: c.id, Era: c.era, Year: year, Month: month, Day: day}, nil
+}
+
+func (c *ethiopicCalendar) ToJDN(d Date) (int, error) {
+	if d.Year < 1 {
+		return 0, fmt.Errorf("invalid %s year %d", c.name, d.Year)
+	}
+	if err := checkMonthDay(d, 13, c.daysInMonth); err != nil {
+		return 0, err
+	}
+	return c.epoch + 365*(d.Year-1) + d.Year/4 + 30*(d.Month-1) + d.Day - 1, nil
+}
+
+func (c *ethiopicCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 13 {
+		return ""
+	}
+	return c.monthNames[d.Month-1]
+}
+
+func (c *ethiopicCalendar) Format(d Date) string {
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%d %s", d.Year, c.era)
+	}
+	return fmt.Sprintf("%d %s %d %s", d.Day, c.MonthName(d), d.Year, c.era)
+}
+
+func (c *ethiopicCalendar) Parse(text string) (Date, error) {
+	parts, err := parseDateParts(text, c.monthNames, c.eraWords)
+	if err != nil {
+		return Date{}, err
+	}
+	return parts.toDate(c.id, c.era), nil
+}
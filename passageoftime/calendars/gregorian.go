@@ -0,0 +1,84 @@
+package calendars
+
+import "fmt"
+
+// offsetCalendar is a calendar with Gregorian months and days whose years are
+// counted from a different epoch
+type offsetCalendar struct {
+	id   string
+	name string
+
+	// era is the era label; eraFirst places it before the year when formatting
+	era      string
+	eraFirst bool
+
+	// offset is added to the Gregorian year
+	offset int
+
+	// eraWords are the words accepted (and ignored) when parsing
+	eraWords []string
+}
+
+func init() {
+	register(&offsetCalendar{id: "gregorian", name: "Gregorian", eraWords: []string{"ad", "ce"}},
+		"iso", "western")
+	register(&offsetCalendar{id: "buddhist", name: "Thai Buddhist", era: "BE", offset: 543,
+		eraWords: []string{"be", "b", "e", "พ", "ศ"}},
+		"thai", "thai_buddhist")
+	register(&offsetCalendar{id: "roc", name: "Republic of China (Minguo)", era: "ROC", eraFirst: true, offset: -1911,
+		eraWords: []string{"roc", "minguo", "民國", "民国"}},
+		"minguo", "taiwan", "republic_of_china")
+}
+
+func (c *offsetCalendar) ID() string   { return c.id }
+func (c *offsetCalendar) Name() string { return c.name }
+
+func (c *offsetCalendar) FromJDN(jdn int) (Date, error) {
+	y, m, d := JDNToGregorian(jdn)
+	date := Date{Calendar: c.id, Era: c.era, Year: y + c.offset, Month: m, Day: d}
+	if c.offset < 0 && date.Year < 1 {
+		return Date{}, fmt.Errorf("%s calendar starts in %d", c.name, 1-c.offset)
+	}
+	return date, nil
+}
+
+func (c *offsetCalendar) ToJDN(d Date) (int, error) {
+	if c.offset < 0 && d.Year < 1 {
+		return 0, fmt.Errorf("invalid %s year %d", c.name, d.Year)
+	}
+	year := d.Year - c.offset
+	if err := checkMonthDay(d, 12, func(_, month int) int { return gregorianDaysInMonth(year, month) }); err != nil {
+		return 0, err
+	}
+	return GregorianToJDN(year, d.Month, d.Day), nil
+}
+
+func (c *offsetCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	return gregorianMonthNames[d.Month-1]
+}
+
+func (c *offsetCalendar) Format(d Date) string {
+	year := fmt.Sprint(d.Year)
+	switch {
+	case c.era == "":
+	case c.eraFirst:
+		year = c.era + " " + year
+	default:
+		year += " " + c.era
+	}
+	if d.IsYearOnly() {
+		return year
+	}
+	return fmt.Sprintf("%d %s %s", d.Day, c.MonthName(d), year)
+}
+
+func (c *offsetCalendar) Parse(text string) (Date, error) {
+	parts, err := parseDateParts(text, gregorianMonthNames, c.eraWords)
+	if err != nil {
+		return Date{}, err
+	}
+	return parts.toDate(c.id, c.era), nil
+}
@@ -0,0 +1,216 @@
+package calendars
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// hebrewCalendar is the arithmetic Hebrew calendar fixed by Hillel II. Months
+// are numbered in the order of the year starting with Tishri, so month 1 day 1
+// is Rosh Hashanah. Leap years (7 of every 19) insert Adar I before Adar, which
+// shifts the numbers of Adar II through Elul by one.
+type hebrewCalendar struct{}
+
+// hebrewEpoch is the Julian Day Number of 1 Tishri AM 1 (7 October 3761 BC, Julian)
+const hebrewEpoch = 347998
+
+var (
+	hebrewMonthNames     = []string{"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar", "Nisan", "Iyar", "Sivan", "Tammuz", "Av", "Elul"}
+	hebrewLeapMonthNames = []string{"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar II", "Nisan", "Iyar", "Sivan", "Tammuz", "Av", "Elul"}
+)
+
+// hebrewParseNames lists accepted spellings; hebrewParseKeys maps each to its
+// month in a common year (Adar I and Adar II are 13 and 14 until the year is known)
+var (
+	hebrewParseNames = []string{
+		"Tishri", "Tishrei", "Heshvan", "Cheshvan", "Marcheshvan", "Kislev", "Kislew",
+		"Tevet", "Teves", "Shevat", "Shvat", "Adar", "Nisan", "Iyar", "Iyyar",
+		"Sivan", "Tammuz", "Tamuz", "Av", "Ab", "Elul", "Adar I", "Adar II",
+	}
+	hebrewParseKeys = []int{1, 1, 2, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 8, 9, 10, 10, 11, 11, 12, 13, 14}
+
+	hebrewAdarII = regexp.MustCompile(`(?i)\badar\s*(ii|bet|beit|sheni)\b`)
+	hebrewAdarI  = regexp.MustCompile(`(?i)\badar\s*(i|aleph|alef|rishon)\b`)
+)
+
+func init() {
+	register(hebrewCalendar{}, "jewish")
+}
+
+func (hebrewCalendar) ID() string   { return "hebrew" }
+func (hebrewCalendar) Name() string { return "Hebrew" }
+
+func hebrewLeapYear(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishri of
+// year, postponed a day when it would fall on Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	days := 29*months + parts/25920
+	if (3*(days+1))%7 < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYear returns the Julian Day Number of 1 Tishri of year, applying
+// the postponements that keep year lengths valid
+func hebrewNewYear(year int) int {
+	prev, cur, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	switch {
+	case next-cur == 356:
+		correction = 2
+	case cur-prev == 382:
+		correction = 1
+	}
+	return hebrewEpoch + cur + correction
+}
+
+func hebrewYearLength(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func hebrewMonthsInYear(year int) int {
+	if hebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewDaysInMonth returns the length of month (numbered from Tishri)
+func hebrewDaysInMonth(year, month int) int {
+	length := hebrewYearLength(year)
+	if hebrewLeapYear(year) {
+		if month == 6 {
+			return 30 // Adar I
+		}
+		if month > 6 {
+			month-- // Adar II onwards follow the common year pattern
+		}
+	}
+	switch month {
+	case 2: // Heshvan is 30 days in a complete year
+		if length%10 == 5 {
+			return 30
+		}
+		return 29
+	case 3: // Kislev is 29 days in a deficient year
+		if length%10 == 3 {
+			return 29
+		}
+		return 30
+	case 1, 5, 7, 9, 11:
+		return 30
+	default:
+		return 29
+	}
+}
+
+// hebrewMonthNumber returns the number of a named month in year: key is the
+// common-year month number, or 13/14 for Adar I/Adar II. Plain Adar is Adar I
+// in a leap year.
+func hebrewMonthNumber(year, key int) (int, error) {
+	leap := hebrewLeapYear(year)
+	switch {
+	case key >= 13 && !leap:
+		return 0, fmt.Errorf("Hebrew year %d is not a leap year and has only one Adar", year)
+	case key == 13:
+		return 6, nil
+	case key == 14:
+		return 7, nil
+	case leap && key > 6:
+		return key + 1, nil
+	}
+	return key, nil
+}
+
+func (hebrewCalendar) FromJDN(jdn int) (Date, error) {
+	if jdn < hebrewEpoch {
+		return Date{}, fmt.Errorf("date is before year 1 of the Hebrew calendar")
+	}
+	year := int(float64(jdn-hebrewEpoch)/365.2468) + 1
+	for hebrewNewYear(year+1) <= jdn {
+		year++
+	}
+	for hebrewNewYear(year) > jdn {
+		year--
+	}
+
+	day := jdn - hebrewNewYear(year)
+	month := 1
+	for n := hebrewDaysInMonth(year, month); day >= n; n = hebrewDaysInMonth(year, month) {
+		day -= n
+		month++
+	}
+	return Date{Calendar: "hebrew", Era: "AM", Year: year, Month: month, Day: day + 1}, nil
+}
+
+func (hebrewCalendar) ToJDN(d Date) (int, error) {
+	if d.Year < 1 {
+		return 0, fmt.Errorf("invalid Hebrew year %d", d.Year)
+	}
+	if err := checkMonthDay(d, hebrewMonthsInYear(d.Year), hebrewDaysInMonth); err != nil {
+		return 0, err
+	}
+	jdn := hebrewNewYear(d.Year)
+	for m := 1; m < d.Month; m++ {
+		jdn += hebrewDaysInMonth(d.Year, m)
+	}
+	return jdn + d.Day - 1, nil
+}
+
+func (hebrewCalendar) MonthName(d Date) string {
+	names := hebrewMonthNames
+	if hebrewLeapYear(d.Year) {
+		names = hebrewLeapMonthNames
+	}
+	if d.Month < 1 || d.Month > len(names) {
+		return ""
+	}
+	return names[d.Month-1]
+}
+
+func (c hebrewCalendar) Format(d Date) string {
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%d AM", d.Year)
+	}
+	return fmt.Sprintf("%d %s %d AM", d.Day, c.MonthName(d), d.Year)
+}
+
+// Parse reads dates such as "1 Tishri 5786", "14 Adar II 5784" or "5786-01-01"
+// (months numbered from Tishri)
+func (hebrewCalendar) Parse(text string) (Date, error) {
+	// Join "Adar II" into one word so it is not read as Adar followed by a number
+	normalized := hebrewAdarII.ReplaceAllString(text, "AdarII")
+	normalized = hebrewAdarI.ReplaceAllString(normalized, "AdarI")
+
+	parts, err := parseDateParts(normalized, hebrewParseNames, []string{"am", "a", "m"})
+	if err != nil {
+		return Date{}, err
+	}
+	if !parts.yearOnly && parts.month != 0 && tokensHaveMonthName(normalized, hebrewParseNames) {
+		month, err := hebrewMonthNumber(parts.year, hebrewParseKeys[parts.month-1])
+		if err != nil {
+			return Date{}, err
+		}
+		parts.month = month
+	}
+	return parts.toDate("hebrew", "AM"), nil
+}
+
+// tokensHaveMonthName reports whether text spells out one of names, as opposed
+// to giving the month as a number
+func tokensHaveMonthName(text string, names []string) bool {
+	for _, tok := range tokenize(text) {
+		if tok[0] < '0' || tok[0] > '9' {
+			if matchMonthName(tok, names) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package calendars
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Holiday is a festival fixed to a date in a non-Gregorian calendar
+type Holiday struct {
+	// ID is the canonical identifier (e.g. "rosh_hashanah")
+	ID string
+
+	// Name is the display name
+	Name string
+
+	// Calendar is the ID of the calendar the holiday is fixed in
+	Calendar string
+
+	// Days is how many days the holiday lasts (as commonly observed)
+	Days int
+
+	// Note describes observance details, such as starting at sunset
+	Note string
+
+	aliases []string
+
+	// date returns the holiday's month and day in a calendar year
+	date func(year int) (month, day int, err error)
+}
+
+// HolidayOccurrence is one instance of a holiday
+type HolidayOccurrence struct {
+	Holiday *Holiday
+
+	// Date is the first day of the holiday in its calendar
+	Date Date
+
+	// First and Last are the Julian Day Numbers of its first and last day
+	First, Last int
+}
+
+// fixedDate returns a date function for a holiday on the same month and day every year
+func fixedDate(month, day int) func(int) (int, int, error) {
+	return func(int) (int, int, error) { return month, day, nil }
+}
+
+// hebrewDate returns a date function for a Hebrew holiday in the month named
+// by key (see hebrewMonthNumber), so Nisan and Adar II follow leap years
+func hebrewDate(key, day int) func(int) (int, int, error) {
+	return func(year int) (int, int, error) {
+		k := key
+		if k == 14 && !hebrewLeapYear(year) {
+			k = 6 // Adar II holidays fall in Adar in a common year
+		}
+		month, err := hebrewMonthNumber(year, k)
+		return month, day, err
+	}
+}
+
+const hebrewSunsetNote = "Begins at sunset on the evening before the first day"
+
+var holidays = []*Holiday{
+	{ID: "rosh_hashanah", Name: "Rosh Hashanah", Calendar: "hebrew", Days: 2, Note: hebrewSunsetNote,
+		aliases: []string{"jewish_new_year"}, date: hebrewDate(1, 1)},
+	{ID: "yom_kippur", Name: "Yom Kippur", Calendar: "hebrew", Days: 1, Note: hebrewSunsetNote,
+		date: hebrewDate(1, 10)},
+	{ID: "sukkot", Name: "Sukkot", Calendar: "hebrew", Days: 7, Note: hebrewSunsetNote,
+		date: hebrewDate(1, 15)},
+	{ID: "hanukkah", Name: "Hanukkah", Calendar: "hebrew", Days: 8, Note: hebrewSunsetNote,
+		aliases: []string{"chanukah"}, date: hebrewDate(3, 25)},
+	{ID: "purim", Name: "Purim", Calendar: "hebrew", Days: 1, Note: hebrewSunsetNote + "; in Adar II in leap years",
+		date: hebrewDate(14, 14)},
+	{ID: "passover", Name: "Passover (Pesach)", Calendar: "hebrew", Days: 8, Note: hebrewSunsetNote + "; 7 days in Israel",
+		aliases: []string{"pesach"}, date: hebrewDate(7, 15)},
+	{ID: "shavuot", Name: "Shavuot", Calendar: "hebrew", Days: 2, Note: hebrewSunsetNote + "; 1 day in Israel",
+		date: hebrewDate(9, 6)},
+
+	{ID: "islamic_new_year", Name: "Islamic New Year", Calendar: "islamic_umalqura", Days: 1,
+		aliases: []string{"hijri_new_year", "muharram"}, date: fixedDate(1, 1)},
+	{ID: "ashura", Name: "Ashura", Calendar: "islamic_umalqura", Days: 1, date: fixedDate(1, 10)},
+	{ID: "mawlid", Name: "Mawlid al-Nabi", Calendar: "islamic_umalqura", Days: 1,
+		aliases: []string{"mawlid_al_nabi", "mawlid_an_nabi"}, date: fixedDate(3, 12)},
+	{ID: "ramadan", Name: "Ramadan", Calendar: "islamic_umalqura", Days: 29,
+		Note: "Umm al-Qura dates; local moon sighting can shift it by a day, and it lasts 29 or 30 days",
+		date: fixedDate(9, 1)},
+	{ID: "eid_al_fitr", Name: "Eid al-Fitr", Calendar: "islamic_umalqura", Days: 1,
+		Note: "Umm al-Qura dates; local moon sighting can shift it by a day", date: fixedDate(10, 1)},
+	{ID: "eid_al_adha", Name: "Eid al-Adha", Calendar: "islamic_umalqura", Days: 1,
+		Note: "Umm al-Qura dates; local moon sighting can shift it by a day", date: fixedDate(12, 10)},
+
+	{ID: "lunar_new_year", Name: "Lunar New Year (Spring Festival)", Calendar: "chinese", Days: 1,
+		aliases: []string{"chinese_new_year", "spring_festival", "tet", "seollal"}, date: fixedDate(1, 1)},
+	{ID: "lantern_festival", Name: "Lantern Festival", Calendar: "chinese", Days: 1, date: fixedDate(1, 15)},
+	{ID: "dragon_boat_festival", Name: "Dragon Boat Festival", Calendar: "chinese", Days: 1,
+		aliases: []string{"duanwu"}, date: fixedDate(5, 5)},
+	{ID: "qixi", Name: "Qixi Festival", Calendar: "chinese", Days: 1, date: fixedDate(7, 7)},
+	{ID: "mid_autumn_festival", Name: "Mid-Autumn Festival", Calendar: "chinese", Days: 1,
+		aliases: []string{"moon_festival", "zhongqiu", "chuseok"}, date: fixedDate(8, 15)},
+	{ID: "double_ninth_festival", Name: "Double Ninth Festival", Calendar: "chinese", Days: 1,
+		aliases: []string{"chongyang"}, date: fixedDate(9, 9)},
+
+	{ID: "nowruz", Name: "Nowruz", Calendar: "persian", Days: 1,
+		aliases: []string{"norooz", "persian_new_year"}, date: fixedDate(1, 1)},
+	{ID: "enkutatash", Name: "Enkutatash (Ethiopian New Year)", Calendar: "ethiopian", Days: 1,
+		aliases: []string{"ethiopian_new_year"}, date: fixedDate(1, 1)},
+}
+
+// GetHoliday returns a holiday by ID or alias (e.g. "eid_al_fitr", "Chinese New Year")
+func GetHoliday(name string) (*Holiday, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(key)
+	for _, h := range holidays {
+		if key == h.ID || containsString(h.aliases, key) {
+			return h, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown holiday '%s' (available: %s)", name, strings.Join(HolidayIDs(), ", "))
+}
+
+// HolidayIDs returns the IDs of all known holidays, sorted
+func HolidayIDs() []string {
+	ids := make([]string, len(holidays))
+	for i, h := range holidays {
+		ids[i] = h.ID
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Occurrences returns the instances of the holiday whose first day falls in
+// the Gregorian year. Islamic holidays occasionally occur twice in a year.
+func (h *Holiday) Occurrences(gregorianYear int) ([]HolidayOccurrence, error) {
+	cal, err := Get(h.Calendar)
+	if err != nil {
+		return nil, err
+	}
+	first := GregorianToJDN(gregorianYear, 1, 1)
+	last := GregorianToJDN(gregorianYear, 12, 31)
+	from, err := cal.FromJDN(first)
+	if err != nil {
+		return nil, err
+	}
+	to, err := cal.FromJDN(last)
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []HolidayOccurrence
+	for year := from.Year; year <= to.Year; year++ {
+		month, day, err := h.date(year)
+		if err != nil {
+			return nil, err
+		}
+		date := Date{Calendar: cal.ID(), Era: from.Era, Year: year, Month: month, Day: day}
+		jdn, err := cal.ToJDN(date)
+		if err != nil {
+			return nil, err
+		}
+		if jdn >= first && jdn <= last {
+			occurrences = append(occurrences, HolidayOccurrence{Holiday: h, Date: date, First: jdn, Last: jdn + h.Days - 1})
+		}
+	}
+	return occurrences, nil
+}
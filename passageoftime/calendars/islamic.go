@@ -0,0 +1,211 @@
+package calendars
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sync"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// islamicEpoch is the Julian Day Number of 1 Muharram AH 1 (16 July 622, Julian)
+const islamicEpoch = 1948440
+
+var islamicMonthNames = []string{
+	"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Awwal", "Jumada al-Thani",
+	"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah",
+}
+
+// islamicParseNames are single-word forms of the month names produced by
+// islamicMonthPhrases, in month order
+var (
+	islamicParseNames = []string{
+		"Muharram", "Safar", "RabiAwwal", "RabiThani", "JumadaAwwal", "JumadaThani",
+		"Rajab", "Shaban", "Ramadan", "Shawwal", "DhulQadah", "DhulHijjah",
+	}
+
+	islamicMonthPhrases = []struct {
+		pattern     *regexp.Regexp
+		replacement string
+	}{
+		{regexp.MustCompile(`(?i)\brabi\W*(al\W*|ul\W*)?(awwal|awal|ula|i)\b`), "RabiAwwal"},
+		{regexp.MustCompile(`(?i)\brabi\W*(al\W*|ath\W*|ul\W*)?(thani|akhir|akhar|ii)\b`), "RabiThani"},
+		{regexp.MustCompile(`(?i)\bjumad[ae]\W*(al\W*|ul\W*)?(awwal|awal|ula|i)\b`), "JumadaAwwal"},
+		{regexp.MustCompile(`(?i)\bjumad[ae]\W*(al\W*|ath\W*|ul\W*)?(thani|akhir|akhirah|ukhra|ii)\b`), "JumadaThani"},
+		{regexp.MustCompile(`(?i)\bsha\W*ban\b`), "Shaban"},
+		{regexp.MustCompile(`(?i)\bramazan\b`), "Ramadan"},
+		{regexp.MustCompile(`(?i)\bdh?[ue]\W*(a?l\W*)?q[ai]\W*dah?\b`), "DhulQadah"},
+		{regexp.MustCompile(`(?i)\bdh?[ue]\W*(a?l\W*)?hij+ah?\b`), "DhulHijjah"},
+	}
+)
+
+// islamicCalendar is the Hijri calendar. The tabular variant uses the common
+// 30-year arithmetic cycle; the Umm al-Qura variant is the official calendar
+// of Saudi Arabia, computed from its rule for the start of each month.
+type islamicCalendar struct {
+	id, name  string
+	ummAlQura bool
+}
+
+func init() {
+	register(&islamicCalendar{id: "islamic", name: "Islamic (tabular)"},
+		"islamic_civil", "islamic_tabular", "tabular_hijri")
+	register(&islamicCalendar{id: "islamic_umalqura", name: "Islamic (Umm al-Qura)", ummAlQura: true},
+		"umm_al_qura", "ummalqura", "umalqura", "hijri", "islamic_umm_al_qura", "saudi")
+}
+
+func (c *islamicCalendar) ID() string   { return c.id }
+func (c *islamicCalendar) Name() string { return c.name }
+
+// tabularIslamicJDN returns the Julian Day Number of a tabular Islamic date
+// (leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each cycle)
+func tabularIslamicJDN(year, month, day int) int {
+	return islamicEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) + 29*(month-1) + month/2 + day
+}
+
+func tabularIslamicFromJDN(jdn int) (year, month, day int) {
+	year = floorDiv(30*(jdn-islamicEpoch)+10646, 10631)
+	month = min(12, (11*(jdn-tabularIslamicJDN(year, 1, 1))+330)/325)
+	day = jdn - tabularIslamicJDN(year, month, 1) + 1
+	return year, month, day
+}
+
+func tabularIslamicDaysInMonth(year, month int) int {
+	if month%2 == 1 || month == 12 && (14+11*year)%30 < 11 {
+		return 30
+	}
+	return 29
+}
+
+// Umm al-Qura months are computed for years 1300-1600 AH (1882-2174), where
+// the astronomical approximations are reliable
+const (
+	ummAlQuraFirstYear = 1300
+	ummAlQuraLastYear  = 1600
+)
+
+// meccaLatitude and meccaLongitude locate the Kaaba, the reference point of
+// the Umm al-Qura rule
+const (
+	meccaLatitude  = 21.4225
+	meccaLongitude = 39.8262
+)
+
+var ummAlQuraCache sync.Map // month index -> start JDN
+
+// ummAlQuraMonthStart returns the Julian Day Number of the first day of the
+// month with the given index (months since 1 Muharram AH 1). The month starts
+// the day after the conjunction if, at sunset in Mecca on the day of the
+// conjunction, the conjunction has already happened and the moon sets after
+// the sun; otherwise it starts a day later. This is the rule in force since
+// 1420 AH (1999); historical tables for earlier years can differ by a day.
+func ummAlQuraMonthStart(index int) int {
+	if start, ok := ummAlQuraCache.Load(index); ok {
+		return start.(int)
+	}
+
+	tabular := tabularIslamicJDN(index/12+1, index%12+1, 1)
+	k := math.Round((float64(tabular) - 1.5 - 2451550.09766) / 29.530588861)
+	conjunction := internal.NewMoon(k)
+
+	// Day of the conjunction in Mecca (UTC+3) and that day's sunset
+	day := int(math.Floor(conjunction + 0.5 + 3.0/24))
+	start := day + 2
+	if sunset, ok := internal.Sunset(float64(day)-0.5-3.0/24, meccaLatitude, meccaLongitude); ok && conjunction < sunset {
+		lon, lat, dist := internal.MoonPosition(sunset)
+		parallax := math.Asin(6378.14/dist) * 180 / math.Pi
+		if internal.Altitude(sunset, lon, lat, meccaLatitude, meccaLongitude) > 0.7275*parallax-0.5667 {
+			start = day + 1
+		}
+	}
+
+	ummAlQuraCache.Store(index, start)
+	return start
+}
+
+func (c *islamicCalendar) checkYear(year int) error {
+	if year < 1 {
+		return fmt.Errorf("invalid Islamic year %d", year)
+	}
+	if c.ummAlQura && (year < ummAlQuraFirstYear || year > ummAlQuraLastYear) {
+		return fmt.Errorf("Umm al-Qura dates are supported for years %d-%d AH; use the tabular 'islamic' calendar outside that range", ummAlQuraFirstYear, ummAlQuraLastYear)
+	}
+	return nil
+}
+
+func (c *islamicCalendar) daysInMonth(year, month int) int {
+	if !c.ummAlQura {
+		return tabularIslamicDaysInMonth(year, month)
+	}
+	index := (year-1)*12 + month - 1
+	return ummAlQuraMonthStart(index+1) - ummAlQuraMonthStart(index)
+}
+
+func (c *islamicCalendar) FromJDN(jdn int) (Date, error) {
+	if jdn < islamicEpoch {
+		return Date{}, fmt.Errorf("date is before year 1 of the Islamic calendar")
+	}
+	year, month, day := tabularIslamicFromJDN(jdn)
+	if c.ummAlQura {
+		// The observed month starts within a couple of days of the tabular one
+		index := (year-1)*12 + month
+		for ummAlQuraMonthStart(index) > jdn {
+			index--
+		}
+		year, month, day = index/12+1, index%12+1, jdn-ummAlQuraMonthStart(index)+1
+	}
+	if err := c.checkYear(year); err != nil {
+		return Date{}, err
+	}
+	return Date{Calendar: c.id, Era: "AH", Year: year, Month: month, Day: day}, nil
+}
+
+func (c *islamicCalendar) ToJDN(d Date) (int, error) {
+	if err := c.checkYear(d.Year); err != nil {
+		return 0, err
+	}
+	if err := checkMonthDay(d, 12, c.daysInMonth); err != nil {
+		return 0, err
+	}
+	if c.ummAlQura {
+		return ummAlQuraMonthStart((d.Year-1)*12+d.Month-1) + d.Day - 1, nil
+	}
+	return tabularIslamicJDN(d.Year, d.Month, d.Day), nil
+}
+
+func (c *islamicCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	return islamicMonthNames[d.Month-1]
+}
+
+func (c *islamicCalendar) Format(d Date) string {
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%d AH", d.Year)
+	}
+	return fmt.Sprintf("%d %s %d AH", d.Day, c.MonthName(d), d.Year)
+}
+
+// Parse reads dates such as "1 Ramadan 1446", "Dhul-Hijjah 10, 1446 AH" or
+// "1446-09-01"
+func (c *islamicCalendar) Parse(text string) (Date, error) {
+	for _, p := range islamicMonthPhrases {
+		text = p.pattern.ReplaceAllString(text, p.replacement)
+	}
+	parts, err := parseDateParts(text, islamicParseNames, []string{"ah", "a", "h", "hijri", "هـ", "ه"})
+	if err != nil {
+		return Date{}, err
+	}
+	return parts.toDate(c.id, "AH"), nil
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,183 @@
+package calendars
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// japaneseEra is an imperial era; eras start on the day of accession
+type japaneseEra struct {
+	name, kanji, letter string
+	year, month, day    int // Gregorian start date
+}
+
+// japaneseEras lists the modern eras, oldest first. Japan adopted the Gregorian
+// calendar on 1 January Meiji 6 (1873); earlier dates are not supported.
+var japaneseEras = []japaneseEra{
+	{"Meiji", "明治", "M", 1868, 10, 23},
+	{"Taisho", "大正", "T", 1912, 7, 30},
+	{"Showa", "昭和", "S", 1926, 12, 25},
+	{"Heisei", "平成", "H", 1989, 1, 8},
+	{"Reiwa", "令和", "R", 2019, 5, 1},
+}
+
+// japaneseFirstJDN is 1 January 1873, when the Gregorian calendar was adopted
+var japaneseFirstJDN = GregorianToJDN(1873, 1, 1)
+
+// japaneseEraPrefix matches a leading era name, kanji or letter followed by a
+// year number or 元 ("first year"); japaneseEraAnywhere finds a spelled-out era
+var (
+	japaneseEraPrefix   = regexp.MustCompile(`(?i)^\s*(meiji|taisho|taishō|showa|shōwa|heisei|reiwa|明治|大正|昭和|平成|令和|[mtshr])\.?\s*(\d+|元)`)
+	japaneseEraAnywhere = regexp.MustCompile(`(?i)(meiji|taisho|taishō|showa|shōwa|heisei|reiwa|明治|大正|昭和|平成|令和)\s*(\d+|元)`)
+)
+
+type japaneseCalendar struct{}
+
+func init() {
+	register(japaneseCalendar{}, "japan", "japanese_era", "wareki", "nengo")
+}
+
+func (japaneseCalendar) ID() string   { return "japanese" }
+func (japaneseCalendar) Name() string { return "Japanese imperial era" }
+
+func eraStartJDN(e japaneseEra) int {
+	return GregorianToJDN(e.year, e.month, e.day)
+}
+
+func findJapaneseEra(name string) (int, bool) {
+	key := strings.NewReplacer("ō", "o", "Ō", "O").Replace(strings.ToLower(name))
+	for i, e := range japaneseEras {
+		if key == strings.ToLower(e.name) || name == e.kanji || key == strings.ToLower(e.letter) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (japaneseCalendar) FromJDN(jdn int) (Date, error) {
+	if jdn < japaneseFirstJDN {
+		return Date{}, fmt.Errorf("Japanese era dates are supported from 1 January 1873 (Meiji 6), when Japan adopted the Gregorian calendar")
+	}
+	i := len(japaneseEras) - 1
+	for jdn < eraStartJDN(japaneseEras[i]) {
+		i--
+	}
+	e := japaneseEras[i]
+	y, m, d := JDNToGregorian(jdn)
+	return Date{Calendar: "japanese", Era: e.name, Year: y - e.year + 1, Month: m, Day: d}, nil
+}
+
+func (japaneseCalendar) ToJDN(d Date) (int, error) {
+	i, ok := findJapaneseEra(d.Era)
+	if !ok {
+		return 0, fmt.Errorf("unknown Japanese era '%s' (expected Meiji, Taisho, Showa, Heisei or Reiwa)", d.Era)
+	}
+	e := japaneseEras[i]
+	if d.Year < 1 {
+		return 0, fmt.Errorf("invalid %s year %d", e.name, d.Year)
+	}
+	year := e.year + d.Year - 1
+	if err := checkMonthDay(d, 12, func(_, month int) int { return gregorianDaysInMonth(year, month) }); err != nil {
+		return 0, err
+	}
+
+	jdn := GregorianToJDN(year, d.Month, d.Day)
+	if jdn < eraStartJDN(e) || i+1 < len(japaneseEras) && jdn >= eraStartJDN(japaneseEras[i+1]) {
+		return 0, fmt.Errorf("%s %d-%02d-%02d is outside the %s era", e.name, d.Year, d.Month, d.Day, e.name)
+	}
+	if jdn < japaneseFirstJDN {
+		return 0, fmt.Errorf("Japanese era dates are supported from 1 January 1873 (Meiji 6)")
+	}
+	return jdn, nil
+}
+
+// yearSpan clips the Gregorian year to the era, so Reiwa 1 starts on 1 May 2019
+func (c japaneseCalendar) yearSpan(d Date) (int, int, error) {
+	i, ok := findJapaneseEra(d.Era)
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown Japanese era '%s'", d.Era)
+	}
+	e := japaneseEras[i]
+	year := e.year + d.Year - 1
+
+	first := max(GregorianToJDN(year, 1, 1), eraStartJDN(e), japaneseFirstJDN)
+	last := GregorianToJDN(year, 12, 31)
+	if i+1 < len(japaneseEras) {
+		last = min(last, eraStartJDN(japaneseEras[i+1])-1)
+	}
+	if d.Year < 1 || first > last {
+		return 0, 0, fmt.Errorf("%s %d does not exist", e.name, d.Year)
+	}
+	return first, last, nil
+}
+
+func (japaneseCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	return gregorianMonthNames[d.Month-1]
+}
+
+func (c japaneseCalendar) Format(d Date) string {
+	kanji := ""
+	if i, ok := findJapaneseEra(d.Era); ok {
+		year := strconv.Itoa(d.Year)
+		if d.Year == 1 {
+			year = "元"
+		}
+		kanji = japaneseEras[i].kanji + year + "年"
+		if !d.IsYearOnly() {
+			kanji += fmt.Sprintf("%d月%d日", d.Month, d.Day)
+		}
+	}
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%s %d (%s)", d.Era, d.Year, kanji)
+	}
+	return fmt.Sprintf("%d %s %s %d (%s)", d.Day, c.MonthName(d), d.Era, d.Year, kanji)
+}
+
+// Parse reads dates such as "Reiwa 7-03-15", "令和7年3月15日", "R7.3.15",
+// "Heisei 31" or "15 March Showa 64"
+func (japaneseCalendar) Parse(text string) (Date, error) {
+	text = digitReplacer.Replace(text)
+	loc := japaneseEraPrefix.FindStringSubmatchIndex(text)
+	if loc == nil {
+		loc = japaneseEraAnywhere.FindStringSubmatchIndex(text)
+	}
+	if loc == nil {
+		return Date{}, fmt.Errorf("Japanese date '%s' needs an era and year (e.g. 'Reiwa 7', '令和7年3月15日', 'R7.3.15')", text)
+	}
+
+	i, _ := findJapaneseEra(text[loc[2]:loc[3]])
+	year := 1
+	if yearText := text[loc[4]:loc[5]]; yearText != "元" {
+		year, _ = strconv.Atoi(yearText)
+	}
+	date := Date{Calendar: "japanese", Era: japaneseEras[i].name, Year: year}
+
+	// Whatever is left names the month and day
+	var nums []int
+	for _, tok := range tokenize(text[:loc[0]] + " " + text[loc[1]:]) {
+		if n, err := strconv.Atoi(tok); err == nil {
+			nums = append(nums, n)
+		} else if m := matchMonthName(tok, gregorianMonthNames); m != 0 && date.Month == 0 {
+			date.Month = m
+		} else {
+			return Date{}, fmt.Errorf("unrecognized word '%s' in date '%s'", tok, text)
+		}
+	}
+
+	switch {
+	case len(nums) == 0 && date.Month == 0:
+		return date, nil
+	case len(nums) == 1 && date.Month != 0:
+		date.Day = nums[0]
+	case len(nums) == 2 && date.Month == 0:
+		date.Month, date.Day = nums[0], nums[1]
+	default:
+		return Date{}, fmt.Errorf("cannot read month and day from '%s'", text)
+	}
+	return date, nil
+}
@@ -0,0 +1,139 @@
+package calendars
+
+import "fmt"
+
+// persianCalendar is the Solar Hijri calendar used in Iran and Afghanistan.
+// The official calendar starts each year at the March equinox as observed in
+// Tehran; this implementation uses Borkowski's leap year breaks, which match
+// the astronomical calendar for years 1 to 3177 AP.
+type persianCalendar struct{}
+
+var persianMonthNames = []string{
+	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+}
+
+// persianBreaks are the years in which the 33-year leap cycle is reset
+var persianBreaks = []int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+func init() {
+	register(persianCalendar{}, "jalali", "solar_hijri", "shamsi", "iranian", "hijri_shamsi")
+}
+
+func (persianCalendar) ID() string   { return "persian" }
+func (persianCalendar) Name() string { return "Persian (Solar Hijri)" }
+
+// persianYearInfo returns whether year is a leap year and the Gregorian March
+// day on which it begins
+func persianYearInfo(year int) (leap bool, march int, err error) {
+	if year < persianBreaks[0]+62 || year >= persianBreaks[len(persianBreaks)-1] {
+		return false, 0, fmt.Errorf("Persian year %d is outside the supported range 1-%d", year, persianBreaks[len(persianBreaks)-1]-1)
+	}
+
+	gy := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+	jump := 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	cycle := ((n+1)%33 - 1) % 4
+	if cycle == -1 {
+		cycle = 4
+	}
+	return cycle == 0, march, nil
+}
+
+func persianDaysInMonth(leap bool, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	case leap:
+		return 30
+	default:
+		return 29
+	}
+}
+
+func (persianCalendar) FromJDN(jdn int) (Date, error) {
+	gy, _, _ := JDNToGregorian(jdn)
+	year := gy - 621
+	_, march, err := persianYearInfo(year)
+	if err != nil {
+		return Date{}, err
+	}
+
+	// Days since 1 Farvardin of the year starting in this Gregorian year
+	k := jdn - GregorianToJDN(gy, 3, march)
+	if k < 0 {
+		year--
+		if _, _, err := persianYearInfo(year); err != nil {
+			return Date{}, err
+		}
+		start, _ := persianCalendar{}.ToJDN(Date{Year: year, Month: 1, Day: 1})
+		k = jdn - start
+	}
+
+	if k <= 185 {
+		return Date{Calendar: "persian", Era: "AP", Year: year, Month: 1 + k/31, Day: k%31 + 1}, nil
+	}
+	k -= 186
+	return Date{Calendar: "persian", Era: "AP", Year: year, Month: 7 + k/30, Day: k%30 + 1}, nil
+}
+
+func (persianCalendar) ToJDN(d Date) (int, error) {
+	leap, march, err := persianYearInfo(d.Year)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkMonthDay(d, 12, func(_, month int) int { return persianDaysInMonth(leap, month) }); err != nil {
+		return 0, err
+	}
+	start := GregorianToJDN(d.Year+621, 3, march)
+	return start + (d.Month-1)*31 - d.Month/7*(d.Month-7) + d.Day - 1, nil
+}
+
+func (persianCalendar) MonthName(d Date) string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+	return persianMonthNames[d.Month-1]
+}
+
+func (c persianCalendar) Format(d Date) string {
+	if d.IsYearOnly() {
+		return fmt.Sprintf("%d AP", d.Year)
+	}
+	return fmt.Sprintf("%d %s %d AP", d.Day, c.MonthName(d), d.Year)
+}
+
+func (persianCalendar) Parse(text string) (Date, error) {
+	parts, err := parseDateParts(text, persianMonthNames, []string{"ap", "sh", "ah", "solar", "hijri", "هـ", "ش", "ه"})
+	if err != nil {
+		return Date{}, err
+	}
+	return parts.toDate("persian", "AP"), nil
+}
@@ -1,7 +1,6 @@
 package passageoftime
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
@@ -62,30 +61,7 @@ func GetTimeContext(t, now time.Time, seconds float64) string {
 // GetTimeDescription generates natural language description for a time
 // This is a compatibility wrapper for the getTimeDescription function  
 func GetTimeDescription(t, now time.Time, isDateOnly bool) string {
-	daysDiff := int(t.Sub(now).Hours() / 24)
-	
-	var dayDesc string
-	switch daysDiff {
-	case 0:
-		dayDesc = "today"
-	case 1:
-		dayDesc = "tomorrow"
-	case -1:
-		dayDesc = "yesterday"
-	case 2, 3, 4, 5, 6, 7:
-		dayDesc = "next " + t.Format("Monday")
-	case -7, -6, -5, -4, -3, -2:
-		dayDesc = "last " + t.Format("Monday")
-	default:
-		dayDesc = t.Format("January 2, 2006")
-	}
-	
-	if isDateOnly {
-		return dayDesc
-	}
-	
-	timeDesc := t.Format("3:04 PM")
-	return fmt.Sprintf("%s at %s", dayDesc, timeDesc)
+	return localeEnglish.DescribeTime(t, now, isDateOnly)
 }
 
 // FormatDuration formats a duration in seconds into human-readable text
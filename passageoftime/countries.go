@@ -0,0 +1,70 @@
+package passageoftime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// CountryName returns the tz database name of the country with the given ISO
+// 3166 alpha-2 code, e.g. "DE" -> "Germany"
+func CountryName(code string) (string, bool) {
+	return internal.CountryName(code)
+}
+
+// GetCountryTimezoneIDs returns the zones the tz database lists for a country,
+// including zones it shares with neighbours, sorted
+func GetCountryTimezoneIDs(code string) ([]string, error) {
+	if _, ok := internal.CountryName(code); !ok {
+		return nil, fmt.Errorf("unknown country code '%s' (expected an ISO 3166 alpha-2 code such as US or DE)", code)
+	}
+	return internal.CountryTimezones(code), nil
+}
+
+// TimezoneInCountry reports whether a zone, or the zone an alias links to,
+// covers the country with the given ISO code
+func TimezoneInCountry(id, code string) bool {
+	for _, c := range internal.TimezoneCountries(id) {
+		if strings.EqualFold(c, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTimezoneInfo describes a zone at now, with its countries, coordinates
+// and comment when the tz database locates it. Zones without a location, such
+// as UTC, are named by their ID.
+func GetTimezoneInfo(id string, now time.Time) (TimezoneInfo, error) {
+	loc, err := time.LoadLocation(id)
+	if err != nil {
+		return TimezoneInfo{}, fmt.Errorf("invalid timezone '%s': %w", id, err)
+	}
+	_, offset := now.In(loc).Zone()
+
+	info := TimezoneInfo{
+		ID:           id,
+		Name:         id,
+		Offset:       offset,
+		OffsetString: FormatOffset(offset),
+	}
+
+	location, ok := internal.TimezoneLocation(id)
+	if !ok {
+		return info, nil
+	}
+	info.CountryCodes = location.CountryCodes
+	for _, code := range location.CountryCodes {
+		name, _ := internal.CountryName(code)
+		info.CountryNames = append(info.CountryNames, name)
+	}
+	info.Latitude = location.Latitude
+	info.Longitude = location.Longitude
+	info.Comment = location.Comment
+
+	city := strings.ReplaceAll(location.Zone[strings.LastIndex(location.Zone, "/")+1:], "_", " ")
+	info.Name = city + ", " + info.CountryNames[0]
+	return info, nil
+}
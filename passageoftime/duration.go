@@ -0,0 +1,424 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParsedDuration is a duration read by ParseDuration. Years, months and days
+// are calendar components whose length depends on where the duration is
+// applied; Clock is the exact part.
+type ParsedDuration struct {
+	// Input is the text as given
+	Input string
+
+	// Syntax is how the input was read: "iso8601", "clock", "go" or "natural"
+	Syntax string
+
+	// Negative is set for "-2h", "3 days ago" and similar
+	Negative bool
+
+	// Years, Months and Days are calendar components (weeks count as 7 days)
+	Years  int
+	Months int
+	Days   int
+
+	// Clock is the hours, minutes and seconds part
+	Clock time.Duration
+}
+
+// Seconds returns the total length in seconds, negative when Negative. Days
+// count as 24 hours and months and years as Gregorian averages (30.436875
+// and 365.2425 days); see Exact.
+func (d *ParsedDuration) Seconds() float64 {
+	total := float64(d.Years)*float64(durationUnits[unitIndex(UnitYear)].seconds) +
+		float64(d.Months)*float64(durationUnits[unitIndex(UnitMonth)].seconds) +
+		float64(d.Days)*86400 + d.Clock.Seconds()
+	if d.Negative {
+		return -total
+	}
+	return total
+}
+
+// Exact reports whether Seconds is exact, which it is unless the duration has
+// months or years
+func (d *ParsedDuration) Exact() bool {
+	return d.Years == 0 && d.Months == 0
+}
+
+// AddTo applies the duration to t, adding calendar components with AddDate
+// (so "1 month" from January 31 is March 3 or 2) and then the clock part
+func (d *ParsedDuration) AddTo(t time.Time) time.Time {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+	return t.AddDate(sign*d.Years, sign*d.Months, sign*d.Days).Add(time.Duration(sign) * d.Clock)
+}
+
+// ISO8601 renders the duration in ISO 8601 form, e.g. "P1Y2M3DT4H5M6.5S"
+func (d *ParsedDuration) ISO8601() string {
+	var b strings.Builder
+	if d.Negative {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+	for _, part := range []struct {
+		value  int
+		suffix string
+	}{{d.Years, "Y"}, {d.Months, "M"}, {d.Days, "D"}} {
+		if part.value != 0 {
+			fmt.Fprintf(&b, "%d%s", part.value, part.suffix)
+		}
+	}
+	hours := int64(d.Clock / time.Hour)
+	minutes := int64(d.Clock % time.Hour / time.Minute)
+	seconds := (d.Clock % time.Minute).Seconds()
+	if d.Clock != 0 {
+		b.WriteByte('T')
+		if hours != 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes != 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds != 0 {
+			b.WriteString(strconv.FormatFloat(seconds, 'f', -1, 64) + "S")
+		}
+	}
+	if b.Len() == 1 || (d.Negative && b.Len() == 2) {
+		b.WriteString("T0S")
+	}
+	return b.String()
+}
+
+var (
+	isoDurationPattern   = regexp.MustCompile(`(?i)^([-+])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+	clockDurationPattern = regexp.MustCompile(`^([-+])?(?:(\d+):)?(\d+):(\d{2}):(\d{2}(?:\.\d+)?)$`)
+	shortClockPattern    = regexp.MustCompile(`^[-+]?(\d+):(\d{2}(?:\.\d+)?)$`)
+	bareNumberPattern    = regexp.MustCompile(`^[-+]?\d+(?:[.,]\d+)?$`)
+
+	// monthShorthandPattern finds "3M", the repo's shorthand for months, before
+	// the input is lowercased and "m" means minutes
+	monthShorthandPattern = regexp.MustCompile(`(\d)\s*M\b`)
+
+	durationTermPattern = regexp.MustCompile(`^(\d+(?:[.,]\d+)?)\s*([^\d\s]+)\s*`)
+	connectorPattern    = regexp.MustCompile(`\s(?:and|und|et|y|e|и|plus)\s+(\d)`)
+	commaPattern        = regexp.MustCompile(`,(\s|$)`)
+	vaguePattern        = regexp.MustCompile(`(^|\s)(a few|few|several|some|a couple of|couple of|many|a bit|a while|ein paar|quelques|unos|unas|alguns|algumas|несколько)(\s|$)`)
+)
+
+var numberWords = map[string]string{
+	"a": "1", "an": "1", "one": "1", "two": "2", "three": "3", "four": "4", "five": "5",
+	"six": "6", "seven": "7", "eight": "8", "nine": "9", "ten": "10", "eleven": "11", "twelve": "12",
+	"fifteen": "15", "twenty": "20", "thirty": "30", "forty": "40", "fifty": "50", "sixty": "60",
+	"ein": "1", "eine": "1", "einen": "1", "un": "1", "une": "1", "una": "1", "um": "1", "uma": "1",
+	"один": "1", "одна": "1", "одну": "1",
+}
+
+// durationNumberPattern matches a number in digits or as one of numberWords
+func durationNumberPattern() string {
+	words := make([]string, 0, len(numberWords))
+	for word := range numberWords {
+		words = append(words, regexp.QuoteMeta(word))
+	}
+	// Longest first, so "an hour" is not read as "a" followed by "n hour"
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	return `(\d+(?:\.\d+)?|` + strings.Join(words, "|") + `)`
+}
+
+// andAHalfPatterns find "2 hours and a half" and "two and a half hours",
+// with the number in digits or words
+var andAHalfPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(^|\s)` + durationNumberPattern() + `\s*([^\d\s]+)\s+and\s+a\s+half(\s|$)`),
+	regexp.MustCompile(`(^|\s)` + durationNumberPattern() + `\s+and\s+a\s+half\s+([^\d\s]+)(\s|$)`),
+}
+
+// errDurationRange is returned for durations whose exact part does not fit
+// in a time.Duration (about 292 years)
+var errDurationRange = fmt.Errorf("duration is out of range: the hours, minutes and seconds may total at most %d hours", math.MaxInt64/int64(time.Hour))
+
+// halfPhrases are fixed phrases for half and quarter units, matched after
+// lowercasing
+var halfPhrases = strings.NewReplacer(
+	"half an hour", "30 minutes", "half hour", "30 minutes", "half a day", "12 hours",
+	"a quarter of an hour", "15 minutes", "quarter of an hour", "15 minutes", "quarter hour", "15 minutes",
+	"eine halbe stunde", "30 minuten", "halbe stunde", "30 minuten", "une demi-heure", "30 minutes",
+	"media hora", "30 minutos", "meia hora", "30 minutos", "полчаса", "30 минут", "半小时", "30分钟",
+	"半日", "12時間",
+)
+
+// durationWords maps unit words to units, built from the English aliases and
+// the unit patterns of every locale
+var durationWords = buildDurationWords()
+
+func buildDurationWords() map[string]DurationUnit {
+	words := map[string]DurationUnit{}
+	for _, l := range locales {
+		for _, forms := range []map[DurationUnit]pluralForms{l.units, l.relativeUnits} {
+			for unit, f := range forms {
+				for _, pattern := range []string{f.one, f.few, f.other} {
+					if word := strings.ToLower(strings.TrimSpace(strings.ReplaceAll(pattern, "{0}", ""))); word != "" {
+						words[word] = unit
+					}
+				}
+			}
+		}
+	}
+	for _, u := range durationUnits {
+		words[u.symbol] = u.unit
+	}
+	extra := map[string]DurationUnit{
+		"yr": UnitYear, "yrs": UnitYear, "mon": UnitMonth, "mos": UnitMonth,
+		"wk": UnitWeek, "wks": UnitWeek, "day": UnitDay, "hr": UnitHour, "hrs": UnitHour,
+		"min": UnitMinute, "mins": UnitMinute, "sec": UnitSecond, "secs": UnitSecond,
+		"jahren": UnitYear, "tagen": UnitDay, "std": UnitHour, "sek": UnitSecond,
+		"个小时": UnitHour, "个星期": UnitWeek, "星期": UnitWeek, "小時": UnitHour, "分": UnitMinute,
+		"ヶ月": UnitMonth, "カ月": UnitMonth, "時間": UnitHour, "週間": UnitWeek,
+	}
+	for word, unit := range extra {
+		words[word] = unit
+	}
+	return words
+}
+
+// fortnightDays is the length of a fortnight
+const fortnightDays = 14
+
+func isFortnight(word string) bool {
+	return word == "fortnight" || word == "fortnights"
+}
+
+// isDurationWord reports whether word names a unit
+func isDurationWord(word string) bool {
+	_, ok := durationWords[strings.TrimRight(word, ".")]
+	return ok || isFortnight(word)
+}
+
+// ParseDuration reads a length of time written as ISO 8601 ("PT2H", "P1Y2M"),
+// a clock reading ("1:15:30", "2:01:15:30" with days), Go syntax ("2h30m",
+// "1.5h") or words and shorthand in any supported locale ("2 hours 30
+// minutes", "1d 4h", "a fortnight", "half an hour", "3 Tage", "2 часа").
+// "ago", "in" and their localized forms set the sign. Input that could mean
+// more than one length, such as "90" or "1:30", is rejected with an
+// explanation.
+func ParseDuration(input string) (*ParsedDuration, error) {
+	text := strings.TrimSpace(input)
+	if text == "" {
+		return nil, fmt.Errorf("duration is empty")
+	}
+
+	if m := isoDurationPattern.FindStringSubmatch(text); m != nil && !strings.EqualFold(strings.TrimLeft(text, "+-"), "P") && !strings.HasSuffix(strings.ToUpper(text), "T") {
+		return parseISODuration(input, m)
+	}
+	if m := clockDurationPattern.FindStringSubmatch(text); m != nil {
+		d := &ParsedDuration{Input: input, Syntax: "clock", Negative: m[1] == "-"}
+		days, _ := strconv.Atoi(m[2])
+		hours, _ := strconv.Atoi(m[3])
+		minutes, _ := strconv.Atoi(m[4])
+		seconds, _ := strconv.ParseFloat(m[5], 64)
+		if minutes > 59 || seconds >= 60 {
+			return nil, fmt.Errorf("invalid clock duration '%s': minutes and seconds must be below 60", input)
+		}
+		if err := d.addClock((float64(days)*86400 + float64(hours)*3600 + float64(minutes)*60 + seconds) * float64(time.Second)); err != nil {
+			return nil, fmt.Errorf("invalid clock duration '%s': %w", input, err)
+		}
+		return d, nil
+	}
+	if m := shortClockPattern.FindStringSubmatch(text); m != nil {
+		first, _ := strconv.Atoi(m[1])
+		return nil, fmt.Errorf("ambiguous duration '%s': it could be hours:minutes or minutes:seconds; write it as H:MM:SS ('%d:%s:00' or '0:%02d:%s')", input, first, m[2], first, m[2])
+	}
+	if bareNumberPattern.MatchString(text) {
+		return nil, fmt.Errorf("ambiguous duration '%s': a number needs a unit, e.g. '%ss', '%sm' or '%sh'", input, text, text, text)
+	}
+	if goDuration, err := time.ParseDuration(text); err == nil {
+		d := &ParsedDuration{Input: input, Syntax: "go", Clock: goDuration}
+		if goDuration < 0 {
+			d.Negative, d.Clock = true, -goDuration
+		}
+		return d, nil
+	}
+	return parseNaturalDuration(input, text)
+}
+
+func parseISODuration(input string, m []string) (*ParsedDuration, error) {
+	d := &ParsedDuration{Input: input, Syntax: "iso8601", Negative: m[1] == "-"}
+	d.Years, _ = strconv.Atoi(m[2])
+	d.Months, _ = strconv.Atoi(m[3])
+	var amounts []durationTerm
+	for i, unit := range []DurationUnit{UnitWeek, UnitDay, UnitHour, UnitMinute, UnitSecond} {
+		if m[4+i] == "" {
+			continue
+		}
+		value, _ := strconv.ParseFloat(strings.ReplaceAll(m[4+i], ",", "."), 64)
+		amounts = append(amounts, durationTerm{unit: unit, value: value})
+	}
+	if err := d.add(amounts); err != nil {
+		return nil, fmt.Errorf("invalid ISO 8601 duration '%s': %w", input, err)
+	}
+	return d, nil
+}
+
+// durationTerm is one "number unit" pair of a duration; name is the unit as
+// written, so "a fortnight and 2 days" is not taken as days given twice
+type durationTerm struct {
+	unit  DurationUnit
+	value float64
+	name  string
+}
+
+func parseNaturalDuration(input, text string) (*ParsedDuration, error) {
+	text = monthShorthandPattern.ReplaceAllString(text, "${1}mo")
+	text = strings.ToLower(text)
+	d := &ParsedDuration{Input: input, Syntax: "natural"}
+
+	if strings.HasPrefix(text, "-") {
+		d.Negative, text = true, strings.TrimSpace(text[1:])
+	} else if strings.HasPrefix(text, "+") {
+		text = strings.TrimSpace(text[1:])
+	} else if body, past, ok := stripRelativePhrase(text); ok {
+		d.Negative, text = past, body
+	}
+
+	if m := vaguePattern.FindStringSubmatch(text); m != nil {
+		return nil, fmt.Errorf("ambiguous duration '%s': '%s' is not a definite amount; give a number", input, m[2])
+	}
+
+	text = commaPattern.ReplaceAllString(text, " $1")
+	// "And a half" goes first, so "a half hours" is not read as "half hour"
+	for _, pattern := range andAHalfPatterns {
+		text = pattern.ReplaceAllStringFunc(text, func(match string) string {
+			m := pattern.FindStringSubmatch(match)
+			if !isDurationWord(m[3]) {
+				return match
+			}
+			number := m[2]
+			if word, ok := numberWords[number]; ok {
+				number = word
+			}
+			value, _ := strconv.ParseFloat(number, 64)
+			return m[1] + strconv.FormatFloat(value+0.5, 'f', -1, 64) + " " + m[3] + m[4]
+		})
+	}
+	text = halfPhrases.Replace(text)
+	// Number words count only in front of a unit, so French "1 an" keeps its unit
+	words := strings.Fields(text)
+	for i := 0; i+1 < len(words); i++ {
+		if number, ok := numberWords[words[i]]; ok && isDurationWord(words[i+1]) {
+			words[i] = number
+		}
+	}
+	text = strings.Join(words, " ")
+	text = strings.TrimSpace(connectorPattern.ReplaceAllString(text, " $1"))
+
+	var terms []durationTerm
+	for rest := text; rest != ""; {
+		m := durationTermPattern.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, fmt.Errorf("could not read duration '%s' at '%s': expected a number followed by a unit, e.g. '2 hours', '1d 4h' or 'PT2H'", input, rest)
+		}
+		value, _ := strconv.ParseFloat(strings.ReplaceAll(m[1], ",", "."), 64)
+		word := strings.TrimRight(m[2], ".")
+		if isFortnight(word) {
+			terms = append(terms, durationTerm{unit: UnitDay, value: value * fortnightDays, name: "fortnight"})
+		} else if unit, ok := durationWords[word]; ok {
+			terms = append(terms, durationTerm{unit: unit, value: value, name: string(unit)})
+		} else {
+			return nil, fmt.Errorf("unknown unit '%s' in duration '%s'", m[2], input)
+		}
+		rest = rest[len(m[0]):]
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("could not read duration '%s'", input)
+	}
+
+	seen := map[string]bool{}
+	for _, term := range terms {
+		if seen[term.name] {
+			return nil, fmt.Errorf("ambiguous duration '%s': %ss are given more than once", input, term.name)
+		}
+		seen[term.name] = true
+	}
+	if err := d.add(terms); err != nil {
+		return nil, fmt.Errorf("invalid duration '%s': %w", input, err)
+	}
+	return d, nil
+}
+
+// stripRelativePhrase removes a past or future phrase such as "3 days ago",
+// "in 3 days" or "vor 3 Tagen" in any locale, reporting whether it was past
+func stripRelativePhrase(text string) (string, bool, bool) {
+	for _, l := range locales {
+		for _, candidate := range []struct {
+			pattern string
+			past    bool
+		}{{l.past, true}, {l.future, false}} {
+			prefix, suffix, _ := strings.Cut(strings.ToLower(candidate.pattern), "{0}")
+			if len(text) > len(prefix)+len(suffix) && strings.HasPrefix(text, prefix) && strings.HasSuffix(text, suffix) {
+				return strings.TrimSpace(text[len(prefix) : len(text)-len(suffix)]), candidate.past, true
+			}
+		}
+	}
+	return text, false, false
+}
+
+// add accumulates terms into d. Months and years must be whole numbers, as
+// they have no fixed length to take a fraction of; fractional weeks and days
+// spill into the clock part.
+func (d *ParsedDuration) add(terms []durationTerm) error {
+	for _, term := range terms {
+		switch term.unit {
+		case UnitYear, UnitMonth:
+			if term.value != math.Trunc(term.value) {
+				return fmt.Errorf("fractional %ss are ambiguous because %ss vary in length; use whole %ss or smaller units", term.unit, term.unit, term.unit)
+			}
+			if term.value > math.MaxInt32 {
+				return fmt.Errorf("duration is out of range: %g %ss", term.value, term.unit)
+			}
+			if term.unit == UnitYear {
+				d.Years += int(term.value)
+			} else {
+				d.Months += int(term.value)
+			}
+		case UnitWeek, UnitDay:
+			days := term.value
+			if term.unit == UnitWeek {
+				days *= 7
+			}
+			if days > math.MaxInt32 {
+				return fmt.Errorf("duration is out of range: %g days", days)
+			}
+			whole := math.Trunc(days)
+			d.Days += int(whole)
+			if err := d.addClock((days - whole) * 24 * float64(time.Hour)); err != nil {
+				return err
+			}
+		default:
+			if err := d.addClock(term.value * float64(durationUnits[unitIndex(term.unit)].seconds) * float64(time.Second)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addClock adds ns nanoseconds to the clock part, failing rather than
+// overflowing time.Duration
+func (d *ParsedDuration) addClock(ns float64) error {
+	if ns >= float64(math.MaxInt64-d.Clock) {
+		return errDurationRange
+	}
+	d.Clock += time.Duration(ns)
+	return nil
+}
@@ -0,0 +1,201 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimestampFormat is a named rendering of a timestamp
+type TimestampFormat struct {
+	// Name identifies the format, e.g. "rfc3339" or "http_date"
+	Name string
+
+	// Description says what the format is and where it is used
+	Description string
+
+	// DefaultPrecision is the number of fractional second digits used when
+	// none is requested; -1 for formats that carry no fraction
+	DefaultPrecision int
+
+	render func(t time.Time, precision int) (string, error)
+}
+
+// minUnixNano and maxUnixNano bound the instants whose Unix nanoseconds fit in
+// an int64; time.Time.UnixNano is undefined outside them
+var (
+	minUnixNano = time.Unix(0, math.MinInt64).UTC()
+	maxUnixNano = time.Unix(0, math.MaxInt64).UTC()
+)
+
+// timestampFormats are the formats FormatTimestamp knows, in the order they
+// are listed
+var timestampFormats = []TimestampFormat{
+	{"rfc3339", "RFC 3339 / ISO 8601 with offset, e.g. 2006-01-02T15:04:05Z07:00", 0, func(t time.Time, p int) (string, error) {
+		return t.Format("2006-01-02T15:04:05" + fractionLayout(p) + "Z07:00"), nil
+	}},
+	{"rfc3339_nano", "RFC 3339 with nanoseconds, trailing zeros removed (Go's RFC3339Nano)", -1, layoutFormat(time.RFC3339Nano)},
+	{"iso8601_basic", "ISO 8601 basic format without separators, e.g. 20060102T150405Z", 0, func(t time.Time, p int) (string, error) {
+		return t.Format("20060102T150405" + fractionLayout(p) + "Z0700"), nil
+	}},
+	{"iso_week_date", "ISO 8601 week date, e.g. 2006-W01-1 (Monday is day 1)", -1, func(t time.Time, _ int) (string, error) {
+		year, week := t.ISOWeek()
+		weekday := int(t.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		return fmt.Sprintf("%04d-W%02d-%d", year, week, weekday), nil
+	}},
+	{"iso_ordinal_date", "ISO 8601 ordinal date (year and day of year), e.g. 2006-002", -1, func(t time.Time, _ int) (string, error) {
+		return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay()), nil
+	}},
+	{"json", "JavaScript Date.toJSON(): UTC with milliseconds, e.g. 2006-01-02T15:04:05.000Z", 3, func(t time.Time, p int) (string, error) {
+		return t.UTC().Format("2006-01-02T15:04:05" + fractionLayout(p) + "Z"), nil
+	}},
+	{"rfc2822", "RFC 2822 / RFC 5322 email date, e.g. Mon, 02 Jan 2006 15:04:05 -0700", -1, layoutFormat(time.RFC1123Z)},
+	{"rfc1123", "RFC 1123 with zone abbreviation, e.g. Mon, 02 Jan 2006 15:04:05 MST", -1, layoutFormat(time.RFC1123)},
+	{"http_date", "HTTP-date (RFC 9110 IMF-fixdate), always in GMT, e.g. Mon, 02 Jan 2006 15:04:05 GMT", -1, func(t time.Time, _ int) (string, error) {
+		return t.UTC().Format("Mon, 02 Jan 2006 15:04:05") + " GMT", nil
+	}},
+	{"rfc850", "RFC 850 (obsolete HTTP date), e.g. Monday, 02-Jan-06 15:04:05 MST", -1, layoutFormat(time.RFC850)},
+	{"rfc822", "RFC 822 with numeric offset, e.g. 02 Jan 06 15:04 -0700", -1, layoutFormat(time.RFC822Z)},
+	{"ansic", "ANSI C asctime(), e.g. Mon Jan  2 15:04:05 2006", -1, layoutFormat(time.ANSIC)},
+	{"unix_date", "Unix date(1) output, e.g. Mon Jan  2 15:04:05 MST 2006", -1, layoutFormat(time.UnixDate)},
+	{"sql_datetime", "SQL DATETIME / TIMESTAMP literal in local time, e.g. 2006-01-02 15:04:05", 0, func(t time.Time, p int) (string, error) {
+		return t.Format("2006-01-02 15:04:05" + fractionLayout(p)), nil
+	}},
+	{"sql_date", "SQL DATE literal, e.g. 2006-01-02", -1, layoutFormat(time.DateOnly)},
+	{"sql_time", "SQL TIME literal, e.g. 15:04:05", 0, func(t time.Time, p int) (string, error) {
+		return t.Format("15:04:05" + fractionLayout(p)), nil
+	}},
+	{"kitchen", "Clock time, e.g. 3:04PM", -1, layoutFormat(time.Kitchen)},
+	{"unix", "Unix time in seconds", -1, func(t time.Time, _ int) (string, error) {
+		return strconv.FormatInt(t.Unix(), 10), nil
+	}},
+	{"unix_ms", "Unix time in milliseconds", -1, func(t time.Time, _ int) (string, error) {
+		return strconv.FormatInt(t.UnixMilli(), 10), nil
+	}},
+	{"unix_us", "Unix time in microseconds", -1, func(t time.Time, _ int) (string, error) {
+		return strconv.FormatInt(t.UnixMicro(), 10), nil
+	}},
+	{"unix_ns", "Unix time in nanoseconds (1677-09-21 to 2262-04-11, the range of a signed 64-bit count)", -1, func(t time.Time, _ int) (string, error) {
+		if t.Before(minUnixNano) || t.After(maxUnixNano) {
+			return "", fmt.Errorf("%s is outside the range of Unix nanoseconds (%s to %s)", t.Format(time.RFC3339), minUnixNano.Format(time.RFC3339Nano), maxUnixNano.Format(time.RFC3339Nano))
+		}
+		return strconv.FormatInt(t.UnixNano(), 10), nil
+	}},
+	{"unix_float", "Unix time in seconds with a decimal fraction, e.g. 1136214245.123456", 6, func(t time.Time, p int) (string, error) {
+		sec, ns := t.Unix(), int64(t.Nanosecond())
+		sign := ""
+		if sec < 0 && ns > 0 {
+			// Before 1970 the fraction counts back from the next whole second
+			sign, sec, ns = "-", -(sec + 1), 1e9-ns
+		}
+		text := sign + strconv.FormatInt(sec, 10)
+		if p > 0 {
+			text += "." + fmt.Sprintf("%09d", ns)[:p]
+		}
+		return text, nil
+	}},
+	{"syslog", "BSD syslog (RFC 3164) timestamp, e.g. Jan  2 15:04:05", -1, layoutFormat(time.Stamp)},
+	{"syslog_rfc5424", "RFC 5424 syslog timestamp: RFC 3339 with microseconds (at most 6 fractional digits)", 6, func(t time.Time, p int) (string, error) {
+		// RFC 5424 section 6.2.3 limits TIME-SECFRAC to 6 digits
+		return t.Format("2006-01-02T15:04:05" + fractionLayout(min(p, 6)) + "Z07:00"), nil
+	}},
+	{"common_log", "Apache/nginx Common Log Format, e.g. 02/Jan/2006:15:04:05 -0700", -1, layoutFormat("02/Jan/2006:15:04:05 -0700")},
+	{"log", "Sortable log line timestamp with milliseconds, e.g. 2006-01-02 15:04:05.000", 3, func(t time.Time, p int) (string, error) {
+		return t.Format("2006-01-02 15:04:05" + fractionLayout(p)), nil
+	}},
+	{"go_log", "Go log package default, e.g. 2006/01/02 15:04:05", -1, layoutFormat("2006/01/02 15:04:05")},
+}
+
+// timestampFormatAliases maps alternative names to timestampFormats entries
+var timestampFormatAliases = map[string]string{
+	"iso8601":      "rfc3339",
+	"iso":          "rfc3339",
+	"rfc5322":      "rfc2822",
+	"email":        "rfc2822",
+	"rfc7231":      "http_date",
+	"rfc9110":      "http_date",
+	"http":         "http_date",
+	"asctime":      "ansic",
+	"iso_week":     "iso_week_date",
+	"ordinal_date": "iso_ordinal_date",
+	"sql":          "sql_datetime",
+	"datetime":     "sql_datetime",
+	"epoch":        "unix",
+	"unix_millis":  "unix_ms",
+	"unix_micros":  "unix_us",
+	"unix_nanos":   "unix_ns",
+	"clf":          "common_log",
+	"apache":       "common_log",
+	"nginx":        "common_log",
+	"rfc3164":      "syslog",
+	"rfc5424":      "syslog_rfc5424",
+}
+
+// layoutFormat renders with a fixed Go time layout
+func layoutFormat(layout string) func(time.Time, int) (string, error) {
+	return func(t time.Time, _ int) (string, error) {
+		return t.Format(layout), nil
+	}
+}
+
+// fractionLayout returns the Go layout for precision fractional second digits
+func fractionLayout(precision int) string {
+	if precision <= 0 {
+		return ""
+	}
+	return "." + strings.Repeat("0", precision)
+}
+
+// TimestampFormats returns the named formats FormatTimestamp accepts
+func TimestampFormats() []TimestampFormat {
+	return append([]TimestampFormat{}, timestampFormats...)
+}
+
+// LookupTimestampFormat finds a format by name or alias, ignoring case and
+// treating "-" and " " as "_"
+func LookupTimestampFormat(name string) (TimestampFormat, error) {
+	key := strings.NewReplacer("-", "_", " ", "_").Replace(strings.ToLower(strings.TrimSpace(name)))
+	if alias, ok := timestampFormatAliases[key]; ok {
+		key = alias
+	}
+	for _, f := range timestampFormats {
+		if f.Name == key {
+			return f, nil
+		}
+	}
+	names := make([]string, 0, len(timestampFormats))
+	for _, f := range timestampFormats {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	return TimestampFormat{}, fmt.Errorf("unknown format '%s' (available: %s)", name, strings.Join(names, ", "))
+}
+
+// Format renders t, which should already be in the wanted timezone. precision
+// is the number of fractional second digits (0-9) for formats that carry a
+// fraction; a negative precision selects the format's default. Formats with a
+// narrower limit, such as syslog_rfc5424, clamp to it.
+func (f TimestampFormat) Format(t time.Time, precision int) (string, error) {
+	if precision > 9 {
+		return "", fmt.Errorf("precision %d is out of range (0-9 fractional digits)", precision)
+	}
+	if precision < 0 || f.DefaultPrecision < 0 {
+		precision = f.DefaultPrecision
+	}
+	return f.render(t, precision)
+}
+
+// FormatTimestamp renders t in the named format; see TimestampFormat.Format
+func FormatTimestamp(t time.Time, name string, precision int) (string, error) {
+	f, err := LookupTimestampFormat(name)
+	if err != nil {
+		return "", err
+	}
+	return f.Format(t, precision)
+}
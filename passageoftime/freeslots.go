@@ -0,0 +1,139 @@
+package passageoftime
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// FreeSlotOptions controls the free slot search
+type FreeSlotOptions struct {
+	// Participants are the attendees whose busy times and working hours apply
+	Participants []Participant
+
+	// Start and End bound the search window
+	Start time.Time
+	End   time.Time
+
+	// MinDuration is the shortest free slot worth returning (default 30 minutes)
+	MinDuration time.Duration
+
+	// Buffer is kept free before and after every busy interval
+	Buffer time.Duration
+
+	// IgnoreWorkingHours searches the whole window instead of only the hours
+	// when every participant is working
+	IgnoreWorkingHours bool
+
+	// Step is the granularity of suggested start times within a slot (default 15 minutes)
+	Step time.Duration
+}
+
+// FreeSlot is a span when every participant is free
+type FreeSlot struct {
+	Start time.Time
+	End   time.Time
+
+	// Suggested is the MinDuration meeting within the slot that best suits
+	// everyone's local time of day
+	Suggested MeetingSlot
+
+	// Score is the suggested meeting's score, from 0 (outside everyone's
+	// working hours) to 1 (the middle of everyone's working day)
+	Score float64
+}
+
+// Duration returns the length of the free slot
+func (s FreeSlot) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// FindFreeSlots returns every span of at least MinDuration in the window when
+// no participant is busy (with Buffer around busy times) and, unless
+// IgnoreWorkingHours is set, everyone is within working hours. Slots are
+// ranked by how well their best meeting time suits the participants.
+func FindFreeSlots(options FreeSlotOptions) ([]FreeSlot, error) {
+	if len(options.Participants) == 0 {
+		return nil, fmt.Errorf("at least one participant is required")
+	}
+	if !options.End.After(options.Start) {
+		return nil, fmt.Errorf("search window end must be after its start")
+	}
+	minDuration := options.MinDuration
+	if minDuration <= 0 {
+		minDuration = 30 * time.Minute
+	}
+	step := options.Step
+	if step <= 0 {
+		step = 15 * time.Minute
+	}
+
+	window := Interval{Start: options.Start, End: options.End}
+	free := []Interval{window}
+	schedules := make([]workSchedule, len(options.Participants))
+	for i, p := range options.Participants {
+		ws, err := newWorkSchedule(p)
+		if err != nil {
+			return nil, err
+		}
+		schedules[i] = ws
+
+		if !options.IgnoreWorkingHours {
+			free = IntersectIntervals(free, ws.windows(window))
+		}
+		var busy []Interval
+		for _, b := range p.Busy {
+			busy = append(busy, Interval{Start: b.Start.Add(-options.Buffer), End: b.End.Add(options.Buffer)})
+		}
+		free = SubtractIntervals(free, busy)
+	}
+
+	var slots []FreeSlot
+	for _, iv := range free {
+		if iv.Duration() < minDuration {
+			continue
+		}
+		iv = iv.In(options.Start.Location())
+		slot := FreeSlot{Start: iv.Start, End: iv.End}
+
+		// Try start times aligned on the local clock (plus the slot's own
+		// start) for the best fit
+		candidates := []time.Time{iv.Start}
+		for t := alignWallClock(iv.Start.Add(time.Nanosecond), step); !t.Add(minDuration).After(iv.End); t = t.Add(step) {
+			candidates = append(candidates, t)
+		}
+		for i, start := range candidates {
+			meeting := evaluateSlot(start, start.Add(minDuration), schedules)
+			if i == 0 || meeting.Score > slot.Suggested.Score {
+				slot.Suggested = meeting
+			}
+		}
+		slot.Score = slot.Suggested.Score
+		slots = append(slots, slot)
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].Score != slots[j].Score {
+			return slots[i].Score > slots[j].Score
+		}
+		return slots[i].Start.Before(slots[j].Start)
+	})
+	return slots, nil
+}
+
+// windows returns the participant's working hours that overlap the interval
+func (ws workSchedule) windows(within Interval) []Interval {
+	var result []Interval
+	first := within.Start.In(ws.loc)
+	day := time.Date(first.Year(), first.Month(), first.Day()-1, 0, 0, 0, 0, ws.loc)
+	for ; day.Before(within.End); day = day.AddDate(0, 0, 1) {
+		if !ws.workDays[day.Weekday()] {
+			continue
+		}
+		window := Interval{Start: clockOnDay(day, ws.start, ws.loc), End: clockOnDay(day, ws.end, ws.loc)}
+		if overlap, ok := window.Intersect(within); ok {
+			result = append(result, overlap)
+		}
+	}
+	return result
+}
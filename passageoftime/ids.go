@@ -0,0 +1,283 @@
+package passageoftime
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IDKind names an ID format that embeds a creation timestamp
+type IDKind string
+
+const (
+	IDKindUUID      IDKind = "uuid"
+	IDKindULID      IDKind = "ulid"
+	IDKindKSUID     IDKind = "ksuid"
+	IDKindObjectID  IDKind = "objectid"
+	IDKindSnowflake IDKind = "snowflake"
+)
+
+// SnowflakeEpochs are the epochs of well-known Snowflake ID schemes, in
+// milliseconds since the Unix epoch
+var SnowflakeEpochs = map[string]int64{
+	"twitter": 1288834974657,
+	"discord": 1420070400000,
+}
+
+// DefaultSnowflakeEpoch is the epoch assumed for Snowflake IDs when none is given
+const DefaultSnowflakeEpoch = "twitter"
+
+// ksuidEpoch is the KSUID epoch, 2014-05-13T16:53:20Z, in Unix seconds
+const ksuidEpoch = 1400000000
+
+// uuidEpochOffset is the number of 100 ns intervals from the Gregorian
+// calendar reform (1582-10-15), where UUID v1/v6 times start, to the Unix epoch
+const uuidEpochOffset = 122192928000000000
+
+// DecodedID is the timestamp recovered from an ID
+type DecodedID struct {
+	// Kind is the detected format
+	Kind IDKind
+
+	// Version is the UUID version (0 for other kinds)
+	Version int
+
+	// Timestamp is the embedded creation time in UTC
+	Timestamp time.Time
+
+	// Precision is the resolution of the embedded timestamp (e.g. "1ms")
+	Precision string
+
+	// Details lists other fields carried by the ID, such as a Snowflake's
+	// worker and sequence numbers
+	Details map[string]interface{}
+}
+
+var (
+	uuidPattern      = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+	ulidPattern      = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	ksuidPattern     = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+	objectIDPattern  = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+	snowflakePattern = regexp.MustCompile(`^\d{1,20}$`)
+)
+
+// ParseIDKind parses an ID kind name; an empty name means auto-detect
+func ParseIDKind(name string) (IDKind, error) {
+	switch strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(name))) {
+	case "", "auto":
+		return "", nil
+	case "uuid", "guid", "uuidv1", "uuidv6", "uuidv7":
+		return IDKindUUID, nil
+	case "ulid":
+		return IDKindULID, nil
+	case "ksuid":
+		return IDKindKSUID, nil
+	case "objectid", "mongo", "mongodb", "bson":
+		return IDKindObjectID, nil
+	case "snowflake", "twitter", "discord":
+		return IDKindSnowflake, nil
+	}
+	return "", fmt.Errorf("invalid ID kind '%s' (expected uuid, ulid, ksuid, objectid or snowflake)", name)
+}
+
+// ParseSnowflakeEpoch resolves a Snowflake epoch given as a preset name
+// ("twitter", "discord"), a number of Unix milliseconds, or a timestamp in any
+// format ParseFuzzyTimestamp accepts. An empty value selects DefaultSnowflakeEpoch.
+func ParseSnowflakeEpoch(value string, options ParseOptions) (time.Time, error) {
+	parsed, err := ParseSnowflakeEpochDetailed(value, options)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.Time, nil
+}
+
+// ParseSnowflakeEpochDetailed is ParseSnowflakeEpoch that also reports a DST
+// gap or overlap resolved in an epoch given as a local timestamp
+func ParseSnowflakeEpochDetailed(value string, options ParseOptions) (*ParsedTimestamp, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		value = DefaultSnowflakeEpoch
+	}
+	if ms, ok := SnowflakeEpochs[strings.ToLower(value)]; ok {
+		return &ParsedTimestamp{Time: time.UnixMilli(ms).UTC()}, nil
+	}
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &ParsedTimestamp{Time: time.UnixMilli(ms).UTC()}, nil
+	}
+	parsed, err := ParseFuzzyTimestampDetailed(value, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snowflake epoch '%s': use twitter, discord, Unix milliseconds or a timestamp", value)
+	}
+	parsed.Time = parsed.Time.UTC()
+	return parsed, nil
+}
+
+// DetectIDKind guesses the format of id from its length and alphabet. A
+// 32-digit hex string is read as a UUID without hyphens and a run of at most
+// 20 decimal digits as a Snowflake.
+func DetectIDKind(id string) (IDKind, error) {
+	id = trimUUID(id)
+	switch {
+	case uuidPattern.MatchString(id):
+		return IDKindUUID, nil
+	case objectIDPattern.MatchString(id) && !snowflakePattern.MatchString(id):
+		return IDKindObjectID, nil
+	case snowflakePattern.MatchString(id):
+		return IDKindSnowflake, nil
+	case ulidPattern.MatchString(id):
+		return IDKindULID, nil
+	case ksuidPattern.MatchString(id):
+		return IDKindKSUID, nil
+	}
+	return "", fmt.Errorf("unrecognized ID '%s': expected a UUID (36 chars), ULID (26), KSUID (27), ObjectID (24 hex) or Snowflake (decimal)", id)
+}
+
+// DecodeIDTimestamp extracts the creation timestamp embedded in id. kind may
+// be empty to detect the format; snowflakeEpoch is used only for Snowflakes.
+func DecodeIDTimestamp(id string, kind IDKind, snowflakeEpoch time.Time) (*DecodedID, error) {
+	id = trimUUID(id)
+	if kind == "" {
+		detected, err := DetectIDKind(id)
+		if err != nil {
+			return nil, err
+		}
+		kind = detected
+	}
+
+	switch kind {
+	case IDKindUUID:
+		return decodeUUID(id)
+	case IDKindULID:
+		return decodeULID(id)
+	case IDKindKSUID:
+		return decodeKSUID(id)
+	case IDKindObjectID:
+		return decodeObjectID(id)
+	case IDKindSnowflake:
+		return decodeSnowflake(id, snowflakeEpoch)
+	}
+	return nil, fmt.Errorf("invalid ID kind '%s'", kind)
+}
+
+// trimUUID removes whitespace and the braces or urn:uuid: prefix UUIDs are
+// sometimes written with
+func trimUUID(id string) string {
+	id = strings.TrimSpace(id)
+	if len(id) > 9 && strings.EqualFold(id[:9], "urn:uuid:") {
+		id = id[9:]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(id, "{"), "}")
+}
+
+func decodeUUID(id string) (*DecodedID, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid UUID '%s'", id)
+	}
+	b, _ := hex.DecodeString(strings.ReplaceAll(id, "-", ""))
+	version := int(b[6] >> 4)
+	decoded := &DecodedID{Kind: IDKindUUID, Version: version, Details: map[string]interface{}{}}
+
+	switch version {
+	case 1, 6:
+		var ticks uint64
+		if version == 1 {
+			// time_low, time_mid, time_hi (the low-order field comes first)
+			ticks = uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)<<48 |
+				uint64(binary.BigEndian.Uint16(b[4:6]))<<32 |
+				uint64(binary.BigEndian.Uint32(b[0:4]))
+		} else {
+			// v6 reorders the fields most significant first so the UUIDs sort by time
+			ticks = uint64(binary.BigEndian.Uint32(b[0:4]))<<28 |
+				uint64(binary.BigEndian.Uint16(b[4:6]))<<12 |
+				uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)
+		}
+		unix100ns := int64(ticks) - uuidEpochOffset
+		decoded.Timestamp = time.Unix(unix100ns/1e7, unix100ns%1e7*100).UTC()
+		decoded.Precision = "100ns"
+		decoded.Details["clock_sequence"] = int(binary.BigEndian.Uint16(b[8:10]) & 0x3fff)
+		decoded.Details["node"] = fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x", b[10], b[11], b[12], b[13], b[14], b[15])
+	case 7:
+		ms := int64(b[0])<<40 | int64(b[1])<<32 | int64(binary.BigEndian.Uint32(b[2:6]))
+		decoded.Timestamp = time.UnixMilli(ms).UTC()
+		decoded.Precision = "1ms"
+	default:
+		return nil, fmt.Errorf("UUID version %d does not embed a timestamp (only versions 1, 6 and 7 do)", version)
+	}
+	return decoded, nil
+}
+
+// crockfordAlphabet is the Crockford base32 alphabet used by ULIDs
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+func decodeULID(id string) (*DecodedID, error) {
+	if !ulidPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid ULID '%s': expected 26 Crockford base32 characters", id)
+	}
+	// The first 10 characters encode a 48-bit millisecond Unix time
+	var ms int64
+	for _, c := range strings.ToUpper(id[:10]) {
+		ms = ms<<5 | int64(strings.IndexRune(crockfordAlphabet, c))
+	}
+	return &DecodedID{Kind: IDKindULID, Timestamp: time.UnixMilli(ms).UTC(), Precision: "1ms"}, nil
+}
+
+// base62Alphabet is the KSUID base62 alphabet
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func decodeKSUID(id string) (*DecodedID, error) {
+	if !ksuidPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid KSUID '%s': expected 27 base62 characters", id)
+	}
+	n := new(big.Int)
+	for _, c := range id {
+		n.Mul(n, big.NewInt(62))
+		n.Add(n, big.NewInt(int64(strings.IndexRune(base62Alphabet, c))))
+	}
+	b := n.Bytes()
+	if len(b) > 20 {
+		return nil, fmt.Errorf("invalid KSUID '%s': value exceeds 160 bits", id)
+	}
+	// The 20-byte payload starts with a 32-bit seconds count from the KSUID epoch
+	padded := make([]byte, 20)
+	copy(padded[20-len(b):], b)
+	seconds := int64(binary.BigEndian.Uint32(padded[:4]))
+	return &DecodedID{Kind: IDKindKSUID, Timestamp: time.Unix(ksuidEpoch+seconds, 0).UTC(), Precision: "1s"}, nil
+}
+
+func decodeObjectID(id string) (*DecodedID, error) {
+	if !objectIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid ObjectID '%s': expected 24 hex characters", id)
+	}
+	b, _ := hex.DecodeString(id)
+	seconds := int64(binary.BigEndian.Uint32(b[:4]))
+	return &DecodedID{
+		Kind:      IDKindObjectID,
+		Timestamp: time.Unix(seconds, 0).UTC(),
+		Precision: "1s",
+		Details:   map[string]interface{}{"counter": int(b[9])<<16 | int(b[10])<<8 | int(b[11])},
+	}, nil
+}
+
+func decodeSnowflake(id string, epoch time.Time) (*DecodedID, error) {
+	value, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || value >= 1<<63 {
+		return nil, fmt.Errorf("invalid Snowflake '%s': expected a positive 64-bit integer", id)
+	}
+	// 41 bits of milliseconds since the epoch, 10 bits of worker, 12 of sequence
+	ms := int64(value >> 22)
+	return &DecodedID{
+		Kind:      IDKindSnowflake,
+		Timestamp: epoch.Add(time.Duration(ms) * time.Millisecond).UTC(),
+		Precision: "1ms",
+		Details: map[string]interface{}{
+			"epoch":    epoch.UTC().Format(time.RFC3339Nano),
+			"worker":   int(value>>12) & 0x3ff,
+			"sequence": int(value) & 0xfff,
+		},
+	}, nil
+}
@@ -0,0 +1,255 @@
+package internal
+
+import "math"
+
+// Low-precision astronomy after Jean Meeus, "Astronomical Algorithms" (2nd ed.).
+// Times are Julian Days; JDE is Terrestrial Time and JD is Universal Time.
+// Accuracy is about a minute for new moons and 0.01° for the sun's longitude,
+// which is enough to date calendar events to the day.
+
+const j2000 = 2451545.0
+
+// DeltaT returns TT - UT in seconds for a (fractional) year, using the
+// polynomial fits of Espenak and Meeus
+func DeltaT(year float64) float64 {
+	u := (year - 1820) / 100
+	switch {
+	case year < 1900:
+		return -20 + 32*u*u
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	default:
+		return -20 + 32*u*u
+	}
+}
+
+// TTToUT converts a JDE to a JD
+func TTToUT(jde float64) float64 {
+	year := 2000 + (jde-j2000)/365.25
+	return jde - DeltaT(year)/86400
+}
+
+// UTToTT converts a JD to a JDE
+func UTToTT(jd float64) float64 {
+	year := 2000 + (jd-j2000)/365.25
+	return jd + DeltaT(year)/86400
+}
+
+// NewMoon returns the JD (UT) of new moon number k, counted from the new moon
+// of 6 January 2000 (Meeus chapter 49)
+func NewMoon(k float64) float64 {
+	t := k / 1236.85
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := deg(2.5534 + 29.10535670*k - 0.0000014*t*t - 0.00000011*t*t*t)
+	mp := deg(201.5643 + 385.81693528*k + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t)
+	f := deg(160.7108 + 390.67050284*k - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t)
+	omega := deg(124.7746 - 1.56375588*k + 0.0020672*t*t + 0.00000215*t*t*t)
+
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+
+	return TTToUT(jde)
+}
+
+// NewMoonIndex returns the number k of the last new moon at or before jd
+func NewMoonIndex(jd float64) float64 {
+	k := math.Floor((jd - 2451550.09766) / 29.530588861)
+	for NewMoon(k+1) <= jd {
+		k++
+	}
+	for NewMoon(k) > jd {
+		k--
+	}
+	return k
+}
+
+// SunLongitude returns the sun's apparent ecliptic longitude in degrees at jd (UT)
+func SunLongitude(jd float64) float64 {
+	t := (UTToTT(jd) - j2000) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := deg(357.52911 + 35999.05029*t - 0.0001537*t*t)
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	omega := deg(125.04 - 1934.136*t)
+	return normalizeDegrees(l0 + c - 0.00569 - 0.00478*math.Sin(omega))
+}
+
+// SolarTerm returns the JD (UT) at which the sun's apparent longitude reaches
+// target degrees, searching near the estimate jd (within about two months)
+func SolarTerm(target, jd float64) float64 {
+	for i := 0; i < 10; i++ {
+		diff := math.Remainder(target-SunLongitude(jd), 360)
+		jd += diff * 365.2422 / 360
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return jd
+}
+
+// MoonPosition returns the moon's geocentric ecliptic longitude and latitude in
+// degrees and its distance in km at jd (UT), from the main terms of Meeus chapter 47
+func MoonPosition(jd float64) (longitude, latitude, distance float64) {
+	t := (UTToTT(jd) - j2000) / 36525
+	lp := 218.3164477 + 481267.88123421*t
+	d := deg(297.8501921 + 445267.1114034*t)
+	m := deg(357.5291092 + 35999.0502909*t)
+	mp := deg(134.9633964 + 477198.8675055*t)
+	f := deg(93.2720950 + 483202.0175233*t)
+	e := 1 - 0.002516*t
+
+	sl := 6288774*math.Sin(mp) +
+		1274027*math.Sin(2*d-mp) +
+		658314*math.Sin(2*d) +
+		213618*math.Sin(2*mp) -
+		185116*e*math.Sin(m) -
+		114332*math.Sin(2*f) +
+		58793*math.Sin(2*d-2*mp) +
+		57066*e*math.Sin(2*d-m-mp) +
+		53322*math.Sin(2*d+mp) +
+		45758*e*math.Sin(2*d-m) -
+		40923*e*math.Sin(m-mp) -
+		34720*math.Sin(d) -
+		30383*e*math.Sin(m+mp) +
+		15327*math.Sin(2*d-2*f) -
+		12528*math.Sin(mp+2*f) +
+		10980*math.Sin(mp-2*f) +
+		10675*math.Sin(4*d-mp) +
+		10034*math.Sin(3*mp) +
+		8548*math.Sin(4*d-2*mp) -
+		7888*e*math.Sin(2*d+m-mp) -
+		6766*e*math.Sin(2*d+m) -
+		5163*math.Sin(d-mp) +
+		4987*e*math.Sin(d+m) +
+		4036*e*math.Sin(2*d-m+mp)
+
+	sb := 5128122*math.Sin(f) +
+		280602*math.Sin(mp+f) +
+		277693*math.Sin(mp-f) +
+		173237*math.Sin(2*d-f) +
+		55413*math.Sin(2*d-mp+f) +
+		46271*math.Sin(2*d-mp-f) +
+		32573*math.Sin(2*d+f) +
+		17198*math.Sin(2*mp+f) +
+		9266*math.Sin(2*d+mp-f) +
+		8822*math.Sin(2*mp-f)
+
+	sr := -20905355*math.Cos(mp) -
+		3699111*math.Cos(2*d-mp) -
+		2955968*math.Cos(2*d) -
+		569925*math.Cos(2*mp) +
+		48888*e*math.Cos(m) -
+		3149*math.Cos(2*f) +
+		246158*math.Cos(2*d-2*mp) -
+		152138*e*math.Cos(2*d-m-mp) -
+		170733*math.Cos(2*d+mp) -
+		204586*e*math.Cos(2*d-m) -
+		129620*e*math.Cos(m-mp) +
+		108743*math.Cos(d) +
+		104755*e*math.Cos(m+mp)
+
+	return normalizeDegrees(lp + sl/1e6), sb / 1e6, 385000.56 + sr/1000
+}
+
+// Altitude returns the altitude in degrees above the horizon of a body at
+// ecliptic longitude and latitude (degrees) seen from the given place at jd (UT)
+func Altitude(jd, eclLongitude, eclLatitude, latitude, longitude float64) float64 {
+	t := (jd - j2000) / 36525
+	eps := deg(23.439291 - 0.0130042*t)
+	lam, beta := deg(eclLongitude), deg(eclLatitude)
+
+	ra := math.Atan2(math.Sin(lam)*math.Cos(eps)-math.Tan(beta)*math.Sin(eps), math.Cos(lam))
+	dec := math.Asin(math.Sin(beta)*math.Cos(eps) + math.Cos(beta)*math.Sin(eps)*math.Sin(lam))
+
+	gmst := 280.46061837 + 360.98564736629*(jd-j2000) + 0.000387933*t*t - t*t*t/38710000
+	hourAngle := deg(gmst+longitude) - ra
+
+	phi := deg(latitude)
+	return math.Asin(math.Sin(phi)*math.Sin(dec)+math.Cos(phi)*math.Cos(dec)*math.Cos(hourAngle)) * 180 / math.Pi
+}
+
+// Sunset returns the JD (UT) of sunset at the given place, searching the 24
+// hours after jd, or ok=false if the sun does not set in that window
+func Sunset(jd, latitude, longitude float64) (sunset float64, ok bool) {
+	const horizon = -0.833
+	altitude := func(t float64) float64 {
+		return Altitude(t, SunLongitude(t), 0, latitude, longitude) - horizon
+	}
+
+	// Step through the day in 20-minute increments to bracket the crossing
+	const step = 1.0 / 72
+	prev := altitude(jd)
+	for t := jd + step; t <= jd+1; t += step {
+		cur := altitude(t)
+		if prev > 0 && cur <= 0 {
+			lo, hi := t-step, t
+			for i := 0; i < 30; i++ {
+				mid := (lo + hi) / 2
+				if altitude(mid) > 0 {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			return (lo + hi) / 2, true
+		}
+		prev = cur
+	}
+	return 0, false
+}
+
+func deg(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func normalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
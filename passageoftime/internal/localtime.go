@@ -0,0 +1,71 @@
+package internal
+
+import "time"
+
+// LocalInstants returns every instant at which the given wall-clock time occurs in loc.
+// The result is sorted and holds zero instants when the wall-clock time falls into a
+// DST gap, one instant for ordinary times and two instants when it is ambiguous
+// (e.g. 01:30 during a fall-back transition).
+//
+// time.Date silently normalizes both cases, so callers that care about DST
+// correctness should use this instead.
+func LocalInstants(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) []time.Time {
+	wall := time.Date(year, month, day, hour, min, sec, nsec, time.UTC)
+	_, current := time.Date(year, month, day, hour, min, sec, nsec, loc).Zone()
+
+	// Any transition affecting this wall-clock time lies within half a day of it,
+	// so the offsets in effect on either side cover every candidate.
+	before, after := OffsetsAround(year, month, day, hour, min, sec, nsec, loc)
+	offsets := []int{current}
+	for _, offset := range []int{before, after} {
+		if !containsInt(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	var instants []time.Time
+	for _, offset := range offsets {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if !sameWallClock(candidate, wall) {
+			continue
+		}
+		if len(instants) > 0 && instants[0].Equal(candidate) {
+			continue
+		}
+		instants = append(instants, candidate)
+	}
+
+	if len(instants) == 2 && instants[1].Before(instants[0]) {
+		instants[0], instants[1] = instants[1], instants[0]
+	}
+
+	return instants
+}
+
+// OffsetsAround returns the UTC offsets (in seconds) in effect in loc half a day
+// before and half a day after the given wall-clock time. For a wall-clock time
+// inside a DST gap these are the offsets on either side of the gap.
+func OffsetsAround(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) (before, after int) {
+	guess := time.Date(year, month, day, hour, min, sec, nsec, loc)
+	_, before = guess.Add(-12 * time.Hour).Zone()
+	_, after = guess.Add(12 * time.Hour).Zone()
+	return before, after
+}
+
+// sameWallClock reports whether t shows the same wall-clock reading as wall (which is in UTC)
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() &&
+		t.Second() == wall.Second() && t.Nanosecond() == wall.Nanosecond()
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -2,9 +2,15 @@ package internal
 
 import (
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 )
 
+//go:generate go run ../../cmd/generate-timezone-list -links-output tzlinks.go
+//go:generate go run ../../cmd/generate-timezone-list -zonetab-output zonetab.go
+//go:generate go run ../../cmd/generate-timezone-mapping -release release-46 -output windows_mapping.go
+
 // GetSystemTimezone returns the system's local timezone name using platform-specific detection.
 // Falls back to "UTC" if the system timezone cannot be determined.
 //
@@ -16,29 +22,132 @@ func GetSystemTimezone() string {
 	// Get the system's local timezone using Go's standard method
 	loc := time.Now().Location()
 	tzName := loc.String()
-	
+
 	// If we get a proper IANA timezone name, use it directly
 	// This works well on most Unix systems
 	if tzName != "" && tzName != "Local" {
 		return tzName
 	}
-	
+
 	// If we get "Local" or empty, we need platform-specific resolution
 	// This is common on Windows where Go returns "Local" instead of IANA name
 	var detectedTz string
 	var err error
-	
+
 	if runtime.GOOS == "windows" {
 		detectedTz, err = GetSystemTimezoneWindows()
 	} else {
 		// Unix-like systems (Linux, macOS, etc.)
 		detectedTz, err = GetSystemTimezoneUnix()
 	}
-	
+
 	if err == nil && detectedTz != "" {
 		return detectedTz
 	}
-	
+
 	// Final fallback to UTC if we can't determine the actual timezone
 	return "UTC"
 }
+
+// CanonicalTimezoneID returns the zone an alias links to, following chains of
+// links, or id itself when it is not a link
+func CanonicalTimezoneID(id string) string {
+	for i := 0; i < 8; i++ {
+		target, ok := timezoneLinks[id]
+		if !ok {
+			break
+		}
+		id = target
+	}
+	return id
+}
+
+// TimezoneAliases returns the other names of id's zone: its canonical ID when
+// id is a link, and every link to that zone, sorted
+func TimezoneAliases(id string) []string {
+	canonical := CanonicalTimezoneID(id)
+	var aliases []string
+	for alias := range timezoneLinks {
+		if alias != id && CanonicalTimezoneID(alias) == canonical {
+			aliases = append(aliases, alias)
+		}
+	}
+	if canonical != id {
+		aliases = append(aliases, canonical)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// CountryName returns the tz database name of the country with the given ISO
+// 3166 alpha-2 code, e.g. "DE" -> "Germany"
+func CountryName(code string) (string, bool) {
+	name, ok := countryNames[strings.ToUpper(code)]
+	return name, ok
+}
+
+// CountryCodes returns every ISO 3166 alpha-2 code the tz database names, sorted
+func CountryCodes() []string {
+	codes := make([]string, 0, len(countryNames))
+	for code := range countryNames {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// ZoneLocation is a zone's entry in the tz database's zone.tab and zone1970.tab
+type ZoneLocation struct {
+	// CountryCodes are the ISO 3166 codes of the countries the zone covers,
+	// its primary country first
+	CountryCodes []string
+
+	// Latitude and Longitude are the zone's representative location (usually
+	// its principal city) in decimal degrees
+	Latitude  float64
+	Longitude float64
+
+	// Comment tells zones of the same country apart, e.g. "Mountain (most
+	// areas)"; empty for countries with one zone
+	Comment string
+
+	// Zone is the zone the entry belongs to: the ID looked up, or the zone
+	// it links to when the alias has no entry of its own
+	Zone string
+}
+
+// TimezoneLocation returns a zone's countries, coordinates and comment.
+// Aliases use the location of the zone they link to.
+func TimezoneLocation(id string) (ZoneLocation, bool) {
+	location, ok := zoneLocations[id]
+	location.Zone = id
+	if !ok {
+		location, ok = zoneLocations[CanonicalTimezoneID(id)]
+		location.Zone = CanonicalTimezoneID(id)
+	}
+	location.CountryCodes = append([]string{}, location.CountryCodes...)
+	return location, ok
+}
+
+// TimezoneCountries returns the ISO country codes a zone covers, its primary
+// country first. Aliases use the countries of the zone they link to.
+func TimezoneCountries(id string) []string {
+	location, _ := TimezoneLocation(id)
+	return location.CountryCodes
+}
+
+// CountryTimezones returns the zones that cover a country, sorted
+func CountryTimezones(code string) []string {
+	code = strings.ToUpper(code)
+	var zones []string
+	for zone, location := range zoneLocations {
+		for _, c := range location.CountryCodes {
+			if c == code {
+				zones = append(zones, zone)
+				break
+			}
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
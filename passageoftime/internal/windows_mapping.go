@@ -1,6 +1,7 @@
 // Code generated by generate-timezone-mapping tool. DO NOT EDIT.
 // Generated from Unicode CLDR windowsZones.xml
-// Source: https://raw.githubusercontent.com/unicode-org/cldr/refs/heads/main/common/supplemental/windowsZones.xml
+// CLDR release: release-46
+// Source: https://raw.githubusercontent.com/unicode-org/cldr/release-46/common/supplemental/windowsZones.xml
 
 package internal
 
@@ -9,149 +10,659 @@ package internal
 // windowsZones.xml file, which is the authoritative source for timezone mappings.
 //
 // Examples:
-//   "Eastern Standard Time"     -> "America/New_York"
-//   "Pacific Standard Time"     -> "America/Los_Angeles"
-//   "Central European Time"     -> "Europe/Berlin"
+//
+//	"Eastern Standard Time"     -> "America/New_York"
+//	"Pacific Standard Time"     -> "America/Los_Angeles"
+//	"W. Europe Standard Time"   -> "Europe/Berlin"
 var WindowsToIANA = map[string]string{
-	"AUS Central Standard Time": "Australia/Darwin",
-	"AUS Eastern Standard Time": "Australia/Sydney",
-	"Afghanistan Standard Time": "Asia/Kabul",
-	"Alaskan Standard Time": "America/Anchorage",
-	"Aleutian Standard Time": "America/Adak",
-	"Altai Standard Time": "Asia/Barnaul",
-	"Arab Standard Time": "Asia/Riyadh",
-	"Arabian Standard Time": "Asia/Dubai",
-	"Arabic Standard Time": "Asia/Baghdad",
-	"Argentina Standard Time": "America/Buenos_Aires",
-	"Astrakhan Standard Time": "Europe/Astrakhan",
-	"Atlantic Standard Time": "America/Halifax",
-	"Aus Central W. Standard Time": "Australia/Eucla",
-	"Azerbaijan Standard Time": "Asia/Baku",
-	"Azores Standard Time": "Atlantic/Azores",
-	"Bahia Standard Time": "America/Bahia",
-	"Bangladesh Standard Time": "Asia/Dhaka",
-	"Belarus Standard Time": "Europe/Minsk",
-	"Bougainville Standard Time": "Pacific/Bougainville",
-	"Canada Central Standard Time": "America/Regina",
-	"Cape Verde Standard Time": "Atlantic/Cape_Verde",
-	"Caucasus Standard Time": "Asia/Yerevan",
-	"Cen. Australia Standard Time": "Australia/Adelaide",
-	"Central America Standard Time": "America/Guatemala",
-	"Central Asia Standard Time": "Asia/Bishkek",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Aleutian Standard Time":          "America/Adak",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Argentina Standard Time":         "America/Buenos_Aires",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Bahia Standard Time":             "America/Bahia",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Canada Central Standard Time":    "America/Regina",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Asia Standard Time":      "Asia/Bishkek",
 	"Central Brazilian Standard Time": "America/Cuiaba",
-	"Central Europe Standard Time": "Europe/Budapest",
-	"Central European Standard Time": "Europe/Warsaw",
-	"Central Pacific Standard Time": "Pacific/Guadalcanal",
-	"Central Standard Time": "America/Chicago",
-	"Central Standard Time (Mexico)": "America/Mexico_City",
-	"Chatham Islands Standard Time": "Pacific/Chatham",
-	"China Standard Time": "Asia/Shanghai",
-	"Cuba Standard Time": "America/Havana",
-	"Dateline Standard Time": "Etc/GMT+12",
-	"E. Africa Standard Time": "Africa/Nairobi",
-	"E. Australia Standard Time": "Australia/Brisbane",
-	"E. Europe Standard Time": "Europe/Chisinau",
-	"E. South America Standard Time": "America/Sao_Paulo",
-	"Easter Island Standard Time": "Pacific/Easter",
-	"Eastern Standard Time": "America/New_York",
-	"Eastern Standard Time (Mexico)": "America/Cancun",
-	"Egypt Standard Time": "Africa/Cairo",
-	"Ekaterinburg Standard Time": "Asia/Yekaterinburg",
-	"FLE Standard Time": "Europe/Kiev",
-	"Fiji Standard Time": "Pacific/Fiji",
-	"GMT Standard Time": "Europe/London",
-	"GTB Standard Time": "Europe/Bucharest",
-	"Georgian Standard Time": "Asia/Tbilisi",
-	"Greenland Standard Time": "America/Godthab",
-	"Greenwich Standard Time": "Atlantic/Reykjavik",
-	"Haiti Standard Time": "America/Port-au-Prince",
-	"Hawaiian Standard Time": "Pacific/Honolulu",
-	"India Standard Time": "Asia/Calcutta",
-	"Iran Standard Time": "Asia/Tehran",
-	"Israel Standard Time": "Asia/Jerusalem",
-	"Jordan Standard Time": "Asia/Amman",
-	"Kaliningrad Standard Time": "Europe/Kaliningrad",
-	"Korea Standard Time": "Asia/Seoul",
-	"Libya Standard Time": "Africa/Tripoli",
-	"Line Islands Standard Time": "Pacific/Kiritimati",
-	"Lord Howe Standard Time": "Australia/Lord_Howe",
-	"Magadan Standard Time": "Asia/Magadan",
-	"Magallanes Standard Time": "America/Punta_Arenas",
-	"Marquesas Standard Time": "Pacific/Marquesas",
-	"Mauritius Standard Time": "Indian/Mauritius",
-	"Middle East Standard Time": "Asia/Beirut",
-	"Montevideo Standard Time": "America/Montevideo",
-	"Morocco Standard Time": "Africa/Casablanca",
-	"Mountain Standard Time": "America/Denver",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"China Standard Time":             "Asia/Shanghai",
+	"Cuba Standard Time":              "America/Havana",
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Eastern Standard Time":           "America/New_York",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"FLE Standard Time":               "Europe/Kiev",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"GMT Standard Time":               "Europe/London",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Greenland Standard Time":         "America/Godthab",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"India Standard Time":             "Asia/Calcutta",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Jordan Standard Time":            "Asia/Amman",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"Mountain Standard Time":          "America/Denver",
 	"Mountain Standard Time (Mexico)": "America/Mazatlan",
-	"Myanmar Standard Time": "Asia/Rangoon",
-	"N. Central Asia Standard Time": "Asia/Novosibirsk",
-	"Namibia Standard Time": "Africa/Windhoek",
-	"Nepal Standard Time": "Asia/Katmandu",
-	"New Zealand Standard Time": "Pacific/Auckland",
-	"Newfoundland Standard Time": "America/St_Johns",
-	"Norfolk Standard Time": "Pacific/Norfolk",
-	"North Asia East Standard Time": "Asia/Irkutsk",
-	"North Asia Standard Time": "Asia/Krasnoyarsk",
-	"North Korea Standard Time": "Asia/Pyongyang",
-	"Omsk Standard Time": "Asia/Omsk",
-	"Pacific SA Standard Time": "America/Santiago",
-	"Pacific Standard Time": "America/Los_Angeles",
-	"Pacific Standard Time (Mexico)": "America/Tijuana",
-	"Pakistan Standard Time": "Asia/Karachi",
-	"Paraguay Standard Time": "America/Asuncion",
-	"Qyzylorda Standard Time": "Asia/Qyzylorda",
-	"Romance Standard Time": "Europe/Paris",
-	"Russia Time Zone 10": "Asia/Srednekolymsk",
-	"Russia Time Zone 11": "Asia/Kamchatka",
-	"Russia Time Zone 3": "Europe/Samara",
-	"Russian Standard Time": "Europe/Moscow",
-	"SA Eastern Standard Time": "America/Cayenne",
-	"SA Pacific Standard Time": "America/Bogota",
-	"SA Western Standard Time": "America/La_Paz",
-	"SE Asia Standard Time": "Asia/Bangkok",
-	"Saint Pierre Standard Time": "America/Miquelon",
-	"Sakhalin Standard Time": "Asia/Sakhalin",
-	"Samoa Standard Time": "Pacific/Apia",
-	"Sao Tome Standard Time": "Africa/Sao_Tome",
-	"Saratov Standard Time": "Europe/Saratov",
-	"Singapore Standard Time": "Asia/Singapore",
-	"South Africa Standard Time": "Africa/Johannesburg",
-	"South Sudan Standard Time": "Africa/Juba",
-	"Sri Lanka Standard Time": "Asia/Colombo",
-	"Sudan Standard Time": "Africa/Khartoum",
-	"Syria Standard Time": "Asia/Damascus",
-	"Taipei Standard Time": "Asia/Taipei",
-	"Tasmania Standard Time": "Australia/Hobart",
-	"Tocantins Standard Time": "America/Araguaina",
-	"Tokyo Standard Time": "Asia/Tokyo",
-	"Tomsk Standard Time": "Asia/Tomsk",
-	"Tonga Standard Time": "Pacific/Tongatapu",
-	"Transbaikal Standard Time": "Asia/Chita",
-	"Turkey Standard Time": "Europe/Istanbul",
-	"Turks And Caicos Standard Time": "America/Grand_Turk",
-	"US Eastern Standard Time": "America/Indianapolis",
-	"US Mountain Standard Time": "America/Phoenix",
-	"UTC": "Etc/UTC",
-	"UTC+12": "Etc/GMT-12",
-	"UTC+13": "Etc/GMT-13",
-	"UTC-02": "Etc/GMT+2",
-	"UTC-08": "Etc/GMT+8",
-	"UTC-09": "Etc/GMT+9",
-	"UTC-11": "Etc/GMT+11",
-	"Ulaanbaatar Standard Time": "Asia/Ulaanbaatar",
-	"Venezuela Standard Time": "America/Caracas",
-	"Vladivostok Standard Time": "Asia/Vladivostok",
-	"Volgograd Standard Time": "Europe/Volgograd",
-	"W. Australia Standard Time": "Australia/Perth",
+	"Myanmar Standard Time":           "Asia/Rangoon",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Nepal Standard Time":             "Asia/Katmandu",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"Romance Standard Time":           "Europe/Paris",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Russian Standard Time":           "Europe/Moscow",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"SA Western Standard Time":        "America/La_Paz",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Syria Standard Time":             "Asia/Damascus",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"US Eastern Standard Time":        "America/Indianapolis",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"UTC":                             "Etc/UTC",
+	"UTC+12":                          "Etc/GMT-12",
+	"UTC+13":                          "Etc/GMT-13",
+	"UTC-02":                          "Etc/GMT+2",
+	"UTC-08":                          "Etc/GMT+8",
+	"UTC-09":                          "Etc/GMT+9",
+	"UTC-11":                          "Etc/GMT+11",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"W. Australia Standard Time":      "Australia/Perth",
 	"W. Central Africa Standard Time": "Africa/Lagos",
-	"W. Europe Standard Time": "Europe/Berlin",
-	"W. Mongolia Standard Time": "Asia/Hovd",
-	"West Asia Standard Time": "Asia/Tashkent",
-	"West Bank Standard Time": "Asia/Hebron",
-	"West Pacific Standard Time": "Pacific/Port_Moresby",
-	"Yakutsk Standard Time": "Asia/Yakutsk",
-	"Yukon Standard Time": "America/Whitehorse",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Yukon Standard Time":             "America/Whitehorse",
+}
+
+// WindowsZoneMappings holds every CLDR mapZone entry: for each Windows zone,
+// the default (territory "001") zone, the zones used in each ISO 3166
+// territory, and the fixed-offset Etc zones (territory "ZZ").
+var WindowsZoneMappings = []WindowsZoneMapping{
+	{"Dateline Standard Time", "001", []string{"Etc/GMT+12"}},
+	{"Dateline Standard Time", "ZZ", []string{"Etc/GMT+12"}},
+	{"UTC-11", "001", []string{"Etc/GMT+11"}},
+	{"UTC-11", "AS", []string{"Pacific/Pago_Pago"}},
+	{"UTC-11", "NU", []string{"Pacific/Niue"}},
+	{"UTC-11", "UM", []string{"Pacific/Midway"}},
+	{"UTC-11", "ZZ", []string{"Etc/GMT+11"}},
+	{"Aleutian Standard Time", "001", []string{"America/Adak"}},
+	{"Aleutian Standard Time", "US", []string{"America/Adak"}},
+	{"Hawaiian Standard Time", "001", []string{"Pacific/Honolulu"}},
+	{"Hawaiian Standard Time", "CK", []string{"Pacific/Rarotonga"}},
+	{"Hawaiian Standard Time", "PF", []string{"Pacific/Tahiti"}},
+	{"Hawaiian Standard Time", "US", []string{"Pacific/Honolulu"}},
+	{"Hawaiian Standard Time", "ZZ", []string{"Etc/GMT+10"}},
+	{"Marquesas Standard Time", "001", []string{"Pacific/Marquesas"}},
+	{"Marquesas Standard Time", "PF", []string{"Pacific/Marquesas"}},
+	{"Alaskan Standard Time", "001", []string{"America/Anchorage"}},
+	{"Alaskan Standard Time", "US", []string{"America/Anchorage", "America/Juneau", "America/Metlakatla", "America/Nome", "America/Sitka", "America/Yakutat"}},
+	{"UTC-09", "001", []string{"Etc/GMT+9"}},
+	{"UTC-09", "PF", []string{"Pacific/Gambier"}},
+	{"UTC-09", "ZZ", []string{"Etc/GMT+9"}},
+	{"Pacific Standard Time (Mexico)", "001", []string{"America/Tijuana"}},
+	{"Pacific Standard Time (Mexico)", "MX", []string{"America/Tijuana", "America/Santa_Isabel"}},
+	{"UTC-08", "001", []string{"Etc/GMT+8"}},
+	{"UTC-08", "PN", []string{"Pacific/Pitcairn"}},
+	{"UTC-08", "ZZ", []string{"Etc/GMT+8"}},
+	{"Pacific Standard Time", "001", []string{"America/Los_Angeles"}},
+	{"Pacific Standard Time", "CA", []string{"America/Vancouver"}},
+	{"Pacific Standard Time", "US", []string{"America/Los_Angeles"}},
+	{"Pacific Standard Time", "ZZ", []string{"PST8PDT"}},
+	{"US Mountain Standard Time", "001", []string{"America/Phoenix"}},
+	{"US Mountain Standard Time", "CA", []string{"America/Creston", "America/Dawson_Creek", "America/Fort_Nelson"}},
+	{"US Mountain Standard Time", "MX", []string{"America/Hermosillo"}},
+	{"US Mountain Standard Time", "US", []string{"America/Phoenix"}},
+	{"US Mountain Standard Time", "ZZ", []string{"Etc/GMT+7"}},
+	{"Mountain Standard Time (Mexico)", "001", []string{"America/Mazatlan"}},
+	{"Mountain Standard Time (Mexico)", "MX", []string{"America/Mazatlan"}},
+	{"Mountain Standard Time", "001", []string{"America/Denver"}},
+	{"Mountain Standard Time", "CA", []string{"America/Edmonton", "America/Cambridge_Bay", "America/Inuvik"}},
+	{"Mountain Standard Time", "MX", []string{"America/Ciudad_Juarez"}},
+	{"Mountain Standard Time", "US", []string{"America/Denver", "America/Boise"}},
+	{"Mountain Standard Time", "ZZ", []string{"MST7MDT"}},
+	{"Yukon Standard Time", "001", []string{"America/Whitehorse"}},
+	{"Yukon Standard Time", "CA", []string{"America/Whitehorse", "America/Dawson"}},
+	{"Central America Standard Time", "001", []string{"America/Guatemala"}},
+	{"Central America Standard Time", "BZ", []string{"America/Belize"}},
+	{"Central America Standard Time", "CR", []string{"America/Costa_Rica"}},
+	{"Central America Standard Time", "EC", []string{"Pacific/Galapagos"}},
+	{"Central America Standard Time", "GT", []string{"America/Guatemala"}},
+	{"Central America Standard Time", "HN", []string{"America/Tegucigalpa"}},
+	{"Central America Standard Time", "NI", []string{"America/Managua"}},
+	{"Central America Standard Time", "SV", []string{"America/El_Salvador"}},
+	{"Central America Standard Time", "ZZ", []string{"Etc/GMT+6"}},
+	{"Central Standard Time", "001", []string{"America/Chicago"}},
+	{"Central Standard Time", "CA", []string{"America/Winnipeg", "America/Rainy_River", "America/Rankin_Inlet", "America/Resolute"}},
+	{"Central Standard Time", "MX", []string{"America/Matamoros", "America/Ojinaga"}},
+	{"Central Standard Time", "US", []string{"America/Chicago", "America/Indiana/Knox", "America/Indiana/Tell_City", "America/Menominee", "America/North_Dakota/Beulah", "America/North_Dakota/Center", "America/North_Dakota/New_Salem"}},
+	{"Central Standard Time", "ZZ", []string{"CST6CDT"}},
+	{"Easter Island Standard Time", "001", []string{"Pacific/Easter"}},
+	{"Easter Island Standard Time", "CL", []string{"Pacific/Easter"}},
+	{"Central Standard Time (Mexico)", "001", []string{"America/Mexico_City"}},
+	{"Central Standard Time (Mexico)", "MX", []string{"America/Mexico_City", "America/Bahia_Banderas", "America/Merida", "America/Monterrey", "America/Chihuahua"}},
+	{"Canada Central Standard Time", "001", []string{"America/Regina"}},
+	{"Canada Central Standard Time", "CA", []string{"America/Regina", "America/Swift_Current"}},
+	{"SA Pacific Standard Time", "001", []string{"America/Bogota"}},
+	{"SA Pacific Standard Time", "BR", []string{"America/Rio_Branco", "America/Eirunepe"}},
+	{"SA Pacific Standard Time", "CA", []string{"America/Coral_Harbour"}},
+	{"SA Pacific Standard Time", "CO", []string{"America/Bogota"}},
+	{"SA Pacific Standard Time", "EC", []string{"America/Guayaquil"}},
+	{"SA Pacific Standard Time", "JM", []string{"America/Jamaica"}},
+	{"SA Pacific Standard Time", "KY", []string{"America/Cayman"}},
+	{"SA Pacific Standard Time", "PA", []string{"America/Panama"}},
+	{"SA Pacific Standard Time", "PE", []string{"America/Lima"}},
+	{"SA Pacific Standard Time", "ZZ", []string{"Etc/GMT+5"}},
+	{"Eastern Standard Time (Mexico)", "001", []string{"America/Cancun"}},
+	{"Eastern Standard Time (Mexico)", "MX", []string{"America/Cancun"}},
+	{"Eastern Standard Time", "001", []string{"America/New_York"}},
+	{"Eastern Standard Time", "BS", []string{"America/Nassau"}},
+	{"Eastern Standard Time", "CA", []string{"America/Toronto", "America/Iqaluit", "America/Montreal", "America/Nipigon", "America/Pangnirtung", "America/Thunder_Bay"}},
+	{"Eastern Standard Time", "US", []string{"America/New_York", "America/Detroit", "America/Indiana/Petersburg", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Kentucky/Monticello", "America/Louisville"}},
+	{"Eastern Standard Time", "ZZ", []string{"EST5EDT"}},
+	{"Haiti Standard Time", "001", []string{"America/Port-au-Prince"}},
+	{"Haiti Standard Time", "HT", []string{"America/Port-au-Prince"}},
+	{"Cuba Standard Time", "001", []string{"America/Havana"}},
+	{"Cuba Standard Time", "CU", []string{"America/Havana"}},
+	{"US Eastern Standard Time", "001", []string{"America/Indianapolis"}},
+	{"US Eastern Standard Time", "US", []string{"America/Indianapolis", "America/Indiana/Marengo", "America/Indiana/Vevay"}},
+	{"Turks And Caicos Standard Time", "001", []string{"America/Grand_Turk"}},
+	{"Turks And Caicos Standard Time", "TC", []string{"America/Grand_Turk"}},
+	{"Paraguay Standard Time", "001", []string{"America/Asuncion"}},
+	{"Paraguay Standard Time", "PY", []string{"America/Asuncion"}},
+	{"Atlantic Standard Time", "001", []string{"America/Halifax"}},
+	{"Atlantic Standard Time", "BM", []string{"Atlantic/Bermuda"}},
+	{"Atlantic Standard Time", "CA", []string{"America/Halifax", "America/Glace_Bay", "America/Goose_Bay", "America/Moncton"}},
+	{"Atlantic Standard Time", "GL", []string{"America/Thule"}},
+	{"Venezuela Standard Time", "001", []string{"America/Caracas"}},
+	{"Venezuela Standard Time", "VE", []string{"America/Caracas"}},
+	{"Central Brazilian Standard Time", "001", []string{"America/Cuiaba"}},
+	{"Central Brazilian Standard Time", "BR", []string{"America/Cuiaba", "America/Campo_Grande"}},
+	{"SA Western Standard Time", "001", []string{"America/La_Paz"}},
+	{"SA Western Standard Time", "AG", []string{"America/Antigua"}},
+	{"SA Western Standard Time", "AI", []string{"America/Anguilla"}},
+	{"SA Western Standard Time", "AW", []string{"America/Aruba"}},
+	{"SA Western Standard Time", "BB", []string{"America/Barbados"}},
+	{"SA Western Standard Time", "BL", []string{"America/St_Barthelemy"}},
+	{"SA Western Standard Time", "BO", []string{"America/La_Paz"}},
+	{"SA Western Standard Time", "BQ", []string{"America/Kralendijk"}},
+	{"SA Western Standard Time", "BR", []string{"America/Manaus", "America/Boa_Vista", "America/Porto_Velho"}},
+	{"SA Western Standard Time", "CA", []string{"America/Blanc-Sablon"}},
+	{"SA Western Standard Time", "CW", []string{"America/Curacao"}},
+	{"SA Western Standard Time", "DM", []string{"America/Dominica"}},
+	{"SA Western Standard Time", "DO", []string{"America/Santo_Domingo"}},
+	{"SA Western Standard Time", "GD", []string{"America/Grenada"}},
+	{"SA Western Standard Time", "GP", []string{"America/Guadeloupe"}},
+	{"SA Western Standard Time", "GY", []string{"America/Guyana"}},
+	{"SA Western Standard Time", "KN", []string{"America/St_Kitts"}},
+	{"SA Western Standard Time", "LC", []string{"America/St_Lucia"}},
+	{"SA Western Standard Time", "MF", []string{"America/Marigot"}},
+	{"SA Western Standard Time", "MQ", []string{"America/Martinique"}},
+	{"SA Western Standard Time", "MS", []string{"America/Montserrat"}},
+	{"SA Western Standard Time", "PR", []string{"America/Puerto_Rico"}},
+	{"SA Western Standard Time", "SX", []string{"America/Lower_Princes"}},
+	{"SA Western Standard Time", "TT", []string{"America/Port_of_Spain"}},
+	{"SA Western Standard Time", "VC", []string{"America/St_Vincent"}},
+	{"SA Western Standard Time", "VG", []string{"America/Tortola"}},
+	{"SA Western Standard Time", "VI", []string{"America/St_Thomas"}},
+	{"SA Western Standard Time", "ZZ", []string{"Etc/GMT+4"}},
+	{"Pacific SA Standard Time", "001", []string{"America/Santiago"}},
+	{"Pacific SA Standard Time", "CL", []string{"America/Santiago"}},
+	{"Newfoundland Standard Time", "001", []string{"America/St_Johns"}},
+	{"Newfoundland Standard Time", "CA", []string{"America/St_Johns"}},
+	{"Tocantins Standard Time", "001", []string{"America/Araguaina"}},
+	{"Tocantins Standard Time", "BR", []string{"America/Araguaina"}},
+	{"E. South America Standard Time", "001", []string{"America/Sao_Paulo"}},
+	{"E. South America Standard Time", "BR", []string{"America/Sao_Paulo"}},
+	{"SA Eastern Standard Time", "001", []string{"America/Cayenne"}},
+	{"SA Eastern Standard Time", "AQ", []string{"Antarctica/Rothera", "Antarctica/Palmer"}},
+	{"SA Eastern Standard Time", "BR", []string{"America/Fortaleza", "America/Belem", "America/Maceio", "America/Recife", "America/Santarem"}},
+	{"SA Eastern Standard Time", "FK", []string{"Atlantic/Stanley"}},
+	{"SA Eastern Standard Time", "GF", []string{"America/Cayenne"}},
+	{"SA Eastern Standard Time", "SR", []string{"America/Paramaribo"}},
+	{"SA Eastern Standard Time", "ZZ", []string{"Etc/GMT+3"}},
+	{"Argentina Standard Time", "001", []string{"America/Buenos_Aires"}},
+	{"Argentina Standard Time", "AR", []string{"America/Buenos_Aires", "America/Argentina/La_Rioja", "America/Argentina/Rio_Gallegos", "America/Argentina/Salta", "America/Argentina/San_Juan", "America/Argentina/San_Luis", "America/Argentina/Tucuman", "America/Argentina/Ushuaia", "America/Catamarca", "America/Cordoba", "America/Jujuy", "America/Mendoza"}},
+	{"Greenland Standard Time", "001", []string{"America/Godthab"}},
+	{"Greenland Standard Time", "GL", []string{"America/Godthab"}},
+	{"Montevideo Standard Time", "001", []string{"America/Montevideo"}},
+	{"Montevideo Standard Time", "UY", []string{"America/Montevideo"}},
+	{"Magallanes Standard Time", "001", []string{"America/Punta_Arenas"}},
+	{"Magallanes Standard Time", "CL", []string{"America/Punta_Arenas"}},
+	{"Saint Pierre Standard Time", "001", []string{"America/Miquelon"}},
+	{"Saint Pierre Standard Time", "PM", []string{"America/Miquelon"}},
+	{"Bahia Standard Time", "001", []string{"America/Bahia"}},
+	{"Bahia Standard Time", "BR", []string{"America/Bahia"}},
+	{"UTC-02", "001", []string{"Etc/GMT+2"}},
+	{"UTC-02", "BR", []string{"America/Noronha"}},
+	{"UTC-02", "GS", []string{"Atlantic/South_Georgia"}},
+	{"UTC-02", "ZZ", []string{"Etc/GMT+2"}},
+	{"Azores Standard Time", "001", []string{"Atlantic/Azores"}},
+	{"Azores Standard Time", "GL", []string{"America/Scoresbysund"}},
+	{"Azores Standard Time", "PT", []string{"Atlantic/Azores"}},
+	{"Cape Verde Standard Time", "001", []string{"Atlantic/Cape_Verde"}},
+	{"Cape Verde Standard Time", "CV", []string{"Atlantic/Cape_Verde"}},
+	{"Cape Verde Standard Time", "ZZ", []string{"Etc/GMT+1"}},
+	{"UTC", "001", []string{"Etc/UTC"}},
+	{"UTC", "ZZ", []string{"Etc/UTC", "Etc/GMT"}},
+	{"GMT Standard Time", "001", []string{"Europe/London"}},
+	{"GMT Standard Time", "ES", []string{"Atlantic/Canary"}},
+	{"GMT Standard Time", "FO", []string{"Atlantic/Faeroe"}},
+	{"GMT Standard Time", "GB", []string{"Europe/London"}},
+	{"GMT Standard Time", "GG", []string{"Europe/Guernsey"}},
+	{"GMT Standard Time", "IE", []string{"Europe/Dublin"}},
+	{"GMT Standard Time", "IM", []string{"Europe/Isle_of_Man"}},
+	{"GMT Standard Time", "JE", []string{"Europe/Jersey"}},
+	{"GMT Standard Time", "PT", []string{"Europe/Lisbon", "Atlantic/Madeira"}},
+	{"Greenwich Standard Time", "001", []string{"Atlantic/Reykjavik"}},
+	{"Greenwich Standard Time", "BF", []string{"Africa/Ouagadougou"}},
+	{"Greenwich Standard Time", "CI", []string{"Africa/Abidjan"}},
+	{"Greenwich Standard Time", "GH", []string{"Africa/Accra"}},
+	{"Greenwich Standard Time", "GL", []string{"America/Danmarkshavn"}},
+	{"Greenwich Standard Time", "GM", []string{"Africa/Banjul"}},
+	{"Greenwich Standard Time", "GN", []string{"Africa/Conakry"}},
+	{"Greenwich Standard Time", "GW", []string{"Africa/Bissau"}},
+	{"Greenwich Standard Time", "IS", []string{"Atlantic/Reykjavik"}},
+	{"Greenwich Standard Time", "LR", []string{"Africa/Monrovia"}},
+	{"Greenwich Standard Time", "ML", []string{"Africa/Bamako"}},
+	{"Greenwich Standard Time", "MR", []string{"Africa/Nouakchott"}},
+	{"Greenwich Standard Time", "SH", []string{"Atlantic/St_Helena"}},
+	{"Greenwich Standard Time", "SL", []string{"Africa/Freetown"}},
+	{"Greenwich Standard Time", "SN", []string{"Africa/Dakar"}},
+	{"Greenwich Standard Time", "TG", []string{"Africa/Lome"}},
+	{"Sao Tome Standard Time", "001", []string{"Africa/Sao_Tome"}},
+	{"Sao Tome Standard Time", "ST", []string{"Africa/Sao_Tome"}},
+	{"Morocco Standard Time", "001", []string{"Africa/Casablanca"}},
+	{"Morocco Standard Time", "EH", []string{"Africa/El_Aaiun"}},
+	{"Morocco Standard Time", "MA", []string{"Africa/Casablanca"}},
+	{"W. Europe Standard Time", "001", []string{"Europe/Berlin"}},
+	{"W. Europe Standard Time", "AD", []string{"Europe/Andorra"}},
+	{"W. Europe Standard Time", "AT", []string{"Europe/Vienna"}},
+	{"W. Europe Standard Time", "CH", []string{"Europe/Zurich"}},
+	{"W. Europe Standard Time", "DE", []string{"Europe/Berlin", "Europe/Busingen"}},
+	{"W. Europe Standard Time", "GI", []string{"Europe/Gibraltar"}},
+	{"W. Europe Standard Time", "IT", []string{"Europe/Rome"}},
+	{"W. Europe Standard Time", "LI", []string{"Europe/Vaduz"}},
+	{"W. Europe Standard Time", "LU", []string{"Europe/Luxembourg"}},
+	{"W. Europe Standard Time", "MC", []string{"Europe/Monaco"}},
+	{"W. Europe Standard Time", "MT", []string{"Europe/Malta"}},
+	{"W. Europe Standard Time", "NL", []string{"Europe/Amsterdam"}},
+	{"W. Europe Standard Time", "NO", []string{"Europe/Oslo"}},
+	{"W. Europe Standard Time", "SE", []string{"Europe/Stockholm"}},
+	{"W. Europe Standard Time", "SJ", []string{"Arctic/Longyearbyen"}},
+	{"W. Europe Standard Time", "SM", []string{"Europe/San_Marino"}},
+	{"W. Europe Standard Time", "VA", []string{"Europe/Vatican"}},
+	{"Central Europe Standard Time", "001", []string{"Europe/Budapest"}},
+	{"Central Europe Standard Time", "AL", []string{"Europe/Tirane"}},
+	{"Central Europe Standard Time", "CZ", []string{"Europe/Prague"}},
+	{"Central Europe Standard Time", "HU", []string{"Europe/Budapest"}},
+	{"Central Europe Standard Time", "ME", []string{"Europe/Podgorica"}},
+	{"Central Europe Standard Time", "RS", []string{"Europe/Belgrade"}},
+	{"Central Europe Standard Time", "SI", []string{"Europe/Ljubljana"}},
+	{"Central Europe Standard Time", "SK", []string{"Europe/Bratislava"}},
+	{"Romance Standard Time", "001", []string{"Europe/Paris"}},
+	{"Romance Standard Time", "BE", []string{"Europe/Brussels"}},
+	{"Romance Standard Time", "DK", []string{"Europe/Copenhagen"}},
+	{"Romance Standard Time", "ES", []string{"Europe/Madrid", "Africa/Ceuta"}},
+	{"Romance Standard Time", "FR", []string{"Europe/Paris"}},
+	{"Central European Standard Time", "001", []string{"Europe/Warsaw"}},
+	{"Central European Standard Time", "BA", []string{"Europe/Sarajevo"}},
+	{"Central European Standard Time", "HR", []string{"Europe/Zagreb"}},
+	{"Central European Standard Time", "MK", []string{"Europe/Skopje"}},
+	{"Central European Standard Time", "PL", []string{"Europe/Warsaw"}},
+	{"W. Central Africa Standard Time", "001", []string{"Africa/Lagos"}},
+	{"W. Central Africa Standard Time", "AO", []string{"Africa/Luanda"}},
+	{"W. Central Africa Standard Time", "BJ", []string{"Africa/Porto-Novo"}},
+	{"W. Central Africa Standard Time", "CD", []string{"Africa/Kinshasa"}},
+	{"W. Central Africa Standard Time", "CF", []string{"Africa/Bangui"}},
+	{"W. Central Africa Standard Time", "CG", []string{"Africa/Brazzaville"}},
+	{"W. Central Africa Standard Time", "CM", []string{"Africa/Douala"}},
+	{"W. Central Africa Standard Time", "DZ", []string{"Africa/Algiers"}},
+	{"W. Central Africa Standard Time", "GA", []string{"Africa/Libreville"}},
+	{"W. Central Africa Standard Time", "GQ", []string{"Africa/Malabo"}},
+	{"W. Central Africa Standard Time", "NE", []string{"Africa/Niamey"}},
+	{"W. Central Africa Standard Time", "NG", []string{"Africa/Lagos"}},
+	{"W. Central Africa Standard Time", "TD", []string{"Africa/Ndjamena"}},
+	{"W. Central Africa Standard Time", "TN", []string{"Africa/Tunis"}},
+	{"W. Central Africa Standard Time", "ZZ", []string{"Etc/GMT-1"}},
+	{"Jordan Standard Time", "001", []string{"Asia/Amman"}},
+	{"Jordan Standard Time", "JO", []string{"Asia/Amman"}},
+	{"GTB Standard Time", "001", []string{"Europe/Bucharest"}},
+	{"GTB Standard Time", "CY", []string{"Asia/Nicosia", "Asia/Famagusta"}},
+	{"GTB Standard Time", "GR", []string{"Europe/Athens"}},
+	{"GTB Standard Time", "RO", []string{"Europe/Bucharest"}},
+	{"Middle East Standard Time", "001", []string{"Asia/Beirut"}},
+	{"Middle East Standard Time", "LB", []string{"Asia/Beirut"}},
+	{"Egypt Standard Time", "001", []string{"Africa/Cairo"}},
+	{"Egypt Standard Time", "EG", []string{"Africa/Cairo"}},
+	{"E. Europe Standard Time", "001", []string{"Europe/Chisinau"}},
+	{"E. Europe Standard Time", "MD", []string{"Europe/Chisinau"}},
+	{"Syria Standard Time", "001", []string{"Asia/Damascus"}},
+	{"Syria Standard Time", "SY", []string{"Asia/Damascus"}},
+	{"West Bank Standard Time", "001", []string{"Asia/Hebron"}},
+	{"West Bank Standard Time", "PS", []string{"Asia/Hebron", "Asia/Gaza"}},
+	{"South Africa Standard Time", "001", []string{"Africa/Johannesburg"}},
+	{"South Africa Standard Time", "BI", []string{"Africa/Bujumbura"}},
+	{"South Africa Standard Time", "BW", []string{"Africa/Gaborone"}},
+	{"South Africa Standard Time", "CD", []string{"Africa/Lubumbashi"}},
+	{"South Africa Standard Time", "LS", []string{"Africa/Maseru"}},
+	{"South Africa Standard Time", "MW", []string{"Africa/Blantyre"}},
+	{"South Africa Standard Time", "MZ", []string{"Africa/Maputo"}},
+	{"South Africa Standard Time", "RW", []string{"Africa/Kigali"}},
+	{"South Africa Standard Time", "SZ", []string{"Africa/Mbabane"}},
+	{"South Africa Standard Time", "ZA", []string{"Africa/Johannesburg"}},
+	{"South Africa Standard Time", "ZM", []string{"Africa/Lusaka"}},
+	{"South Africa Standard Time", "ZW", []string{"Africa/Harare"}},
+	{"South Africa Standard Time", "ZZ", []string{"Etc/GMT-2"}},
+	{"FLE Standard Time", "001", []string{"Europe/Kiev"}},
+	{"FLE Standard Time", "AX", []string{"Europe/Mariehamn"}},
+	{"FLE Standard Time", "BG", []string{"Europe/Sofia"}},
+	{"FLE Standard Time", "EE", []string{"Europe/Tallinn"}},
+	{"FLE Standard Time", "FI", []string{"Europe/Helsinki"}},
+	{"FLE Standard Time", "LT", []string{"Europe/Vilnius"}},
+	{"FLE Standard Time", "LV", []string{"Europe/Riga"}},
+	{"FLE Standard Time", "UA", []string{"Europe/Kiev", "Europe/Uzhgorod", "Europe/Zaporozhye"}},
+	{"Israel Standard Time", "001", []string{"Asia/Jerusalem"}},
+	{"Israel Standard Time", "IL", []string{"Asia/Jerusalem"}},
+	{"South Sudan Standard Time", "001", []string{"Africa/Juba"}},
+	{"South Sudan Standard Time", "SS", []string{"Africa/Juba"}},
+	{"Kaliningrad Standard Time", "001", []string{"Europe/Kaliningrad"}},
+	{"Kaliningrad Standard Time", "RU", []string{"Europe/Kaliningrad"}},
+	{"Sudan Standard Time", "001", []string{"Africa/Khartoum"}},
+	{"Sudan Standard Time", "SD", []string{"Africa/Khartoum"}},
+	{"Libya Standard Time", "001", []string{"Africa/Tripoli"}},
+	{"Libya Standard Time", "LY", []string{"Africa/Tripoli"}},
+	{"Namibia Standard Time", "001", []string{"Africa/Windhoek"}},
+	{"Namibia Standard Time", "NA", []string{"Africa/Windhoek"}},
+	{"Arabic Standard Time", "001", []string{"Asia/Baghdad"}},
+	{"Arabic Standard Time", "IQ", []string{"Asia/Baghdad"}},
+	{"Turkey Standard Time", "001", []string{"Europe/Istanbul"}},
+	{"Turkey Standard Time", "TR", []string{"Europe/Istanbul"}},
+	{"Arab Standard Time", "001", []string{"Asia/Riyadh"}},
+	{"Arab Standard Time", "BH", []string{"Asia/Bahrain"}},
+	{"Arab Standard Time", "KW", []string{"Asia/Kuwait"}},
+	{"Arab Standard Time", "QA", []string{"Asia/Qatar"}},
+	{"Arab Standard Time", "SA", []string{"Asia/Riyadh"}},
+	{"Arab Standard Time", "YE", []string{"Asia/Aden"}},
+	{"Belarus Standard Time", "001", []string{"Europe/Minsk"}},
+	{"Belarus Standard Time", "BY", []string{"Europe/Minsk"}},
+	{"Russian Standard Time", "001", []string{"Europe/Moscow"}},
+	{"Russian Standard Time", "RU", []string{"Europe/Moscow", "Europe/Kirov"}},
+	{"Russian Standard Time", "UA", []string{"Europe/Simferopol"}},
+	{"E. Africa Standard Time", "001", []string{"Africa/Nairobi"}},
+	{"E. Africa Standard Time", "AQ", []string{"Antarctica/Syowa"}},
+	{"E. Africa Standard Time", "DJ", []string{"Africa/Djibouti"}},
+	{"E. Africa Standard Time", "ER", []string{"Africa/Asmera"}},
+	{"E. Africa Standard Time", "ET", []string{"Africa/Addis_Ababa"}},
+	{"E. Africa Standard Time", "KE", []string{"Africa/Nairobi"}},
+	{"E. Africa Standard Time", "KM", []string{"Indian/Comoro"}},
+	{"E. Africa Standard Time", "MG", []string{"Indian/Antananarivo"}},
+	{"E. Africa Standard Time", "SO", []string{"Africa/Mogadishu"}},
+	{"E. Africa Standard Time", "TZ", []string{"Africa/Dar_es_Salaam"}},
+	{"E. Africa Standard Time", "UG", []string{"Africa/Kampala"}},
+	{"E. Africa Standard Time", "YT", []string{"Indian/Mayotte"}},
+	{"E. Africa Standard Time", "ZZ", []string{"Etc/GMT-3"}},
+	{"Volgograd Standard Time", "001", []string{"Europe/Volgograd"}},
+	{"Volgograd Standard Time", "RU", []string{"Europe/Volgograd"}},
+	{"Iran Standard Time", "001", []string{"Asia/Tehran"}},
+	{"Iran Standard Time", "IR", []string{"Asia/Tehran"}},
+	{"Arabian Standard Time", "001", []string{"Asia/Dubai"}},
+	{"Arabian Standard Time", "AE", []string{"Asia/Dubai"}},
+	{"Arabian Standard Time", "OM", []string{"Asia/Muscat"}},
+	{"Arabian Standard Time", "ZZ", []string{"Etc/GMT-4"}},
+	{"Astrakhan Standard Time", "001", []string{"Europe/Astrakhan"}},
+	{"Astrakhan Standard Time", "RU", []string{"Europe/Astrakhan", "Europe/Ulyanovsk"}},
+	{"Azerbaijan Standard Time", "001", []string{"Asia/Baku"}},
+	{"Azerbaijan Standard Time", "AZ", []string{"Asia/Baku"}},
+	{"Russia Time Zone 3", "001", []string{"Europe/Samara"}},
+	{"Russia Time Zone 3", "RU", []string{"Europe/Samara"}},
+	{"Mauritius Standard Time", "001", []string{"Indian/Mauritius"}},
+	{"Mauritius Standard Time", "MU", []string{"Indian/Mauritius"}},
+	{"Mauritius Standard Time", "RE", []string{"Indian/Reunion"}},
+	{"Mauritius Standard Time", "SC", []string{"Indian/Mahe"}},
+	{"Saratov Standard Time", "001", []string{"Europe/Saratov"}},
+	{"Saratov Standard Time", "RU", []string{"Europe/Saratov"}},
+	{"Georgian Standard Time", "001", []string{"Asia/Tbilisi"}},
+	{"Georgian Standard Time", "GE", []string{"Asia/Tbilisi"}},
+	{"Caucasus Standard Time", "001", []string{"Asia/Yerevan"}},
+	{"Caucasus Standard Time", "AM", []string{"Asia/Yerevan"}},
+	{"Afghanistan Standard Time", "001", []string{"Asia/Kabul"}},
+	{"Afghanistan Standard Time", "AF", []string{"Asia/Kabul"}},
+	{"West Asia Standard Time", "001", []string{"Asia/Tashkent"}},
+	{"West Asia Standard Time", "AQ", []string{"Antarctica/Mawson", "Antarctica/Vostok"}},
+	{"West Asia Standard Time", "KZ", []string{"Asia/Oral", "Asia/Almaty", "Asia/Aqtau", "Asia/Aqtobe", "Asia/Atyrau", "Asia/Qostanay"}},
+	{"West Asia Standard Time", "MV", []string{"Indian/Maldives"}},
+	{"West Asia Standard Time", "TF", []string{"Indian/Kerguelen"}},
+	{"West Asia Standard Time", "TJ", []string{"Asia/Dushanbe"}},
+	{"West Asia Standard Time", "TM", []string{"Asia/Ashgabat"}},
+	{"West Asia Standard Time", "UZ", []string{"Asia/Tashkent", "Asia/Samarkand"}},
+	{"West Asia Standard Time", "ZZ", []string{"Etc/GMT-5"}},
+	{"Pakistan Standard Time", "001", []string{"Asia/Karachi"}},
+	{"Pakistan Standard Time", "PK", []string{"Asia/Karachi"}},
+	{"Ekaterinburg Standard Time", "001", []string{"Asia/Yekaterinburg"}},
+	{"Ekaterinburg Standard Time", "RU", []string{"Asia/Yekaterinburg"}},
+	{"Qyzylorda Standard Time", "001", []string{"Asia/Qyzylorda"}},
+	{"Qyzylorda Standard Time", "KZ", []string{"Asia/Qyzylorda"}},
+	{"India Standard Time", "001", []string{"Asia/Calcutta"}},
+	{"India Standard Time", "IN", []string{"Asia/Calcutta"}},
+	{"Sri Lanka Standard Time", "001", []string{"Asia/Colombo"}},
+	{"Sri Lanka Standard Time", "LK", []string{"Asia/Colombo"}},
+	{"Nepal Standard Time", "001", []string{"Asia/Katmandu"}},
+	{"Nepal Standard Time", "NP", []string{"Asia/Katmandu"}},
+	{"Central Asia Standard Time", "001", []string{"Asia/Bishkek"}},
+	{"Central Asia Standard Time", "CN", []string{"Asia/Urumqi"}},
+	{"Central Asia Standard Time", "IO", []string{"Indian/Chagos"}},
+	{"Central Asia Standard Time", "KG", []string{"Asia/Bishkek"}},
+	{"Central Asia Standard Time", "ZZ", []string{"Etc/GMT-6"}},
+	{"Bangladesh Standard Time", "001", []string{"Asia/Dhaka"}},
+	{"Bangladesh Standard Time", "BD", []string{"Asia/Dhaka"}},
+	{"Bangladesh Standard Time", "BT", []string{"Asia/Thimphu"}},
+	{"Omsk Standard Time", "001", []string{"Asia/Omsk"}},
+	{"Omsk Standard Time", "RU", []string{"Asia/Omsk"}},
+	{"Myanmar Standard Time", "001", []string{"Asia/Rangoon"}},
+	{"Myanmar Standard Time", "CC", []string{"Indian/Cocos"}},
+	{"Myanmar Standard Time", "MM", []string{"Asia/Rangoon"}},
+	{"SE Asia Standard Time", "001", []string{"Asia/Bangkok"}},
+	{"SE Asia Standard Time", "AQ", []string{"Antarctica/Davis"}},
+	{"SE Asia Standard Time", "CX", []string{"Indian/Christmas"}},
+	{"SE Asia Standard Time", "ID", []string{"Asia/Jakarta", "Asia/Pontianak"}},
+	{"SE Asia Standard Time", "KH", []string{"Asia/Phnom_Penh"}},
+	{"SE Asia Standard Time", "LA", []string{"Asia/Vientiane"}},
+	{"SE Asia Standard Time", "TH", []string{"Asia/Bangkok"}},
+	{"SE Asia Standard Time", "VN", []string{"Asia/Saigon"}},
+	{"SE Asia Standard Time", "ZZ", []string{"Etc/GMT-7"}},
+	{"Altai Standard Time", "001", []string{"Asia/Barnaul"}},
+	{"Altai Standard Time", "RU", []string{"Asia/Barnaul"}},
+	{"W. Mongolia Standard Time", "001", []string{"Asia/Hovd"}},
+	{"W. Mongolia Standard Time", "MN", []string{"Asia/Hovd"}},
+	{"North Asia Standard Time", "001", []string{"Asia/Krasnoyarsk"}},
+	{"North Asia Standard Time", "RU", []string{"Asia/Krasnoyarsk", "Asia/Novokuznetsk"}},
+	{"N. Central Asia Standard Time", "001", []string{"Asia/Novosibirsk"}},
+	{"N. Central Asia Standard Time", "RU", []string{"Asia/Novosibirsk"}},
+	{"Tomsk Standard Time", "001", []string{"Asia/Tomsk"}},
+	{"Tomsk Standard Time", "RU", []string{"Asia/Tomsk"}},
+	{"China Standard Time", "001", []string{"Asia/Shanghai"}},
+	{"China Standard Time", "CN", []string{"Asia/Shanghai"}},
+	{"China Standard Time", "HK", []string{"Asia/Hong_Kong"}},
+	{"China Standard Time", "MO", []string{"Asia/Macau"}},
+	{"North Asia East Standard Time", "001", []string{"Asia/Irkutsk"}},
+	{"North Asia East Standard Time", "RU", []string{"Asia/Irkutsk"}},
+	{"Singapore Standard Time", "001", []string{"Asia/Singapore"}},
+	{"Singapore Standard Time", "BN", []string{"Asia/Brunei"}},
+	{"Singapore Standard Time", "ID", []string{"Asia/Makassar"}},
+	{"Singapore Standard Time", "MY", []string{"Asia/Kuala_Lumpur", "Asia/Kuching"}},
+	{"Singapore Standard Time", "PH", []string{"Asia/Manila"}},
+	{"Singapore Standard Time", "SG", []string{"Asia/Singapore"}},
+	{"Singapore Standard Time", "ZZ", []string{"Etc/GMT-8"}},
+	{"W. Australia Standard Time", "001", []string{"Australia/Perth"}},
+	{"W. Australia Standard Time", "AU", []string{"Australia/Perth"}},
+	{"Taipei Standard Time", "001", []string{"Asia/Taipei"}},
+	{"Taipei Standard Time", "TW", []string{"Asia/Taipei"}},
+	{"Ulaanbaatar Standard Time", "001", []string{"Asia/Ulaanbaatar"}},
+	{"Ulaanbaatar Standard Time", "MN", []string{"Asia/Ulaanbaatar", "Asia/Choibalsan"}},
+	{"Aus Central W. Standard Time", "001", []string{"Australia/Eucla"}},
+	{"Aus Central W. Standard Time", "AU", []string{"Australia/Eucla"}},
+	{"Transbaikal Standard Time", "001", []string{"Asia/Chita"}},
+	{"Transbaikal Standard Time", "RU", []string{"Asia/Chita"}},
+	{"Tokyo Standard Time", "001", []string{"Asia/Tokyo"}},
+	{"Tokyo Standard Time", "ID", []string{"Asia/Jayapura"}},
+	{"Tokyo Standard Time", "JP", []string{"Asia/Tokyo"}},
+	{"Tokyo Standard Time", "PW", []string{"Pacific/Palau"}},
+	{"Tokyo Standard Time", "TL", []string{"Asia/Dili"}},
+	{"Tokyo Standard Time", "ZZ", []string{"Etc/GMT-9"}},
+	{"North Korea Standard Time", "001", []string{"Asia/Pyongyang"}},
+	{"North Korea Standard Time", "KP", []string{"Asia/Pyongyang"}},
+	{"Korea Standard Time", "001", []string{"Asia/Seoul"}},
+	{"Korea Standard Time", "KR", []string{"Asia/Seoul"}},
+	{"Yakutsk Standard Time", "001", []string{"Asia/Yakutsk"}},
+	{"Yakutsk Standard Time", "RU", []string{"Asia/Yakutsk", "Asia/Khandyga"}},
+	{"Cen. Australia Standard Time", "001", []string{"Australia/Adelaide"}},
+	{"Cen. Australia Standard Time", "AU", []string{"Australia/Adelaide", "Australia/Broken_Hill"}},
+	{"AUS Central Standard Time", "001", []string{"Australia/Darwin"}},
+	{"AUS Central Standard Time", "AU", []string{"Australia/Darwin"}},
+	{"E. Australia Standard Time", "001", []string{"Australia/Brisbane"}},
+	{"E. Australia Standard Time", "AU", []string{"Australia/Brisbane", "Australia/Lindeman"}},
+	{"AUS Eastern Standard Time", "001", []string{"Australia/Sydney"}},
+	{"AUS Eastern Standard Time", "AU", []string{"Australia/Sydney", "Australia/Melbourne"}},
+	{"West Pacific Standard Time", "001", []string{"Pacific/Port_Moresby"}},
+	{"West Pacific Standard Time", "AQ", []string{"Antarctica/DumontDUrville"}},
+	{"West Pacific Standard Time", "FM", []string{"Pacific/Truk"}},
+	{"West Pacific Standard Time", "GU", []string{"Pacific/Guam"}},
+	{"West Pacific Standard Time", "MP", []string{"Pacific/Saipan"}},
+	{"West Pacific Standard Time", "PG", []string{"Pacific/Port_Moresby"}},
+	{"West Pacific Standard Time", "ZZ", []string{"Etc/GMT-10"}},
+	{"Tasmania Standard Time", "001", []string{"Australia/Hobart"}},
+	{"Tasmania Standard Time", "AU", []string{"Australia/Hobart", "Antarctica/Macquarie"}},
+	{"Vladivostok Standard Time", "001", []string{"Asia/Vladivostok"}},
+	{"Vladivostok Standard Time", "RU", []string{"Asia/Vladivostok", "Asia/Ust-Nera"}},
+	{"Lord Howe Standard Time", "001", []string{"Australia/Lord_Howe"}},
+	{"Lord Howe Standard Time", "AU", []string{"Australia/Lord_Howe"}},
+	{"Bougainville Standard Time", "001", []string{"Pacific/Bougainville"}},
+	{"Bougainville Standard Time", "PG", []string{"Pacific/Bougainville"}},
+	{"Russia Time Zone 10", "001", []string{"Asia/Srednekolymsk"}},
+	{"Russia Time Zone 10", "RU", []string{"Asia/Srednekolymsk"}},
+	{"Magadan Standard Time", "001", []string{"Asia/Magadan"}},
+	{"Magadan Standard Time", "RU", []string{"Asia/Magadan"}},
+	{"Norfolk Standard Time", "001", []string{"Pacific/Norfolk"}},
+	{"Norfolk Standard Time", "NF", []string{"Pacific/Norfolk"}},
+	{"Sakhalin Standard Time", "001", []string{"Asia/Sakhalin"}},
+	{"Sakhalin Standard Time", "RU", []string{"Asia/Sakhalin"}},
+	{"Central Pacific Standard Time", "001", []string{"Pacific/Guadalcanal"}},
+	{"Central Pacific Standard Time", "AQ", []string{"Antarctica/Casey"}},
+	{"Central Pacific Standard Time", "FM", []string{"Pacific/Ponape", "Pacific/Kosrae"}},
+	{"Central Pacific Standard Time", "NC", []string{"Pacific/Noumea"}},
+	{"Central Pacific Standard Time", "SB", []string{"Pacific/Guadalcanal"}},
+	{"Central Pacific Standard Time", "VU", []string{"Pacific/Efate"}},
+	{"Central Pacific Standard Time", "ZZ", []string{"Etc/GMT-11"}},
+	{"Russia Time Zone 11", "001", []string{"Asia/Kamchatka"}},
+	{"Russia Time Zone 11", "RU", []string{"Asia/Kamchatka", "Asia/Anadyr"}},
+	{"New Zealand Standard Time", "001", []string{"Pacific/Auckland"}},
+	{"New Zealand Standard Time", "AQ", []string{"Antarctica/McMurdo"}},
+	{"New Zealand Standard Time", "NZ", []string{"Pacific/Auckland"}},
+	{"UTC+12", "001", []string{"Etc/GMT-12"}},
+	{"UTC+12", "KI", []string{"Pacific/Tarawa"}},
+	{"UTC+12", "MH", []string{"Pacific/Majuro", "Pacific/Kwajalein"}},
+	{"UTC+12", "NR", []string{"Pacific/Nauru"}},
+	{"UTC+12", "TV", []string{"Pacific/Funafuti"}},
+	{"UTC+12", "UM", []string{"Pacific/Wake"}},
+	{"UTC+12", "WF", []string{"Pacific/Wallis"}},
+	{"UTC+12", "ZZ", []string{"Etc/GMT-12"}},
+	{"Fiji Standard Time", "001", []string{"Pacific/Fiji"}},
+	{"Fiji Standard Time", "FJ", []string{"Pacific/Fiji"}},
+	{"Chatham Islands Standard Time", "001", []string{"Pacific/Chatham"}},
+	{"Chatham Islands Standard Time", "NZ", []string{"Pacific/Chatham"}},
+	{"UTC+13", "001", []string{"Etc/GMT-13"}},
+	{"UTC+13", "KI", []string{"Pacific/Enderbury"}},
+	{"UTC+13", "TK", []string{"Pacific/Fakaofo"}},
+	{"UTC+13", "ZZ", []string{"Etc/GMT-13"}},
+	{"Tonga Standard Time", "001", []string{"Pacific/Tongatapu"}},
+	{"Tonga Standard Time", "TO", []string{"Pacific/Tongatapu"}},
+	{"Samoa Standard Time", "001", []string{"Pacific/Apia"}},
+	{"Samoa Standard Time", "WS", []string{"Pacific/Apia"}},
+	{"Line Islands Standard Time", "001", []string{"Pacific/Kiritimati"}},
+	{"Line Islands Standard Time", "KI", []string{"Pacific/Kiritimati"}},
+	{"Line Islands Standard Time", "ZZ", []string{"Etc/GMT-14"}},
 }
 
 // GetIANATimezone converts a Windows timezone name to IANA timezone.
@@ -162,4 +673,4 @@ func GetIANATimezone(windowsTimezone string) (string, bool) {
 }
 
 // Generated with 139 Windows timezone mappings
-// Total CLDR mappings processed: 139
+// Total CLDR mappings processed: 503
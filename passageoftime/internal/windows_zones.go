@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+)
+
+// WindowsZoneMapping is one CLDR windowsZones.xml mapZone entry
+type WindowsZoneMapping struct {
+	// Windows is the Windows time zone ID, e.g. "W. Europe Standard Time"
+	Windows string
+
+	// Territory is an ISO 3166 code, "001" for the Windows zone's default
+	// IANA zone or "ZZ" for fixed-offset Etc zones
+	Territory string
+
+	// IANA lists the territory's zones, the preferred one first
+	IANA []string
+}
+
+// WindowsZoneIDs returns every Windows time zone ID CLDR maps, sorted
+func WindowsZoneIDs() []string {
+	ids := make([]string, 0, len(WindowsToIANA))
+	for id := range WindowsToIANA {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// LookupWindowsZoneID returns the CLDR spelling of a Windows time zone ID,
+// ignoring case and surrounding spaces
+func LookupWindowsZoneID(id string) (string, bool) {
+	id = strings.TrimSpace(id)
+	if _, ok := WindowsToIANA[id]; ok {
+		return id, true
+	}
+	for known := range WindowsToIANA {
+		if strings.EqualFold(known, id) {
+			return known, true
+		}
+	}
+	return "", false
+}
+
+// WindowsToIANAForTerritory returns the IANA zones CLDR maps a Windows zone to
+// in a territory, the preferred one first. When the territory has no entry
+// of its own, or territory is empty, it returns the default "001" zone and
+// "001" as the territory used.
+func WindowsToIANAForTerritory(windows, territory string) ([]string, string, bool) {
+	territory = strings.ToUpper(territory)
+	var fallback []string
+	for _, m := range WindowsZoneMappings {
+		if m.Windows != windows {
+			continue
+		}
+		if m.Territory == territory {
+			return append([]string{}, m.IANA...), m.Territory, true
+		}
+		if m.Territory == "001" {
+			fallback = m.IANA
+		}
+	}
+	if fallback == nil {
+		return nil, "", false
+	}
+	return append([]string{}, fallback...), "001", true
+}
+
+// IANAToWindows returns the Windows zone and territory CLDR lists an IANA
+// zone under. An exact match of the ID wins; otherwise the zone is compared
+// through its links, since CLDR keeps older names such as Asia/Calcutta.
+// A non-empty territory restricts the search to that territory's entries.
+func IANAToWindows(iana, territory string) (WindowsZoneMapping, bool) {
+	territory = strings.ToUpper(territory)
+	find := func(match func(zone string) bool) (WindowsZoneMapping, bool) {
+		for _, m := range WindowsZoneMappings {
+			if m.Territory == "001" || (territory != "" && m.Territory != territory) {
+				continue
+			}
+			for _, zone := range m.IANA {
+				if match(zone) {
+					return m, true
+				}
+			}
+		}
+		return WindowsZoneMapping{}, false
+	}
+
+	if m, ok := find(func(zone string) bool { return zone == iana }); ok {
+		return m, true
+	}
+	canonical := CanonicalTimezoneID(iana)
+	return find(func(zone string) bool { return CanonicalTimezoneID(zone) == canonical })
+}
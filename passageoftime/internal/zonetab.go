@@ -0,0 +1,683 @@
+// Code generated by go:generate; DO NOT EDIT.
+// This file contains zone locations and country names from the tz database
+// Source: /usr/share/zoneinfo/{zone.tab,zone1970.tab,iso3166.tab}
+// Generated on: 2026-10-18T12:25:42Z
+// Total zones: 418, countries: 249
+
+package internal
+
+// countryNames maps ISO 3166 alpha-2 codes to the names used by the tz database
+var countryNames = map[string]string{
+	"AD": "Andorra",
+	"AE": "United Arab Emirates",
+	"AF": "Afghanistan",
+	"AG": "Antigua & Barbuda",
+	"AI": "Anguilla",
+	"AL": "Albania",
+	"AM": "Armenia",
+	"AO": "Angola",
+	"AQ": "Antarctica",
+	"AR": "Argentina",
+	"AS": "Samoa (American)",
+	"AT": "Austria",
+	"AU": "Australia",
+	"AW": "Aruba",
+	"AX": "Åland Islands",
+	"AZ": "Azerbaijan",
+	"BA": "Bosnia & Herzegovina",
+	"BB": "Barbados",
+	"BD": "Bangladesh",
+	"BE": "Belgium",
+	"BF": "Burkina Faso",
+	"BG": "Bulgaria",
+	"BH": "Bahrain",
+	"BI": "Burundi",
+	"BJ": "Benin",
+	"BL": "St Barthelemy",
+	"BM": "Bermuda",
+	"BN": "Brunei",
+	"BO": "Bolivia",
+	"BQ": "Caribbean NL",
+	"BR": "Brazil",
+	"BS": "Bahamas",
+	"BT": "Bhutan",
+	"BV": "Bouvet Island",
+	"BW": "Botswana",
+	"BY": "Belarus",
+	"BZ": "Belize",
+	"CA": "Canada",
+	"CC": "Cocos (Keeling) Islands",
+	"CD": "Congo (Dem. Rep.)",
+	"CF": "Central African Rep.",
+	"CG": "Congo (Rep.)",
+	"CH": "Switzerland",
+	"CI": "Côte d'Ivoire",
+	"CK": "Cook Islands",
+	"CL": "Chile",
+	"CM": "Cameroon",
+	"CN": "China",
+	"CO": "Colombia",
+	"CR": "Costa Rica",
+	"CU": "Cuba",
+	"CV": "Cape Verde",
+	"CW": "Curaçao",
+	"CX": "Christmas Island",
+	"CY": "Cyprus",
+	"CZ": "Czech Republic",
+	"DE": "Germany",
+	"DJ": "Djibouti",
+	"DK": "Denmark",
+	"DM": "Dominica",
+	"DO": "Dominican Republic",
+	"DZ": "Algeria",
+	"EC": "Ecuador",
+	"EE": "Estonia",
+	"EG": "Egypt",
+	"EH": "Western Sahara",
+	"ER": "Eritrea",
+	"ES": "Spain",
+	"ET": "Ethiopia",
+	"FI": "Finland",
+	"FJ": "Fiji",
+	"FK": "Falkland Islands",
+	"FM": "Micronesia",
+	"FO": "Faroe Islands",
+	"FR": "France",
+	"GA": "Gabon",
+	"GB": "Britain (UK)",
+	"GD": "Grenada",
+	"GE": "Georgia",
+	"GF": "French Guiana",
+	"GG": "Guernsey",
+	"GH": "Ghana",
+	"GI": "Gibraltar",
+	"GL": "Greenland",
+	"GM": "Gambia",
+	"GN": "Guinea",
+	"GP": "Guadeloupe",
+	"GQ": "Equatorial Guinea",
+	"GR": "Greece",
+	"GS": "South Georgia & the South Sandwich Islands",
+	"GT": "Guatemala",
+	"GU": "Guam",
+	"GW": "Guinea-Bissau",
+	"GY": "Guyana",
+	"HK": "Hong Kong",
+	"HM": "Heard Island & McDonald Islands",
+	"HN": "Honduras",
+	"HR": "Croatia",
+	"HT": "Haiti",
+	"HU": "Hungary",
+	"ID": "Indonesia",
+	"IE": "Ireland",
+	"IL": "Israel",
+	"IM": "Isle of Man",
+	"IN": "India",
+	"IO": "British Indian Ocean Territory",
+	"IQ": "Iraq",
+	"IR": "Iran",
+	"IS": "Iceland",
+	"IT": "Italy",
+	"JE": "Jersey",
+	"JM": "Jamaica",
+	"JO": "Jordan",
+	"JP": "Japan",
+	"KE": "Kenya",
+	"KG": "Kyrgyzstan",
+	"KH": "Cambodia",
+	"KI": "Kiribati",
+	"KM": "Comoros",
+	"KN": "St Kitts & Nevis",
+	"KP": "Korea (North)",
+	"KR": "Korea (South)",
+	"KW": "Kuwait",
+	"KY": "Cayman Islands",
+	"KZ": "Kazakhstan",
+	"LA": "Laos",
+	"LB": "Lebanon",
+	"LC": "St Lucia",
+	"LI": "Liechtenstein",
+	"LK": "Sri Lanka",
+	"LR": "Liberia",
+	"LS": "Lesotho",
+	"LT": "Lithuania",
+	"LU": "Luxembourg",
+	"LV": "Latvia",
+	"LY": "Libya",
+	"MA": "Morocco",
+	"MC": "Monaco",
+	"MD": "Moldova",
+	"ME": "Montenegro",
+	"MF": "St Martin (French)",
+	"MG": "Madagascar",
+	"MH": "Marshall Islands",
+	"MK": "North Macedonia",
+	"ML": "Mali",
+	"MM": "Myanmar (Burma)",
+	"MN": "Mongolia",
+	"MO": "Macau",
+	"MP": "Northern Mariana Islands",
+	"MQ": "Martinique",
+	"MR": "Mauritania",
+	"MS": "Montserrat",
+	"MT": "Malta",
+	"MU": "Mauritius",
+	"MV": "Maldives",
+	"MW": "Malawi",
+	"MX": "Mexico",
+	"MY": "Malaysia",
+	"MZ": "Mozambique",
+	"NA": "Namibia",
+	"NC": "New Caledonia",
+	"NE": "Niger",
+	"NF": "Norfolk Island",
+	"NG": "Nigeria",
+	"NI": "Nicaragua",
+	"NL": "Netherlands",
+	"NO": "Norway",
+	"NP": "Nepal",
+	"NR": "Nauru",
+	"NU": "Niue",
+	"NZ": "New Zealand",
+	"OM": "Oman",
+	"PA": "Panama",
+	"PE": "Peru",
+	"PF": "French Polynesia",
+	"PG": "Papua New Guinea",
+	"PH": "Philippines",
+	"PK": "Pakistan",
+	"PL": "Poland",
+	"PM": "St Pierre & Miquelon",
+	"PN": "Pitcairn",
+	"PR": "Puerto Rico",
+	"PS": "Palestine",
+	"PT": "Portugal",
+	"PW": "Palau",
+	"PY": "Paraguay",
+	"QA": "Qatar",
+	"RE": "Réunion",
+	"RO": "Romania",
+	"RS": "Serbia",
+	"RU": "Russia",
+	"RW": "Rwanda",
+	"SA": "Saudi Arabia",
+	"SB": "Solomon Islands",
+	"SC": "Seychelles",
+	"SD": "Sudan",
+	"SE": "Sweden",
+	"SG": "Singapore",
+	"SH": "St Helena",
+	"SI": "Slovenia",
+	"SJ": "Svalbard & Jan Mayen",
+	"SK": "Slovakia",
+	"SL": "Sierra Leone",
+	"SM": "San Marino",
+	"SN": "Senegal",
+	"SO": "Somalia",
+	"SR": "Suriname",
+	"SS": "South Sudan",
+	"ST": "Sao Tome & Principe",
+	"SV": "El Salvador",
+	"SX": "St Maarten (Dutch)",
+	"SY": "Syria",
+	"SZ": "Eswatini (Swaziland)",
+	"TC": "Turks & Caicos Is",
+	"TD": "Chad",
+	"TF": "French S. Terr.",
+	"TG": "Togo",
+	"TH": "Thailand",
+	"TJ": "Tajikistan",
+	"TK": "Tokelau",
+	"TL": "East Timor",
+	"TM": "Turkmenistan",
+	"TN": "Tunisia",
+	"TO": "Tonga",
+	"TR": "Turkey",
+	"TT": "Trinidad & Tobago",
+	"TV": "Tuvalu",
+	"TW": "Taiwan",
+	"TZ": "Tanzania",
+	"UA": "Ukraine",
+	"UG": "Uganda",
+	"UM": "US minor outlying islands",
+	"US": "United States",
+	"UY": "Uruguay",
+	"UZ": "Uzbekistan",
+	"VA": "Vatican City",
+	"VC": "St Vincent",
+	"VE": "Venezuela",
+	"VG": "Virgin Islands (UK)",
+	"VI": "Virgin Islands (US)",
+	"VN": "Vietnam",
+	"VU": "Vanuatu",
+	"WF": "Wallis & Futuna",
+	"WS": "Samoa (western)",
+	"YE": "Yemen",
+	"YT": "Mayotte",
+	"ZA": "South Africa",
+	"ZM": "Zambia",
+	"ZW": "Zimbabwe",
+}
+
+// zoneLocations holds each zone's countries (its zone.tab country first),
+// representative coordinates and tz comment
+var zoneLocations = map[string]ZoneLocation{
+	"Africa/Abidjan":                 {CountryCodes: []string{"CI", "BF", "GH", "GM", "GN", "IS", "ML", "MR", "SH", "SL", "SN", "TG"}, Latitude: 5.3167, Longitude: -4.0333},
+	"Africa/Accra":                   {CountryCodes: []string{"GH"}, Latitude: 5.55, Longitude: -0.2167},
+	"Africa/Addis_Ababa":             {CountryCodes: []string{"ET"}, Latitude: 9.0333, Longitude: 38.7},
+	"Africa/Algiers":                 {CountryCodes: []string{"DZ"}, Latitude: 36.7833, Longitude: 3.05},
+	"Africa/Asmara":                  {CountryCodes: []string{"ER"}, Latitude: 15.3333, Longitude: 38.8833},
+	"Africa/Bamako":                  {CountryCodes: []string{"ML"}, Latitude: 12.65, Longitude: -8},
+	"Africa/Bangui":                  {CountryCodes: []string{"CF"}, Latitude: 4.3667, Longitude: 18.5833},
+	"Africa/Banjul":                  {CountryCodes: []string{"GM"}, Latitude: 13.4667, Longitude: -16.65},
+	"Africa/Bissau":                  {CountryCodes: []string{"GW"}, Latitude: 11.85, Longitude: -15.5833},
+	"Africa/Blantyre":                {CountryCodes: []string{"MW"}, Latitude: -15.7833, Longitude: 35},
+	"Africa/Brazzaville":             {CountryCodes: []string{"CG"}, Latitude: -4.2667, Longitude: 15.2833},
+	"Africa/Bujumbura":               {CountryCodes: []string{"BI"}, Latitude: -3.3833, Longitude: 29.3667},
+	"Africa/Cairo":                   {CountryCodes: []string{"EG"}, Latitude: 30.05, Longitude: 31.25},
+	"Africa/Casablanca":              {CountryCodes: []string{"MA"}, Latitude: 33.65, Longitude: -7.5833},
+	"Africa/Ceuta":                   {CountryCodes: []string{"ES"}, Latitude: 35.8833, Longitude: -5.3167, Comment: "Ceuta, Melilla"},
+	"Africa/Conakry":                 {CountryCodes: []string{"GN"}, Latitude: 9.5167, Longitude: -13.7167},
+	"Africa/Dakar":                   {CountryCodes: []string{"SN"}, Latitude: 14.6667, Longitude: -17.4333},
+	"Africa/Dar_es_Salaam":           {CountryCodes: []string{"TZ"}, Latitude: -6.8, Longitude: 39.2833},
+	"Africa/Djibouti":                {CountryCodes: []string{"DJ"}, Latitude: 11.6, Longitude: 43.15},
+	"Africa/Douala":                  {CountryCodes: []string{"CM"}, Latitude: 4.05, Longitude: 9.7},
+	"Africa/El_Aaiun":                {CountryCodes: []string{"EH"}, Latitude: 27.15, Longitude: -13.2},
+	"Africa/Freetown":                {CountryCodes: []string{"SL"}, Latitude: 8.5, Longitude: -13.25},
+	"Africa/Gaborone":                {CountryCodes: []string{"BW"}, Latitude: -24.65, Longitude: 25.9167},
+	"Africa/Harare":                  {CountryCodes: []string{"ZW"}, Latitude: -17.8333, Longitude: 31.05},
+	"Africa/Johannesburg":            {CountryCodes: []string{"ZA", "LS", "SZ"}, Latitude: -26.25, Longitude: 28},
+	"Africa/Juba":                    {CountryCodes: []string{"SS"}, Latitude: 4.85, Longitude: 31.6167},
+	"Africa/Kampala":                 {CountryCodes: []string{"UG"}, Latitude: 0.3167, Longitude: 32.4167},
+	"Africa/Khartoum":                {CountryCodes: []string{"SD"}, Latitude: 15.6, Longitude: 32.5333},
+	"Africa/Kigali":                  {CountryCodes: []string{"RW"}, Latitude: -1.95, Longitude: 30.0667},
+	"Africa/Kinshasa":                {CountryCodes: []string{"CD"}, Latitude: -4.3, Longitude: 15.3, Comment: "Dem. Rep. of Congo (west)"},
+	"Africa/Lagos":                   {CountryCodes: []string{"NG", "AO", "BJ", "CD", "CF", "CG", "CM", "GA", "GQ", "NE"}, Latitude: 6.45, Longitude: 3.4, Comment: "West Africa Time"},
+	"Africa/Libreville":              {CountryCodes: []string{"GA"}, Latitude: 0.3833, Longitude: 9.45},
+	"Africa/Lome":                    {CountryCodes: []string{"TG"}, Latitude: 6.1333, Longitude: 1.2167},
+	"Africa/Luanda":                  {CountryCodes: []string{"AO"}, Latitude: -8.8, Longitude: 13.2333},
+	"Africa/Lubumbashi":              {CountryCodes: []string{"CD"}, Latitude: -11.6667, Longitude: 27.4667, Comment: "Dem. Rep. of Congo (east)"},
+	"Africa/Lusaka":                  {CountryCodes: []string{"ZM"}, Latitude: -15.4167, Longitude: 28.2833},
+	"Africa/Malabo":                  {CountryCodes: []string{"GQ"}, Latitude: 3.75, Longitude: 8.7833},
+	"Africa/Maputo":                  {CountryCodes: []string{"MZ", "BI", "BW", "CD", "MW", "RW", "ZM", "ZW"}, Latitude: -25.9667, Longitude: 32.5833, Comment: "Central Africa Time"},
+	"Africa/Maseru":                  {CountryCodes: []string{"LS"}, Latitude: -29.4667, Longitude: 27.5},
+	"Africa/Mbabane":                 {CountryCodes: []string{"SZ"}, Latitude: -26.3, Longitude: 31.1},
+	"Africa/Mogadishu":               {CountryCodes: []string{"SO"}, Latitude: 2.0667, Longitude: 45.3667},
+	"Africa/Monrovia":                {CountryCodes: []string{"LR"}, Latitude: 6.3, Longitude: -10.7833},
+	"Africa/Nairobi":                 {CountryCodes: []string{"KE", "DJ", "ER", "ET", "KM", "MG", "SO", "TZ", "UG", "YT"}, Latitude: -1.2833, Longitude: 36.8167},
+	"Africa/Ndjamena":                {CountryCodes: []string{"TD"}, Latitude: 12.1167, Longitude: 15.05},
+	"Africa/Niamey":                  {CountryCodes: []string{"NE"}, Latitude: 13.5167, Longitude: 2.1167},
+	"Africa/Nouakchott":              {CountryCodes: []string{"MR"}, Latitude: 18.1, Longitude: -15.95},
+	"Africa/Ouagadougou":             {CountryCodes: []string{"BF"}, Latitude: 12.3667, Longitude: -1.5167},
+	"Africa/Porto-Novo":              {CountryCodes: []string{"BJ"}, Latitude: 6.4833, Longitude: 2.6167},
+	"Africa/Sao_Tome":                {CountryCodes: []string{"ST"}, Latitude: 0.3333, Longitude: 6.7333},
+	"Africa/Tripoli":                 {CountryCodes: []string{"LY"}, Latitude: 32.9, Longitude: 13.1833},
+	"Africa/Tunis":                   {CountryCodes: []string{"TN"}, Latitude: 36.8, Longitude: 10.1833},
+	"Africa/Windhoek":                {CountryCodes: []string{"NA"}, Latitude: -22.5667, Longitude: 17.1},
+	"America/Adak":                   {CountryCodes: []string{"US"}, Latitude: 51.88, Longitude: -176.6581, Comment: "Alaska - western Aleutians"},
+	"America/Anchorage":              {CountryCodes: []string{"US"}, Latitude: 61.2181, Longitude: -149.9003, Comment: "Alaska (most areas)"},
+	"America/Anguilla":               {CountryCodes: []string{"AI"}, Latitude: 18.2, Longitude: -63.0667},
+	"America/Antigua":                {CountryCodes: []string{"AG"}, Latitude: 17.05, Longitude: -61.8},
+	"America/Araguaina":              {CountryCodes: []string{"BR"}, Latitude: -7.2, Longitude: -48.2, Comment: "Tocantins"},
+	"America/Argentina/Buenos_Aires": {CountryCodes: []string{"AR"}, Latitude: -34.6, Longitude: -58.45, Comment: "Buenos Aires (BA, CF)"},
+	"America/Argentina/Catamarca":    {CountryCodes: []string{"AR"}, Latitude: -28.4667, Longitude: -65.7833, Comment: "Catamarca (CT), Chubut (CH)"},
+	"America/Argentina/Cordoba":      {CountryCodes: []string{"AR"}, Latitude: -31.4, Longitude: -64.1833, Comment: "Argentina (most areas: CB, CC, CN, ER, FM, MN, SE, SF)"},
+	"America/Argentina/Jujuy":        {CountryCodes: []string{"AR"}, Latitude: -24.1833, Longitude: -65.3, Comment: "Jujuy (JY)"},
+	"America/Argentina/La_Rioja":     {CountryCodes: []string{"AR"}, Latitude: -29.4333, Longitude: -66.85, Comment: "La Rioja (LR)"},
+	"America/Argentina/Mendoza":      {CountryCodes: []string{"AR"}, Latitude: -32.8833, Longitude: -68.8167, Comment: "Mendoza (MZ)"},
+	"America/Argentina/Rio_Gallegos": {CountryCodes: []string{"AR"}, Latitude: -51.6333, Longitude: -69.2167, Comment: "Santa Cruz (SC)"},
+	"America/Argentina/Salta":        {CountryCodes: []string{"AR"}, Latitude: -24.7833, Longitude: -65.4167, Comment: "Salta (SA, LP, NQ, RN)"},
+	"America/Argentina/San_Juan":     {CountryCodes: []string{"AR"}, Latitude: -31.5333, Longitude: -68.5167, Comment: "San Juan (SJ)"},
+	"America/Argentina/San_Luis":     {CountryCodes: []string{"AR"}, Latitude: -33.3167, Longitude: -66.35, Comment: "San Luis (SL)"},
+	"America/Argentina/Tucuman":      {CountryCodes: []string{"AR"}, Latitude: -26.8167, Longitude: -65.2167, Comment: "Tucuman (TM)"},
+	"America/Argentina/Ushuaia":      {CountryCodes: []string{"AR"}, Latitude: -54.8, Longitude: -68.3, Comment: "Tierra del Fuego (TF)"},
+	"America/Aruba":                  {CountryCodes: []string{"AW"}, Latitude: 12.5, Longitude: -69.9667},
+	"America/Asuncion":               {CountryCodes: []string{"PY"}, Latitude: -25.2667, Longitude: -57.6667},
+	"America/Atikokan":               {CountryCodes: []string{"CA"}, Latitude: 48.7586, Longitude: -91.6217, Comment: "EST - ON (Atikokan), NU (Coral H)"},
+	"America/Bahia":                  {CountryCodes: []string{"BR"}, Latitude: -12.9833, Longitude: -38.5167, Comment: "Bahia"},
+	"America/Bahia_Banderas":         {CountryCodes: []string{"MX"}, Latitude: 20.8, Longitude: -105.25, Comment: "Bahia de Banderas"},
+	"America/Barbados":               {CountryCodes: []string{"BB"}, Latitude: 13.1, Longitude: -59.6167},
+	"America/Belem":                  {CountryCodes: []string{"BR"}, Latitude: -1.45, Longitude: -48.4833, Comment: "Para (east), Amapa"},
+	"America/Belize":                 {CountryCodes: []string{"BZ"}, Latitude: 17.5, Longitude: -88.2},
+	"America/Blanc-Sablon":           {CountryCodes: []string{"CA"}, Latitude: 51.4167, Longitude: -57.1167, Comment: "AST - QC (Lower North Shore)"},
+	"America/Boa_Vista":              {CountryCodes: []string{"BR"}, Latitude: 2.8167, Longitude: -60.6667, Comment: "Roraima"},
+	"America/Bogota":                 {CountryCodes: []string{"CO"}, Latitude: 4.6, Longitude: -74.0833},
+	"America/Boise":                  {CountryCodes: []string{"US"}, Latitude: 43.6136, Longitude: -116.2025, Comment: "Mountain - ID (south), OR (east)"},
+	"America/Cambridge_Bay":          {CountryCodes: []string{"CA"}, Latitude: 69.1139, Longitude: -105.0528, Comment: "Mountain - NU (west)"},
+	"America/Campo_Grande":           {CountryCodes: []string{"BR"}, Latitude: -20.45, Longitude: -54.6167, Comment: "Mato Grosso do Sul"},
+	"America/Cancun":                 {CountryCodes: []string{"MX"}, Latitude: 21.0833, Longitude: -86.7667, Comment: "Quintana Roo"},
+	"America/Caracas":                {CountryCodes: []string{"VE"}, Latitude: 10.5, Longitude: -66.9333},
+	"America/Cayenne":                {CountryCodes: []string{"GF"}, Latitude: 4.9333, Longitude: -52.3333},
+	"America/Cayman":                 {CountryCodes: []string{"KY"}, Latitude: 19.3, Longitude: -81.3833},
+	"America/Chicago":                {CountryCodes: []string{"US"}, Latitude: 41.85, Longitude: -87.65, Comment: "Central (most areas)"},
+	"America/Chihuahua":              {CountryCodes: []string{"MX"}, Latitude: 28.6333, Longitude: -106.0833, Comment: "Chihuahua (most areas)"},
+	"America/Ciudad_Juarez":          {CountryCodes: []string{"MX"}, Latitude: 31.7333, Longitude: -106.4833, Comment: "Chihuahua (US border - west)"},
+	"America/Costa_Rica":             {CountryCodes: []string{"CR"}, Latitude: 9.9333, Longitude: -84.0833},
+	"America/Coyhaique":              {CountryCodes: []string{"CL"}, Latitude: -45.5667, Longitude: -72.0667, Comment: "Aysen Region"},
+	"America/Creston":                {CountryCodes: []string{"CA"}, Latitude: 49.1, Longitude: -116.5167, Comment: "MST - BC (Creston)"},
+	"America/Cuiaba":                 {CountryCodes: []string{"BR"}, Latitude: -15.5833, Longitude: -56.0833, Comment: "Mato Grosso"},
+	"America/Curacao":                {CountryCodes: []string{"CW"}, Latitude: 12.1833, Longitude: -69},
+	"America/Danmarkshavn":           {CountryCodes: []string{"GL"}, Latitude: 76.7667, Longitude: -18.6667, Comment: "National Park (east coast)"},
+	"America/Dawson":                 {CountryCodes: []string{"CA"}, Latitude: 64.0667, Longitude: -139.4167, Comment: "MST - Yukon (west)"},
+	"America/Dawson_Creek":           {CountryCodes: []string{"CA"}, Latitude: 55.7667, Longitude: -120.2333, Comment: "MST - BC (Dawson Cr, Ft St John)"},
+	"America/Denver":                 {CountryCodes: []string{"US"}, Latitude: 39.7392, Longitude: -104.9842, Comment: "Mountain (most areas)"},
+	"America/Detroit":                {CountryCodes: []string{"US"}, Latitude: 42.3314, Longitude: -83.0458, Comment: "Eastern - MI (most areas)"},
+	"America/Dominica":               {CountryCodes: []string{"DM"}, Latitude: 15.3, Longitude: -61.4},
+	"America/Edmonton":               {CountryCodes: []string{"CA"}, Latitude: 53.55, Longitude: -113.4667, Comment: "Mountain - AB, BC(E), NT(E), SK(W)"},
+	"America/Eirunepe":               {CountryCodes: []string{"BR"}, Latitude: -6.6667, Longitude: -69.8667, Comment: "Amazonas (west)"},
+	"America/El_Salvador":            {CountryCodes: []string{"SV"}, Latitude: 13.7, Longitude: -89.2},
+	"America/Fort_Nelson":            {CountryCodes: []string{"CA"}, Latitude: 58.8, Longitude: -122.7, Comment: "MST - BC (Ft Nelson)"},
+	"America/Fortaleza":              {CountryCodes: []string{"BR"}, Latitude: -3.7167, Longitude: -38.5, Comment: "Brazil (northeast: MA, PI, CE, RN, PB)"},
+	"America/Glace_Bay":              {CountryCodes: []string{"CA"}, Latitude: 46.2, Longitude: -59.95, Comment: "Atlantic - NS (Cape Breton)"},
+	"America/Goose_Bay":              {CountryCodes: []string{"CA"}, Latitude: 53.3333, Longitude: -60.4167, Comment: "Atlantic - Labrador (most areas)"},
+	"America/Grand_Turk":             {CountryCodes: []string{"TC"}, Latitude: 21.4667, Longitude: -71.1333},
+	"America/Grenada":                {CountryCodes: []string{"GD"}, Latitude: 12.05, Longitude: -61.75},
+	"America/Guadeloupe":             {CountryCodes: []string{"GP"}, Latitude: 16.2333, Longitude: -61.5333},
+	"America/Guatemala":              {CountryCodes: []string{"GT"}, Latitude: 14.6333, Longitude: -90.5167},
+	"America/Guayaquil":              {CountryCodes: []string{"EC"}, Latitude: -2.1667, Longitude: -79.8333, Comment: "Ecuador (mainland)"},
+	"America/Guyana":                 {CountryCodes: []string{"GY"}, Latitude: 6.8, Longitude: -58.1667},
+	"America/Halifax":                {CountryCodes: []string{"CA"}, Latitude: 44.65, Longitude: -63.6, Comment: "Atlantic - NS (most areas), PE"},
+	"America/Havana":                 {CountryCodes: []string{"CU"}, Latitude: 23.1333, Longitude: -82.3667},
+	"America/Hermosillo":             {CountryCodes: []string{"MX"}, Latitude: 29.0667, Longitude: -110.9667, Comment: "Sonora"},
+	"America/Indiana/Indianapolis":   {CountryCodes: []string{"US"}, Latitude: 39.7683, Longitude: -86.1581, Comment: "Eastern - IN (most areas)"},
+	"America/Indiana/Knox":           {CountryCodes: []string{"US"}, Latitude: 41.2958, Longitude: -86.625, Comment: "Central - IN (Starke)"},
+	"America/Indiana/Marengo":        {CountryCodes: []string{"US"}, Latitude: 38.3756, Longitude: -86.3447, Comment: "Eastern - IN (Crawford)"},
+	"America/Indiana/Petersburg":     {CountryCodes: []string{"US"}, Latitude: 38.4919, Longitude: -87.2786, Comment: "Eastern - IN (Pike)"},
+	"America/Indiana/Tell_City":      {CountryCodes: []string{"US"}, Latitude: 37.9531, Longitude: -86.7614, Comment: "Central - IN (Perry)"},
+	"America/Indiana/Vevay":          {CountryCodes: []string{"US"}, Latitude: 38.7478, Longitude: -85.0672, Comment: "Eastern - IN (Switzerland)"},
+	"America/Indiana/Vincennes":      {CountryCodes: []string{"US"}, Latitude: 38.6772, Longitude: -87.5286, Comment: "Eastern - IN (Da, Du, K, Mn)"},
+	"America/Indiana/Winamac":        {CountryCodes: []string{"US"}, Latitude: 41.0514, Longitude: -86.6031, Comment: "Eastern - IN (Pulaski)"},
+	"America/Inuvik":                 {CountryCodes: []string{"CA"}, Latitude: 68.3497, Longitude: -133.7167, Comment: "Mountain - NT (west)"},
+	"America/Iqaluit":                {CountryCodes: []string{"CA"}, Latitude: 63.7333, Longitude: -68.4667, Comment: "Eastern - NU (most areas)"},
+	"America/Jamaica":                {CountryCodes: []string{"JM"}, Latitude: 17.9681, Longitude: -76.7933},
+	"America/Juneau":                 {CountryCodes: []string{"US"}, Latitude: 58.3019, Longitude: -134.4197, Comment: "Alaska - Juneau area"},
+	"America/Kentucky/Louisville":    {CountryCodes: []string{"US"}, Latitude: 38.2542, Longitude: -85.7594, Comment: "Eastern - KY (Louisville area)"},
+	"America/Kentucky/Monticello":    {CountryCodes: []string{"US"}, Latitude: 36.8297, Longitude: -84.8492, Comment: "Eastern - KY (Wayne)"},
+	"America/Kralendijk":             {CountryCodes: []string{"BQ"}, Latitude: 12.1508, Longitude: -68.2767},
+	"America/La_Paz":                 {CountryCodes: []string{"BO"}, Latitude: -16.5, Longitude: -68.15},
+	"America/Lima":                   {CountryCodes: []string{"PE"}, Latitude: -12.05, Longitude: -77.05},
+	"America/Los_Angeles":            {CountryCodes: []string{"US"}, Latitude: 34.0522, Longitude: -118.2428, Comment: "Pacific"},
+	"America/Lower_Princes":          {CountryCodes: []string{"SX"}, Latitude: 18.0514, Longitude: -63.0472},
+	"America/Maceio":                 {CountryCodes: []string{"BR"}, Latitude: -9.6667, Longitude: -35.7167, Comment: "Alagoas, Sergipe"},
+	"America/Managua":                {CountryCodes: []string{"NI"}, Latitude: 12.15, Longitude: -86.2833},
+	"America/Manaus":                 {CountryCodes: []string{"BR"}, Latitude: -3.1333, Longitude: -60.0167, Comment: "Amazonas (east)"},
+	"America/Marigot":                {CountryCodes: []string{"MF"}, Latitude: 18.0667, Longitude: -63.0833},
+	"America/Martinique":             {CountryCodes: []string{"MQ"}, Latitude: 14.6, Longitude: -61.0833},
+	"America/Matamoros":              {CountryCodes: []string{"MX"}, Latitude: 25.8333, Longitude: -97.5, Comment: "Coahuila, Nuevo Leon, Tamaulipas (US border)"},
+	"America/Mazatlan":               {CountryCodes: []string{"MX"}, Latitude: 23.2167, Longitude: -106.4167, Comment: "Baja California Sur, Nayarit (most areas), Sinaloa"},
+	"America/Menominee":              {CountryCodes: []string{"US"}, Latitude: 45.1078, Longitude: -87.6142, Comment: "Central - MI (Wisconsin border)"},
+	"America/Merida":                 {CountryCodes: []string{"MX"}, Latitude: 20.9667, Longitude: -89.6167, Comment: "Campeche, Yucatan"},
+	"America/Metlakatla":             {CountryCodes: []string{"US"}, Latitude: 55.1269, Longitude: -131.5764, Comment: "Alaska - Annette Island"},
+	"America/Mexico_City":            {CountryCodes: []string{"MX"}, Latitude: 19.4, Longitude: -99.15, Comment: "Central Mexico"},
+	"America/Miquelon":               {CountryCodes: []string{"PM"}, Latitude: 47.05, Longitude: -56.3333},
+	"America/Moncton":                {CountryCodes: []string{"CA"}, Latitude: 46.1, Longitude: -64.7833, Comment: "Atlantic - New Brunswick"},
+	"America/Monterrey":              {CountryCodes: []string{"MX"}, Latitude: 25.6667, Longitude: -100.3167, Comment: "Durango; Coahuila, Nuevo Leon, Tamaulipas (most areas)"},
+	"America/Montevideo":             {CountryCodes: []string{"UY"}, Latitude: -34.9092, Longitude: -56.2125},
+	"America/Montserrat":             {CountryCodes: []string{"MS"}, Latitude: 16.7167, Longitude: -62.2167},
+	"America/Nassau":                 {CountryCodes: []string{"BS"}, Latitude: 25.0833, Longitude: -77.35},
+	"America/New_York":               {CountryCodes: []string{"US"}, Latitude: 40.7142, Longitude: -74.0064, Comment: "Eastern (most areas)"},
+	"America/Nome":                   {CountryCodes: []string{"US"}, Latitude: 64.5011, Longitude: -165.4064, Comment: "Alaska (west)"},
+	"America/Noronha":                {CountryCodes: []string{"BR"}, Latitude: -3.85, Longitude: -32.4167, Comment: "Atlantic islands"},
+	"America/North_Dakota/Beulah":    {CountryCodes: []string{"US"}, Latitude: 47.2642, Longitude: -101.7778, Comment: "Central - ND (Mercer)"},
+	"America/North_Dakota/Center":    {CountryCodes: []string{"US"}, Latitude: 47.1164, Longitude: -101.2992, Comment: "Central - ND (Oliver)"},
+	"America/North_Dakota/New_Salem": {CountryCodes: []string{"US"}, Latitude: 46.845, Longitude: -101.4108, Comment: "Central - ND (Morton rural)"},
+	"America/Nuuk":                   {CountryCodes: []string{"GL"}, Latitude: 64.1833, Longitude: -51.7333, Comment: "most of Greenland"},
+	"America/Ojinaga":                {CountryCodes: []string{"MX"}, Latitude: 29.5667, Longitude: -104.4167, Comment: "Chihuahua (US border - east)"},
+	"America/Panama":                 {CountryCodes: []string{"PA", "CA", "KY"}, Latitude: 8.9667, Longitude: -79.5333, Comment: "EST - ON (Atikokan), NU (Coral H)"},
+	"America/Paramaribo":             {CountryCodes: []string{"SR"}, Latitude: 5.8333, Longitude: -55.1667},
+	"America/Phoenix":                {CountryCodes: []string{"US", "CA"}, Latitude: 33.4483, Longitude: -112.0733, Comment: "MST - AZ (except Navajo)"},
+	"America/Port-au-Prince":         {CountryCodes: []string{"HT"}, Latitude: 18.5333, Longitude: -72.3333},
+	"America/Port_of_Spain":          {CountryCodes: []string{"TT"}, Latitude: 10.65, Longitude: -61.5167},
+	"America/Porto_Velho":            {CountryCodes: []string{"BR"}, Latitude: -8.7667, Longitude: -63.9, Comment: "Rondonia"},
+	"America/Puerto_Rico":            {CountryCodes: []string{"PR", "AG", "CA", "AI", "AW", "BL", "BQ", "CW", "DM", "GD", "GP", "KN", "LC", "MF", "MS", "SX", "TT", "VC", "VG", "VI"}, Latitude: 18.4683, Longitude: -66.1061, Comment: "AST - QC (Lower North Shore)"},
+	"America/Punta_Arenas":           {CountryCodes: []string{"CL"}, Latitude: -53.15, Longitude: -70.9167, Comment: "Magallanes Region"},
+	"America/Rankin_Inlet":           {CountryCodes: []string{"CA"}, Latitude: 62.8167, Longitude: -92.0831, Comment: "Central - NU (central)"},
+	"America/Recife":                 {CountryCodes: []string{"BR"}, Latitude: -8.05, Longitude: -34.9, Comment: "Pernambuco"},
+	"America/Regina":                 {CountryCodes: []string{"CA"}, Latitude: 50.4, Longitude: -104.65, Comment: "CST - SK (most areas)"},
+	"America/Resolute":               {CountryCodes: []string{"CA"}, Latitude: 74.6956, Longitude: -94.8292, Comment: "Central - NU (Resolute)"},
+	"America/Rio_Branco":             {CountryCodes: []string{"BR"}, Latitude: -9.9667, Longitude: -67.8, Comment: "Acre"},
+	"America/Santarem":               {CountryCodes: []string{"BR"}, Latitude: -2.4333, Longitude: -54.8667, Comment: "Para (west)"},
+	"America/Santiago":               {CountryCodes: []string{"CL"}, Latitude: -33.45, Longitude: -70.6667, Comment: "most of Chile"},
+	"America/Santo_Domingo":          {CountryCodes: []string{"DO"}, Latitude: 18.4667, Longitude: -69.9},
+	"America/Sao_Paulo":              {CountryCodes: []string{"BR"}, Latitude: -23.5333, Longitude: -46.6167, Comment: "Brazil (southeast: GO, DF, MG, ES, RJ, SP, PR, SC, RS)"},
+	"America/Scoresbysund":           {CountryCodes: []string{"GL"}, Latitude: 70.4833, Longitude: -21.9667, Comment: "Scoresbysund/Ittoqqortoormiit"},
+	"America/Sitka":                  {CountryCodes: []string{"US"}, Latitude: 57.1764, Longitude: -135.3019, Comment: "Alaska - Sitka area"},
+	"America/St_Barthelemy":          {CountryCodes: []string{"BL"}, Latitude: 17.8833, Longitude: -62.85},
+	"America/St_Johns":               {CountryCodes: []string{"CA"}, Latitude: 47.5667, Longitude: -52.7167, Comment: "Newfoundland, Labrador (SE)"},
+	"America/St_Kitts":               {CountryCodes: []string{"KN"}, Latitude: 17.3, Longitude: -62.7167},
+	"America/St_Lucia":               {CountryCodes: []string{"LC"}, Latitude: 14.0167, Longitude: -61},
+	"America/St_Thomas":              {CountryCodes: []string{"VI"}, Latitude: 18.35, Longitude: -64.9333},
+	"America/St_Vincent":             {CountryCodes: []string{"VC"}, Latitude: 13.15, Longitude: -61.2333},
+	"America/Swift_Current":          {CountryCodes: []string{"CA"}, Latitude: 50.2833, Longitude: -107.8333, Comment: "CST - SK (midwest)"},
+	"America/Tegucigalpa":            {CountryCodes: []string{"HN"}, Latitude: 14.1, Longitude: -87.2167},
+	"America/Thule":                  {CountryCodes: []string{"GL"}, Latitude: 76.5667, Longitude: -68.7833, Comment: "Thule/Pituffik"},
+	"America/Tijuana":                {CountryCodes: []string{"MX"}, Latitude: 32.5333, Longitude: -117.0167, Comment: "Baja California"},
+	"America/Toronto":                {CountryCodes: []string{"CA", "BS"}, Latitude: 43.65, Longitude: -79.3833, Comment: "Eastern - ON & QC (most areas)"},
+	"America/Tortola":                {CountryCodes: []string{"VG"}, Latitude: 18.45, Longitude: -64.6167},
+	"America/Vancouver":              {CountryCodes: []string{"CA"}, Latitude: 49.2667, Longitude: -123.1167, Comment: "Pacific - BC (most areas)"},
+	"America/Whitehorse":             {CountryCodes: []string{"CA"}, Latitude: 60.7167, Longitude: -135.05, Comment: "MST - Yukon (east)"},
+	"America/Winnipeg":               {CountryCodes: []string{"CA"}, Latitude: 49.8833, Longitude: -97.15, Comment: "Central - ON (west), Manitoba"},
+	"America/Yakutat":                {CountryCodes: []string{"US"}, Latitude: 59.5469, Longitude: -139.7272, Comment: "Alaska - Yakutat"},
+	"Antarctica/Casey":               {CountryCodes: []string{"AQ"}, Latitude: -66.2833, Longitude: 110.5167, Comment: "Casey"},
+	"Antarctica/Davis":               {CountryCodes: []string{"AQ"}, Latitude: -68.5833, Longitude: 77.9667, Comment: "Davis"},
+	"Antarctica/DumontDUrville":      {CountryCodes: []string{"AQ"}, Latitude: -66.6667, Longitude: 140.0167, Comment: "Dumont-d'Urville"},
+	"Antarctica/Macquarie":           {CountryCodes: []string{"AU"}, Latitude: -54.5, Longitude: 158.95, Comment: "Macquarie Island"},
+	"Antarctica/Mawson":              {CountryCodes: []string{"AQ"}, Latitude: -67.6, Longitude: 62.8833, Comment: "Mawson"},
+	"Antarctica/McMurdo":             {CountryCodes: []string{"AQ"}, Latitude: -77.8333, Longitude: 166.6, Comment: "New Zealand time - McMurdo, South Pole"},
+	"Antarctica/Palmer":              {CountryCodes: []string{"AQ"}, Latitude: -64.8, Longitude: -64.1, Comment: "Palmer"},
+	"Antarctica/Rothera":             {CountryCodes: []string{"AQ"}, Latitude: -67.5667, Longitude: -68.1333, Comment: "Rothera"},
+	"Antarctica/Syowa":               {CountryCodes: []string{"AQ"}, Latitude: -69.0061, Longitude: 39.59, Comment: "Syowa"},
+	"Antarctica/Troll":               {CountryCodes: []string{"AQ"}, Latitude: -72.0114, Longitude: 2.535, Comment: "Troll"},
+	"Antarctica/Vostok":              {CountryCodes: []string{"AQ"}, Latitude: -78.4, Longitude: 106.9, Comment: "Vostok"},
+	"Arctic/Longyearbyen":            {CountryCodes: []string{"SJ"}, Latitude: 78, Longitude: 16},
+	"Asia/Aden":                      {CountryCodes: []string{"YE"}, Latitude: 12.75, Longitude: 45.2},
+	"Asia/Almaty":                    {CountryCodes: []string{"KZ"}, Latitude: 43.25, Longitude: 76.95, Comment: "most of Kazakhstan"},
+	"Asia/Amman":                     {CountryCodes: []string{"JO"}, Latitude: 31.95, Longitude: 35.9333},
+	"Asia/Anadyr":                    {CountryCodes: []string{"RU"}, Latitude: 64.75, Longitude: 177.4833, Comment: "MSK+09 - Bering Sea"},
+	"Asia/Aqtau":                     {CountryCodes: []string{"KZ"}, Latitude: 44.5167, Longitude: 50.2667, Comment: "Mangghystau/Mankistau"},
+	"Asia/Aqtobe":                    {CountryCodes: []string{"KZ"}, Latitude: 50.2833, Longitude: 57.1667, Comment: "Aqtobe/Aktobe"},
+	"Asia/Ashgabat":                  {CountryCodes: []string{"TM"}, Latitude: 37.95, Longitude: 58.3833},
+	"Asia/Atyrau":                    {CountryCodes: []string{"KZ"}, Latitude: 47.1167, Longitude: 51.9333, Comment: "Atyrau/Atirau/Gur'yev"},
+	"Asia/Baghdad":                   {CountryCodes: []string{"IQ"}, Latitude: 33.35, Longitude: 44.4167},
+	"Asia/Bahrain":                   {CountryCodes: []string{"BH"}, Latitude: 26.3833, Longitude: 50.5833},
+	"Asia/Baku":                      {CountryCodes: []string{"AZ"}, Latitude: 40.3833, Longitude: 49.85},
+	"Asia/Bangkok":                   {CountryCodes: []string{"TH", "CX", "KH", "LA", "VN"}, Latitude: 13.75, Longitude: 100.5167, Comment: "north Vietnam"},
+	"Asia/Barnaul":                   {CountryCodes: []string{"RU"}, Latitude: 53.3667, Longitude: 83.75, Comment: "MSK+04 - Altai"},
+	"Asia/Beirut":                    {CountryCodes: []string{"LB"}, Latitude: 33.8833, Longitude: 35.5},
+	"Asia/Bishkek":                   {CountryCodes: []string{"KG"}, Latitude: 42.9, Longitude: 74.6},
+	"Asia/Brunei":                    {CountryCodes: []string{"BN"}, Latitude: 4.9333, Longitude: 114.9167},
+	"Asia/Chita":                     {CountryCodes: []string{"RU"}, Latitude: 52.05, Longitude: 113.4667, Comment: "MSK+06 - Zabaykalsky"},
+	"Asia/Colombo":                   {CountryCodes: []string{"LK"}, Latitude: 6.9333, Longitude: 79.85},
+	"Asia/Damascus":                  {CountryCodes: []string{"SY"}, Latitude: 33.5, Longitude: 36.3},
+	"Asia/Dhaka":                     {CountryCodes: []string{"BD"}, Latitude: 23.7167, Longitude: 90.4167},
+	"Asia/Dili":                      {CountryCodes: []string{"TL"}, Latitude: -8.55, Longitude: 125.5833},
+	"Asia/Dubai":                     {CountryCodes: []string{"AE", "OM", "RE", "SC", "TF"}, Latitude: 25.3, Longitude: 55.3, Comment: "Crozet"},
+	"Asia/Dushanbe":                  {CountryCodes: []string{"TJ"}, Latitude: 38.5833, Longitude: 68.8},
+	"Asia/Famagusta":                 {CountryCodes: []string{"CY"}, Latitude: 35.1167, Longitude: 33.95, Comment: "Northern Cyprus"},
+	"Asia/Gaza":                      {CountryCodes: []string{"PS"}, Latitude: 31.5, Longitude: 34.4667, Comment: "Gaza Strip"},
+	"Asia/Hebron":                    {CountryCodes: []string{"PS"}, Latitude: 31.5333, Longitude: 35.095, Comment: "West Bank"},
+	"Asia/Ho_Chi_Minh":               {CountryCodes: []string{"VN"}, Latitude: 10.75, Longitude: 106.6667, Comment: "south Vietnam"},
+	"Asia/Hong_Kong":                 {CountryCodes: []string{"HK"}, Latitude: 22.2833, Longitude: 114.15},
+	"Asia/Hovd":                      {CountryCodes: []string{"MN"}, Latitude: 48.0167, Longitude: 91.65, Comment: "Bayan-Olgii, Hovd, Uvs"},
+	"Asia/Irkutsk":                   {CountryCodes: []string{"RU"}, Latitude: 52.2667, Longitude: 104.3333, Comment: "MSK+05 - Irkutsk, Buryatia"},
+	"Asia/Jakarta":                   {CountryCodes: []string{"ID"}, Latitude: -6.1667, Longitude: 106.8, Comment: "Java, Sumatra"},
+	"Asia/Jayapura":                  {CountryCodes: []string{"ID"}, Latitude: -2.5333, Longitude: 140.7, Comment: "New Guinea (West Papua / Irian Jaya), Malukus/Moluccas"},
+	"Asia/Jerusalem":                 {CountryCodes: []string{"IL"}, Latitude: 31.7806, Longitude: 35.2239},
+	"Asia/Kabul":                     {CountryCodes: []string{"AF"}, Latitude: 34.5167, Longitude: 69.2},
+	"Asia/Kamchatka":                 {CountryCodes: []string{"RU"}, Latitude: 53.0167, Longitude: 158.65, Comment: "MSK+09 - Kamchatka"},
+	"Asia/Karachi":                   {CountryCodes: []string{"PK"}, Latitude: 24.8667, Longitude: 67.05},
+	"Asia/Kathmandu":                 {CountryCodes: []string{"NP"}, Latitude: 27.7167, Longitude: 85.3167},
+	"Asia/Khandyga":                  {CountryCodes: []string{"RU"}, Latitude: 62.6564, Longitude: 135.5539, Comment: "MSK+06 - Tomponsky, Ust-Maysky"},
+	"Asia/Kolkata":                   {CountryCodes: []string{"IN"}, Latitude: 22.5333, Longitude: 88.3667},
+	"Asia/Krasnoyarsk":               {CountryCodes: []string{"RU"}, Latitude: 56.0167, Longitude: 92.8333, Comment: "MSK+04 - Krasnoyarsk area"},
+	"Asia/Kuala_Lumpur":              {CountryCodes: []string{"MY"}, Latitude: 3.1667, Longitude: 101.7, Comment: "Malaysia (peninsula)"},
+	"Asia/Kuching":                   {CountryCodes: []string{"MY", "BN"}, Latitude: 1.55, Longitude: 110.3333, Comment: "Sabah, Sarawak"},
+	"Asia/Kuwait":                    {CountryCodes: []string{"KW"}, Latitude: 29.3333, Longitude: 47.9833},
+	"Asia/Macau":                     {CountryCodes: []string{"MO"}, Latitude: 22.1972, Longitude: 113.5417},
+	"Asia/Magadan":                   {CountryCodes: []string{"RU"}, Latitude: 59.5667, Longitude: 150.8, Comment: "MSK+08 - Magadan"},
+	"Asia/Makassar":                  {CountryCodes: []string{"ID"}, Latitude: -5.1167, Longitude: 119.4, Comment: "Borneo (east, south), Sulawesi/Celebes, Bali, Nusa Tengarra, Timor (west)"},
+	"Asia/Manila":                    {CountryCodes: []string{"PH"}, Latitude: 14.5867, Longitude: 120.9678},
+	"Asia/Muscat":                    {CountryCodes: []string{"OM"}, Latitude: 23.6, Longitude: 58.5833},
+	"Asia/Nicosia":                   {CountryCodes: []string{"CY"}, Latitude: 35.1667, Longitude: 33.3667, Comment: "most of Cyprus"},
+	"Asia/Novokuznetsk":              {CountryCodes: []string{"RU"}, Latitude: 53.75, Longitude: 87.1167, Comment: "MSK+04 - Kemerovo"},
+	"Asia/Novosibirsk":               {CountryCodes: []string{"RU"}, Latitude: 55.0333, Longitude: 82.9167, Comment: "MSK+04 - Novosibirsk"},
+	"Asia/Omsk":                      {CountryCodes: []string{"RU"}, Latitude: 55, Longitude: 73.4, Comment: "MSK+03 - Omsk"},
+	"Asia/Oral":                      {CountryCodes: []string{"KZ"}, Latitude: 51.2167, Longitude: 51.35, Comment: "West Kazakhstan"},
+	"Asia/Phnom_Penh":                {CountryCodes: []string{"KH"}, Latitude: 11.55, Longitude: 104.9167},
+	"Asia/Pontianak":                 {CountryCodes: []string{"ID"}, Latitude: -0.0333, Longitude: 109.3333, Comment: "Borneo (west, central)"},
+	"Asia/Pyongyang":                 {CountryCodes: []string{"KP"}, Latitude: 39.0167, Longitude: 125.75},
+	"Asia/Qatar":                     {CountryCodes: []string{"QA", "BH"}, Latitude: 25.2833, Longitude: 51.5333},
+	"Asia/Qostanay":                  {CountryCodes: []string{"KZ"}, Latitude: 53.2, Longitude: 63.6167, Comment: "Qostanay/Kostanay/Kustanay"},
+	"Asia/Qyzylorda":                 {CountryCodes: []string{"KZ"}, Latitude: 44.8, Longitude: 65.4667, Comment: "Qyzylorda/Kyzylorda/Kzyl-Orda"},
+	"Asia/Riyadh":                    {CountryCodes: []string{"SA", "AQ", "KW", "YE"}, Latitude: 24.6333, Longitude: 46.7167, Comment: "Syowa"},
+	"Asia/Sakhalin":                  {CountryCodes: []string{"RU"}, Latitude: 46.9667, Longitude: 142.7, Comment: "MSK+08 - Sakhalin Island"},
+	"Asia/Samarkand":                 {CountryCodes: []string{"UZ"}, Latitude: 39.6667, Longitude: 66.8, Comment: "Uzbekistan (west)"},
+	"Asia/Seoul":                     {CountryCodes: []string{"KR"}, Latitude: 37.55, Longitude: 126.9667},
+	"Asia/Shanghai":                  {CountryCodes: []string{"CN"}, Latitude: 31.2333, Longitude: 121.4667, Comment: "Beijing Time"},
+	"Asia/Singapore":                 {CountryCodes: []string{"SG", "AQ", "MY"}, Latitude: 1.2833, Longitude: 103.85, Comment: "peninsular Malaysia, Concordia"},
+	"Asia/Srednekolymsk":             {CountryCodes: []string{"RU"}, Latitude: 67.4667, Longitude: 153.7167, Comment: "MSK+08 - Sakha (E), N Kuril Is"},
+	"Asia/Taipei":                    {CountryCodes: []string{"TW"}, Latitude: 25.05, Longitude: 121.5},
+	"Asia/Tashkent":                  {CountryCodes: []string{"UZ"}, Latitude: 41.3333, Longitude: 69.3, Comment: "Uzbekistan (east)"},
+	"Asia/Tbilisi":                   {CountryCodes: []string{"GE"}, Latitude: 41.7167, Longitude: 44.8167},
+	"Asia/Tehran":                    {CountryCodes: []string{"IR"}, Latitude: 35.6667, Longitude: 51.4333},
+	"Asia/Thimphu":                   {CountryCodes: []string{"BT"}, Latitude: 27.4667, Longitude: 89.65},
+	"Asia/Tokyo":                     {CountryCodes: []string{"JP", "AU"}, Latitude: 35.6544, Longitude: 139.7447, Comment: "Eyre Bird Observatory"},
+	"Asia/Tomsk":                     {CountryCodes: []string{"RU"}, Latitude: 56.5, Longitude: 84.9667, Comment: "MSK+04 - Tomsk"},
+	"Asia/Ulaanbaatar":               {CountryCodes: []string{"MN"}, Latitude: 47.9167, Longitude: 106.8833, Comment: "most of Mongolia"},
+	"Asia/Urumqi":                    {CountryCodes: []string{"CN"}, Latitude: 43.8, Longitude: 87.5833, Comment: "Xinjiang Time"},
+	"Asia/Ust-Nera":                  {CountryCodes: []string{"RU"}, Latitude: 64.5603, Longitude: 143.2267, Comment: "MSK+07 - Oymyakonsky"},
+	"Asia/Vientiane":                 {CountryCodes: []string{"LA"}, Latitude: 17.9667, Longitude: 102.6},
+	"Asia/Vladivostok":               {CountryCodes: []string{"RU"}, Latitude: 43.1667, Longitude: 131.9333, Comment: "MSK+07 - Amur River"},
+	"Asia/Yakutsk":                   {CountryCodes: []string{"RU"}, Latitude: 62, Longitude: 129.6667, Comment: "MSK+06 - Lena River"},
+	"Asia/Yangon":                    {CountryCodes: []string{"MM", "CC"}, Latitude: 16.7833, Longitude: 96.1667},
+	"Asia/Yekaterinburg":             {CountryCodes: []string{"RU"}, Latitude: 56.85, Longitude: 60.6, Comment: "MSK+02 - Urals"},
+	"Asia/Yerevan":                   {CountryCodes: []string{"AM"}, Latitude: 40.1833, Longitude: 44.5},
+	"Atlantic/Azores":                {CountryCodes: []string{"PT"}, Latitude: 37.7333, Longitude: -25.6667, Comment: "Azores"},
+	"Atlantic/Bermuda":               {CountryCodes: []string{"BM"}, Latitude: 32.2833, Longitude: -64.7667},
+	"Atlantic/Canary":                {CountryCodes: []string{"ES"}, Latitude: 28.1, Longitude: -15.4, Comment: "Canary Islands"},
+	"Atlantic/Cape_Verde":            {CountryCodes: []string{"CV"}, Latitude: 14.9167, Longitude: -23.5167},
+	"Atlantic/Faroe":                 {CountryCodes: []string{"FO"}, Latitude: 62.0167, Longitude: -6.7667},
+	"Atlantic/Madeira":               {CountryCodes: []string{"PT"}, Latitude: 32.6333, Longitude: -16.9, Comment: "Madeira Islands"},
+	"Atlantic/Reykjavik":             {CountryCodes: []string{"IS"}, Latitude: 64.15, Longitude: -21.85},
+	"Atlantic/South_Georgia":         {CountryCodes: []string{"GS"}, Latitude: -54.2667, Longitude: -36.5333},
+	"Atlantic/St_Helena":             {CountryCodes: []string{"SH"}, Latitude: -15.9167, Longitude: -5.7},
+	"Atlantic/Stanley":               {CountryCodes: []string{"FK"}, Latitude: -51.7, Longitude: -57.85},
+	"Australia/Adelaide":             {CountryCodes: []string{"AU"}, Latitude: -34.9167, Longitude: 138.5833, Comment: "South Australia"},
+	"Australia/Brisbane":             {CountryCodes: []string{"AU"}, Latitude: -27.4667, Longitude: 153.0333, Comment: "Queensland (most areas)"},
+	"Australia/Broken_Hill":          {CountryCodes: []string{"AU"}, Latitude: -31.95, Longitude: 141.45, Comment: "New South Wales (Yancowinna)"},
+	"Australia/Darwin":               {CountryCodes: []string{"AU"}, Latitude: -12.4667, Longitude: 130.8333, Comment: "Northern Territory"},
+	"Australia/Eucla":                {CountryCodes: []string{"AU"}, Latitude: -31.7167, Longitude: 128.8667, Comment: "Western Australia (Eucla)"},
+	"Australia/Hobart":               {CountryCodes: []string{"AU"}, Latitude: -42.8833, Longitude: 147.3167, Comment: "Tasmania"},
+	"Australia/Lindeman":             {CountryCodes: []string{"AU"}, Latitude: -20.2667, Longitude: 149, Comment: "Queensland (Whitsunday Islands)"},
+	"Australia/Lord_Howe":            {CountryCodes: []string{"AU"}, Latitude: -31.55, Longitude: 159.0833, Comment: "Lord Howe Island"},
+	"Australia/Melbourne":            {CountryCodes: []string{"AU"}, Latitude: -37.8167, Longitude: 144.9667, Comment: "Victoria"},
+	"Australia/Perth":                {CountryCodes: []string{"AU"}, Latitude: -31.95, Longitude: 115.85, Comment: "Western Australia (most areas)"},
+	"Australia/Sydney":               {CountryCodes: []string{"AU"}, Latitude: -33.8667, Longitude: 151.2167, Comment: "New South Wales (most areas)"},
+	"Europe/Amsterdam":               {CountryCodes: []string{"NL"}, Latitude: 52.3667, Longitude: 4.9},
+	"Europe/Andorra":                 {CountryCodes: []string{"AD"}, Latitude: 42.5, Longitude: 1.5167},
+	"Europe/Astrakhan":               {CountryCodes: []string{"RU"}, Latitude: 46.35, Longitude: 48.05, Comment: "MSK+01 - Astrakhan"},
+	"Europe/Athens":                  {CountryCodes: []string{"GR"}, Latitude: 37.9667, Longitude: 23.7167},
+	"Europe/Belgrade":                {CountryCodes: []string{"RS", "BA", "HR", "ME", "MK", "SI"}, Latitude: 44.8333, Longitude: 20.5},
+	"Europe/Berlin":                  {CountryCodes: []string{"DE", "DK", "NO", "SE", "SJ"}, Latitude: 52.5, Longitude: 13.3667, Comment: "most of Germany"},
+	"Europe/Bratislava":              {CountryCodes: []string{"SK"}, Latitude: 48.15, Longitude: 17.1167},
+	"Europe/Brussels":                {CountryCodes: []string{"BE", "LU", "NL"}, Latitude: 50.8333, Longitude: 4.3333},
+	"Europe/Bucharest":               {CountryCodes: []string{"RO"}, Latitude: 44.4333, Longitude: 26.1},
+	"Europe/Budapest":                {CountryCodes: []string{"HU"}, Latitude: 47.5, Longitude: 19.0833},
+	"Europe/Busingen":                {CountryCodes: []string{"DE"}, Latitude: 47.7, Longitude: 8.6833, Comment: "Busingen"},
+	"Europe/Chisinau":                {CountryCodes: []string{"MD"}, Latitude: 47, Longitude: 28.8333},
+	"Europe/Copenhagen":              {CountryCodes: []string{"DK"}, Latitude: 55.6667, Longitude: 12.5833},
+	"Europe/Dublin":                  {CountryCodes: []string{"IE"}, Latitude: 53.3333, Longitude: -6.25},
+	"Europe/Gibraltar":               {CountryCodes: []string{"GI"}, Latitude: 36.1333, Longitude: -5.35},
+	"Europe/Guernsey":                {CountryCodes: []string{"GG"}, Latitude: 49.4547, Longitude: -2.5361},
+	"Europe/Helsinki":                {CountryCodes: []string{"FI", "AX"}, Latitude: 60.1667, Longitude: 24.9667},
+	"Europe/Isle_of_Man":             {CountryCodes: []string{"IM"}, Latitude: 54.15, Longitude: -4.4667},
+	"Europe/Istanbul":                {CountryCodes: []string{"TR"}, Latitude: 41.0167, Longitude: 28.9667},
+	"Europe/Jersey":                  {CountryCodes: []string{"JE"}, Latitude: 49.1836, Longitude: -2.1067},
+	"Europe/Kaliningrad":             {CountryCodes: []string{"RU"}, Latitude: 54.7167, Longitude: 20.5, Comment: "MSK-01 - Kaliningrad"},
+	"Europe/Kirov":                   {CountryCodes: []string{"RU"}, Latitude: 58.6, Longitude: 49.65, Comment: "MSK+00 - Kirov"},
+	"Europe/Kyiv":                    {CountryCodes: []string{"UA"}, Latitude: 50.4333, Longitude: 30.5167, Comment: "most of Ukraine"},
+	"Europe/Lisbon":                  {CountryCodes: []string{"PT"}, Latitude: 38.7167, Longitude: -9.1333, Comment: "Portugal (mainland)"},
+	"Europe/Ljubljana":               {CountryCodes: []string{"SI"}, Latitude: 46.05, Longitude: 14.5167},
+	"Europe/London":                  {CountryCodes: []string{"GB", "GG", "IM", "JE"}, Latitude: 51.5083, Longitude: -0.1253},
+	"Europe/Luxembourg":              {CountryCodes: []string{"LU"}, Latitude: 49.6, Longitude: 6.15},
+	"Europe/Madrid":                  {CountryCodes: []string{"ES"}, Latitude: 40.4, Longitude: -3.6833, Comment: "Spain (mainland)"},
+	"Europe/Malta":                   {CountryCodes: []string{"MT"}, Latitude: 35.9, Longitude: 14.5167},
+	"Europe/Mariehamn":               {CountryCodes: []string{"AX"}, Latitude: 60.1, Longitude: 19.95},
+	"Europe/Minsk":                   {CountryCodes: []string{"BY"}, Latitude: 53.9, Longitude: 27.5667},
+	"Europe/Monaco":                  {CountryCodes: []string{"MC"}, Latitude: 43.7, Longitude: 7.3833},
+	"Europe/Moscow":                  {CountryCodes: []string{"RU"}, Latitude: 55.7558, Longitude: 37.6178, Comment: "MSK+00 - Moscow area"},
+	"Europe/Oslo":                    {CountryCodes: []string{"NO"}, Latitude: 59.9167, Longitude: 10.75},
+	"Europe/Paris":                   {CountryCodes: []string{"FR", "MC"}, Latitude: 48.8667, Longitude: 2.3333},
+	"Europe/Podgorica":               {CountryCodes: []string{"ME"}, Latitude: 42.4333, Longitude: 19.2667},
+	"Europe/Prague":                  {CountryCodes: []string{"CZ", "SK"}, Latitude: 50.0833, Longitude: 14.4333},
+	"Europe/Riga":                    {CountryCodes: []string{"LV"}, Latitude: 56.95, Longitude: 24.1},
+	"Europe/Rome":                    {CountryCodes: []string{"IT", "SM", "VA"}, Latitude: 41.9, Longitude: 12.4833},
+	"Europe/Samara":                  {CountryCodes: []string{"RU"}, Latitude: 53.2, Longitude: 50.15, Comment: "MSK+01 - Samara, Udmurtia"},
+	"Europe/San_Marino":              {CountryCodes: []string{"SM"}, Latitude: 43.9167, Longitude: 12.4667},
+	"Europe/Sarajevo":                {CountryCodes: []string{"BA"}, Latitude: 43.8667, Longitude: 18.4167},
+	"Europe/Saratov":                 {CountryCodes: []string{"RU"}, Latitude: 51.5667, Longitude: 46.0333, Comment: "MSK+01 - Saratov"},
+	"Europe/Simferopol":              {CountryCodes: []string{"UA", "RU"}, Latitude: 44.95, Longitude: 34.1, Comment: "Crimea"},
+	"Europe/Skopje":                  {CountryCodes: []string{"MK"}, Latitude: 41.9833, Longitude: 21.4333},
+	"Europe/Sofia":                   {CountryCodes: []string{"BG"}, Latitude: 42.6833, Longitude: 23.3167},
+	"Europe/Stockholm":               {CountryCodes: []string{"SE"}, Latitude: 59.3333, Longitude: 18.05},
+	"Europe/Tallinn":                 {CountryCodes: []string{"EE"}, Latitude: 59.4167, Longitude: 24.75},
+	"Europe/Tirane":                  {CountryCodes: []string{"AL"}, Latitude: 41.3333, Longitude: 19.8333},
+	"Europe/Ulyanovsk":               {CountryCodes: []string{"RU"}, Latitude: 54.3333, Longitude: 48.4, Comment: "MSK+01 - Ulyanovsk"},
+	"Europe/Vaduz":                   {CountryCodes: []string{"LI"}, Latitude: 47.15, Longitude: 9.5167},
+	"Europe/Vatican":                 {CountryCodes: []string{"VA"}, Latitude: 41.9022, Longitude: 12.4531},
+	"Europe/Vienna":                  {CountryCodes: []string{"AT"}, Latitude: 48.2167, Longitude: 16.3333},
+	"Europe/Vilnius":                 {CountryCodes: []string{"LT"}, Latitude: 54.6833, Longitude: 25.3167},
+	"Europe/Volgograd":               {CountryCodes: []string{"RU"}, Latitude: 48.7333, Longitude: 44.4167, Comment: "MSK+00 - Volgograd"},
+	"Europe/Warsaw":                  {CountryCodes: []string{"PL"}, Latitude: 52.25, Longitude: 21},
+	"Europe/Zagreb":                  {CountryCodes: []string{"HR"}, Latitude: 45.8, Longitude: 15.9667},
+	"Europe/Zurich":                  {CountryCodes: []string{"CH", "DE", "LI"}, Latitude: 47.3833, Longitude: 8.5333, Comment: "Büsingen"},
+	"Indian/Antananarivo":            {CountryCodes: []string{"MG"}, Latitude: -18.9167, Longitude: 47.5167},
+	"Indian/Chagos":                  {CountryCodes: []string{"IO"}, Latitude: -7.3333, Longitude: 72.4167},
+	"Indian/Christmas":               {CountryCodes: []string{"CX"}, Latitude: -10.4167, Longitude: 105.7167},
+	"Indian/Cocos":                   {CountryCodes: []string{"CC"}, Latitude: -12.1667, Longitude: 96.9167},
+	"Indian/Comoro":                  {CountryCodes: []string{"KM"}, Latitude: -11.6833, Longitude: 43.2667},
+	"Indian/Kerguelen":               {CountryCodes: []string{"TF"}, Latitude: -49.3528, Longitude: 70.2175},
+	"Indian/Mahe":                    {CountryCodes: []string{"SC"}, Latitude: -4.6667, Longitude: 55.4667},
+	"Indian/Maldives":                {CountryCodes: []string{"MV", "TF"}, Latitude: 4.1667, Longitude: 73.5, Comment: "Kerguelen, St Paul I, Amsterdam I"},
+	"Indian/Mauritius":               {CountryCodes: []string{"MU"}, Latitude: -20.1667, Longitude: 57.5},
+	"Indian/Mayotte":                 {CountryCodes: []string{"YT"}, Latitude: -12.7833, Longitude: 45.2333},
+	"Indian/Reunion":                 {CountryCodes: []string{"RE"}, Latitude: -20.8667, Longitude: 55.4667},
+	"Pacific/Apia":                   {CountryCodes: []string{"WS"}, Latitude: -13.8333, Longitude: -171.7333},
+	"Pacific/Auckland":               {CountryCodes: []string{"NZ", "AQ"}, Latitude: -36.8667, Longitude: 174.7667, Comment: "most of New Zealand"},
+	"Pacific/Bougainville":           {CountryCodes: []string{"PG"}, Latitude: -6.2167, Longitude: 155.5667, Comment: "Bougainville"},
+	"Pacific/Chatham":                {CountryCodes: []string{"NZ"}, Latitude: -43.95, Longitude: -176.55, Comment: "Chatham Islands"},
+	"Pacific/Chuuk":                  {CountryCodes: []string{"FM"}, Latitude: 7.4167, Longitude: 151.7833, Comment: "Chuuk/Truk, Yap"},
+	"Pacific/Easter":                 {CountryCodes: []string{"CL"}, Latitude: -27.15, Longitude: -109.4333, Comment: "Easter Island"},
+	"Pacific/Efate":                  {CountryCodes: []string{"VU"}, Latitude: -17.6667, Longitude: 168.4167},
+	"Pacific/Fakaofo":                {CountryCodes: []string{"TK"}, Latitude: -9.3667, Longitude: -171.2333},
+	"Pacific/Fiji":                   {CountryCodes: []string{"FJ"}, Latitude: -18.1333, Longitude: 178.4167},
+	"Pacific/Funafuti":               {CountryCodes: []string{"TV"}, Latitude: -8.5167, Longitude: 179.2167},
+	"Pacific/Galapagos":              {CountryCodes: []string{"EC"}, Latitude: -0.9, Longitude: -89.6, Comment: "Galapagos Islands"},
+	"Pacific/Gambier":                {CountryCodes: []string{"PF"}, Latitude: -23.1333, Longitude: -134.95, Comment: "Gambier Islands"},
+	"Pacific/Guadalcanal":            {CountryCodes: []string{"SB", "FM"}, Latitude: -9.5333, Longitude: 160.2, Comment: "Pohnpei"},
+	"Pacific/Guam":                   {CountryCodes: []string{"GU", "MP"}, Latitude: 13.4667, Longitude: 144.75},
+	"Pacific/Honolulu":               {CountryCodes: []string{"US"}, Latitude: 21.3069, Longitude: -157.8583, Comment: "Hawaii"},
+	"Pacific/Kanton":                 {CountryCodes: []string{"KI"}, Latitude: -2.7833, Longitude: -171.7167, Comment: "Phoenix Islands"},
+	"Pacific/Kiritimati":             {CountryCodes: []string{"KI"}, Latitude: 1.8667, Longitude: -157.3333, Comment: "Line Islands"},
+	"Pacific/Kosrae":                 {CountryCodes: []string{"FM"}, Latitude: 5.3167, Longitude: 162.9833, Comment: "Kosrae"},
+	"Pacific/Kwajalein":              {CountryCodes: []string{"MH"}, Latitude: 9.0833, Longitude: 167.3333, Comment: "Kwajalein"},
+	"Pacific/Majuro":                 {CountryCodes: []string{"MH"}, Latitude: 7.15, Longitude: 171.2, Comment: "most of Marshall Islands"},
+	"Pacific/Marquesas":              {CountryCodes: []string{"PF"}, Latitude: -9, Longitude: -139.5, Comment: "Marquesas Islands"},
+	"Pacific/Midway":                 {CountryCodes: []string{"UM"}, Latitude: 28.2167, Longitude: -177.3667, Comment: "Midway Islands"},
+	"Pacific/Nauru":                  {CountryCodes: []string{"NR"}, Latitude: -0.5167, Longitude: 166.9167},
+	"Pacific/Niue":                   {CountryCodes: []string{"NU"}, Latitude: -19.0167, Longitude: -169.9167},
+	"Pacific/Norfolk":                {CountryCodes: []string{"NF"}, Latitude: -29.05, Longitude: 167.9667},
+	"Pacific/Noumea":                 {CountryCodes: []string{"NC"}, Latitude: -22.2667, Longitude: 166.45},
+	"Pacific/Pago_Pago":              {CountryCodes: []string{"AS", "UM"}, Latitude: -14.2667, Longitude: -170.7, Comment: "Midway"},
+	"Pacific/Palau":                  {CountryCodes: []string{"PW"}, Latitude: 7.3333, Longitude: 134.4833},
+	"Pacific/Pitcairn":               {CountryCodes: []string{"PN"}, Latitude: -25.0667, Longitude: -130.0833},
+	"Pacific/Pohnpei":                {CountryCodes: []string{"FM"}, Latitude: 6.9667, Longitude: 158.2167, Comment: "Pohnpei/Ponape"},
+	"Pacific/Port_Moresby":           {CountryCodes: []string{"PG", "AQ", "FM"}, Latitude: -9.5, Longitude: 147.1667, Comment: "most of Papua New Guinea"},
+	"Pacific/Rarotonga":              {CountryCodes: []string{"CK"}, Latitude: -21.2333, Longitude: -159.7667},
+	"Pacific/Saipan":                 {CountryCodes: []string{"MP"}, Latitude: 15.2, Longitude: 145.75},
+	"Pacific/Tahiti":                 {CountryCodes: []string{"PF"}, Latitude: -17.5333, Longitude: -149.5667, Comment: "Society Islands"},
+	"Pacific/Tarawa":                 {CountryCodes: []string{"KI", "MH", "TV", "UM", "WF"}, Latitude: 1.4167, Longitude: 173, Comment: "Gilbert Islands"},
+	"Pacific/Tongatapu":              {CountryCodes: []string{"TO"}, Latitude: -21.1333, Longitude: -175.2},
+	"Pacific/Wake":                   {CountryCodes: []string{"UM"}, Latitude: 19.2833, Longitude: 166.6167, Comment: "Wake Island"},
+	"Pacific/Wallis":                 {CountryCodes: []string{"WF"}, Latitude: -13.3, Longitude: -176.1667},
+}
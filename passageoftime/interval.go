@@ -0,0 +1,253 @@
+package passageoftime
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Interval is a half-open span of time [Start, End): it includes its start
+// instant but not its end, so back-to-back intervals do not overlap
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewInterval returns the interval from start to end, which must not precede start
+func NewInterval(start, end time.Time) (Interval, error) {
+	if end.Before(start) {
+		return Interval{}, fmt.Errorf("interval end %s is before its start %s", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// Duration returns the length of the interval
+func (iv Interval) Duration() time.Duration {
+	return iv.End.Sub(iv.Start)
+}
+
+// IsEmpty reports whether the interval has zero length
+func (iv Interval) IsEmpty() bool {
+	return !iv.End.After(iv.Start)
+}
+
+// Contains reports whether t lies within the interval
+func (iv Interval) Contains(t time.Time) bool {
+	return !t.Before(iv.Start) && t.Before(iv.End)
+}
+
+// ContainsInterval reports whether other lies entirely within the interval
+func (iv Interval) ContainsInterval(other Interval) bool {
+	return !other.Start.Before(iv.Start) && !other.End.After(iv.End)
+}
+
+// Overlaps reports whether the intervals share any instant
+func (iv Interval) Overlaps(other Interval) bool {
+	return iv.Start.Before(other.End) && other.Start.Before(iv.End)
+}
+
+// Intersect returns the overlap of two intervals, or false if they do not overlap
+func (iv Interval) Intersect(other Interval) (Interval, bool) {
+	start, end := iv.Start, iv.End
+	if other.Start.After(start) {
+		start = other.Start
+	}
+	if other.End.Before(end) {
+		end = other.End
+	}
+	if !end.After(start) {
+		return Interval{}, false
+	}
+	return Interval{Start: start, End: end}, true
+}
+
+// In returns the interval with both ends in loc
+func (iv Interval) In(loc *time.Location) Interval {
+	return Interval{Start: iv.Start.In(loc), End: iv.End.In(loc)}
+}
+
+// String renders the interval in ISO 8601 "start/end" form
+func (iv Interval) String() string {
+	return iv.Start.Format(time.RFC3339) + "/" + iv.End.Format(time.RFC3339)
+}
+
+// MergeIntervals sorts intervals and joins any that overlap or touch. Empty
+// intervals are dropped.
+func MergeIntervals(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, iv := range intervals {
+		if !iv.IsEmpty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var merged []Interval
+	for _, iv := range sorted {
+		if n := len(merged); n > 0 && !iv.Start.After(merged[n-1].End) {
+			if iv.End.After(merged[n-1].End) {
+				merged[n-1].End = iv.End
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// UnionIntervals returns the time covered by either list, merged
+func UnionIntervals(a, b []Interval) []Interval {
+	all := append(append([]Interval{}, a...), b...)
+	return MergeIntervals(all)
+}
+
+// IntersectIntervals returns the time covered by both lists
+func IntersectIntervals(a, b []Interval) []Interval {
+	a, b = MergeIntervals(a), MergeIntervals(b)
+	var result []Interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if overlap, ok := a[i].Intersect(b[j]); ok {
+			result = append(result, overlap)
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// SubtractIntervals returns the time covered by a but not by b
+func SubtractIntervals(a, b []Interval) []Interval {
+	b = MergeIntervals(b)
+	var result []Interval
+	for _, iv := range MergeIntervals(a) {
+		start := iv.Start
+		for _, cut := range b {
+			if !cut.End.After(start) {
+				continue
+			}
+			if !cut.Start.Before(iv.End) {
+				break
+			}
+			if cut.Start.After(start) {
+				result = append(result, Interval{Start: start, End: cut.Start})
+			}
+			start = cut.End
+		}
+		if iv.End.After(start) {
+			result = append(result, Interval{Start: start, End: iv.End})
+		}
+	}
+	return result
+}
+
+// FindGaps returns the parts of window not covered by any of the intervals
+func FindGaps(intervals []Interval, window Interval) []Interval {
+	return SubtractIntervals([]Interval{window}, intervals)
+}
+
+// IntervalsContain reports whether the merged intervals cover all of target,
+// returning the merged interval that does
+func IntervalsContain(intervals []Interval, target Interval) (Interval, bool) {
+	for _, iv := range MergeIntervals(intervals) {
+		if iv.ContainsInterval(target) && (!target.IsEmpty() || iv.Contains(target.Start)) {
+			return iv, true
+		}
+	}
+	return Interval{}, false
+}
+
+// TotalDuration returns the summed length of the intervals after merging
+func TotalDuration(intervals []Interval) time.Duration {
+	var total time.Duration
+	for _, iv := range MergeIntervals(intervals) {
+		total += iv.Duration()
+	}
+	return total
+}
+
+// intervalSeparator splits "start/end", "start to end", "start until end",
+// "start -- end" and "start – end" (a plain hyphen needs spaces around it)
+var intervalSeparator = regexp.MustCompile(`(?i)\s*/\s*|\s+(?:to|until|till)\s+|\s*(?:--|–|—)\s*|\s+-\s+`)
+
+// dateOnlyPattern matches a bare YYYY-MM-DD date
+var dateOnlyPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// ParsedInterval is a parsed interval together with the DST resolutions of
+// its ends
+type ParsedInterval struct {
+	Interval
+
+	// StartResolution and EndResolution describe any DST gap or overlap
+	// resolved at either end (an end given as a duration has none)
+	StartResolution LocalTimeResolution
+	EndResolution   LocalTimeResolution
+}
+
+// ParseInterval reads an interval written as "start/end" (ISO 8601), "start
+// to end", "start until end" or "start – end", with each side in any format
+// ParseFuzzyTimestamp accepts. The end may instead be a Go duration such as
+// "90m" or "1h30m", measured from the start. A bare date as the end includes
+// that whole day.
+func ParseInterval(text string, options ParseOptions) (Interval, error) {
+	parsed, err := ParseIntervalDetailed(text, options)
+	if err != nil {
+		return Interval{}, err
+	}
+	return parsed.Interval, nil
+}
+
+// ParseIntervalDetailed is ParseInterval that also reports whether either end
+// was a nonexistent or ambiguous local time
+func ParseIntervalDetailed(text string, options ParseOptions) (*ParsedInterval, error) {
+	trimmed := strings.TrimSpace(text)
+	locs := intervalSeparator.FindAllStringIndex(trimmed, -1)
+	if len(locs) == 0 {
+		return nil, fmt.Errorf("interval '%s' needs a start and an end, e.g. '2025-03-10T09:00/2025-03-10T10:00' or '9am to 10am'", text)
+	}
+
+	// Dates may contain slashes or dashes, so try each separator until both sides parse
+	var lastErr error
+	for _, loc := range locs {
+		startText, endText := trimmed[:loc[0]], trimmed[loc[1]:]
+		if startText == "" || endText == "" {
+			continue
+		}
+		start, err := ParseFuzzyTimestampDetailed(startText, options)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		parsed := &ParsedInterval{StartResolution: start.Resolution}
+		var end time.Time
+		if d, err := time.ParseDuration(strings.TrimPrefix(endText, "+")); err == nil {
+			end = start.Time.Add(d)
+		} else {
+			parsedEnd, err := ParseFuzzyTimestampDetailed(endText, options)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			end, parsed.EndResolution = parsedEnd.Time, parsedEnd.Resolution
+			if dateOnlyPattern.MatchString(endText) {
+				end = end.AddDate(0, 0, 1)
+			}
+		}
+		parsed.Interval, err = NewInterval(start.Time, end)
+		if err == nil {
+			return parsed, nil
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("invalid interval '%s': %w", text, lastErr)
+	}
+	return nil, fmt.Errorf("invalid interval '%s'", text)
+}
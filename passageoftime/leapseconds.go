@@ -0,0 +1,298 @@
+package passageoftime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeScale identifies a time scale that timestamps can be expressed in
+type TimeScale string
+
+const (
+	// ScaleUTC is Coordinated Universal Time, which inserts leap seconds
+	ScaleUTC TimeScale = "utc"
+
+	// ScaleTAI is International Atomic Time, a continuous count of SI seconds
+	ScaleTAI TimeScale = "tai"
+
+	// ScaleGPS is GPS time: TAI - 19 s, equal to UTC at the GPS epoch (6 January 1980)
+	ScaleGPS TimeScale = "gps"
+
+	// ScaleTT is Terrestrial Time: TAI + 32.184 s
+	ScaleTT TimeScale = "tt"
+)
+
+// LeapSecond is an entry of the leap second table
+type LeapSecond struct {
+	// Effective is the UTC instant from which the new offset applies (00:00:00
+	// on the day after the inserted 23:59:60)
+	Effective time.Time
+
+	// TAIMinusUTC is the offset in seconds from Effective onwards
+	TAIMinusUTC int
+}
+
+// leapSecondTable lists TAI - UTC from 1 January 1972, when UTC adopted whole
+// leap seconds, as published by the IERS in leap-seconds.list. It is updated
+// through IERS Bulletin C 70 (July 2025, file update NTP 3960835200), which
+// announced no leap second for the end of December 2025.
+var leapSecondTable = []LeapSecond{
+	{time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+	{time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 11},
+	{time.Date(1973, 1, 1, 0, 0, 0, 0, time.UTC), 12},
+	{time.Date(1974, 1, 1, 0, 0, 0, 0, time.UTC), 13},
+	{time.Date(1975, 1, 1, 0, 0, 0, 0, time.UTC), 14},
+	{time.Date(1976, 1, 1, 0, 0, 0, 0, time.UTC), 15},
+	{time.Date(1977, 1, 1, 0, 0, 0, 0, time.UTC), 16},
+	{time.Date(1978, 1, 1, 0, 0, 0, 0, time.UTC), 17},
+	{time.Date(1979, 1, 1, 0, 0, 0, 0, time.UTC), 18},
+	{time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC), 19},
+	{time.Date(1981, 7, 1, 0, 0, 0, 0, time.UTC), 20},
+	{time.Date(1982, 7, 1, 0, 0, 0, 0, time.UTC), 21},
+	{time.Date(1983, 7, 1, 0, 0, 0, 0, time.UTC), 22},
+	{time.Date(1985, 7, 1, 0, 0, 0, 0, time.UTC), 23},
+	{time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC), 24},
+	{time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), 25},
+	{time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC), 26},
+	{time.Date(1992, 7, 1, 0, 0, 0, 0, time.UTC), 27},
+	{time.Date(1993, 7, 1, 0, 0, 0, 0, time.UTC), 28},
+	{time.Date(1994, 7, 1, 0, 0, 0, 0, time.UTC), 29},
+	{time.Date(1996, 1, 1, 0, 0, 0, 0, time.UTC), 30},
+	{time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC), 31},
+	{time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), 32},
+	{time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC), 33},
+	{time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC), 34},
+	{time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC), 35},
+	{time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC), 36},
+	{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+}
+
+// LeapSecondTableExpires is when the embedded table stops being authoritative.
+// The IERS announces leap seconds about six months ahead; after this date a
+// newly announced leap second may be missing. To refresh, copy the entries
+// and the "#@" expiry of the current list at
+// https://hpiers.obspm.fr/iers/bul/bulc/ntp/leap-seconds.list and cite its
+// Bulletin C above.
+var LeapSecondTableExpires = time.Date(2026, 6, 28, 0, 0, 0, 0, time.UTC)
+
+// GPSEpoch is the start of GPS time, 6 January 1980 00:00:00 UTC
+var GPSEpoch = time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC)
+
+const (
+	gpsMinusTAI = -19 * time.Second
+	ttMinusTAI  = 32184 * time.Millisecond
+)
+
+// LeapSeconds returns a copy of the leap second table
+func LeapSeconds() []LeapSecond {
+	return append([]LeapSecond{}, leapSecondTable...)
+}
+
+// ParseTimeScale parses a time scale name (utc, tai, gps, tt)
+func ParseTimeScale(name string) (TimeScale, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "utc":
+		return ScaleUTC, nil
+	case "tai":
+		return ScaleTAI, nil
+	case "gps", "gpst":
+		return ScaleGPS, nil
+	case "tt", "tdt":
+		return ScaleTT, nil
+	}
+	return "", fmt.Errorf("invalid time scale '%s' (expected utc, tai, gps or tt)", name)
+}
+
+// TAIMinusUTC returns the leap second offset in force at the UTC instant t
+func TAIMinusUTC(t time.Time) (int, error) {
+	if t.Before(leapSecondTable[0].Effective) {
+		return 0, fmt.Errorf("leap second offsets are defined from 1972-01-01; UTC before then used fractional adjustments")
+	}
+	offset := 0
+	for _, ls := range leapSecondTable {
+		if t.Before(ls.Effective) {
+			break
+		}
+		offset = ls.TAIMinusUTC
+	}
+	return offset, nil
+}
+
+// ScaleTime is an instant expressed in a time scale. Time holds the scale's
+// clock reading with a UTC location; LeapSecond marks a UTC reading inside an
+// inserted leap second, where Time shows 23:59:59 and the true label is 23:59:60.
+type ScaleTime struct {
+	Scale      TimeScale
+	Time       time.Time
+	LeapSecond bool
+}
+
+// String renders the reading in ISO 8601 with the scale name, e.g.
+// "2017-01-01T00:00:36 TAI" or "2016-12-31T23:59:60Z"
+func (s ScaleTime) String() string {
+	layout := "2006-01-02T15:04:05.999999999"
+	text := s.Time.UTC().Format(layout)
+	if s.LeapSecond {
+		// Only the seconds field changes: 23:59:59.x becomes 23:59:60.x
+		text = strings.Replace(text, "T23:59:59", "T23:59:60", 1)
+	}
+	if s.Scale == ScaleUTC {
+		return text + "Z"
+	}
+	return text + " " + strings.ToUpper(string(s.Scale))
+}
+
+// toTAI converts a reading in scale to a TAI reading
+func toTAI(s ScaleTime) (time.Time, error) {
+	t := s.Time.UTC()
+	switch s.Scale {
+	case ScaleTAI:
+		return t, nil
+	case ScaleGPS:
+		return t.Add(-gpsMinusTAI), nil
+	case ScaleTT:
+		return t.Add(-ttMinusTAI), nil
+	case ScaleUTC:
+		offset, err := TAIMinusUTC(t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if s.LeapSecond {
+			offset++ // 23:59:60 is one second after 23:59:59 under the old offset
+		}
+		return t.Add(time.Duration(offset) * time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time scale '%s'", s.Scale)
+}
+
+// fromTAI converts a TAI reading to scale
+func fromTAI(tai time.Time, scale TimeScale) (ScaleTime, error) {
+	switch scale {
+	case ScaleTAI:
+		return ScaleTime{Scale: scale, Time: tai}, nil
+	case ScaleGPS:
+		return ScaleTime{Scale: scale, Time: tai.Add(gpsMinusTAI)}, nil
+	case ScaleTT:
+		return ScaleTime{Scale: scale, Time: tai.Add(ttMinusTAI)}, nil
+	case ScaleUTC:
+		for i := len(leapSecondTable) - 1; i >= 0; i-- {
+			ls := leapSecondTable[i]
+			offset := time.Duration(ls.TAIMinusUTC) * time.Second
+			if !tai.Before(ls.Effective.Add(offset)) {
+				return ScaleTime{Scale: scale, Time: tai.Add(-offset)}, nil
+			}
+			if i > 0 && !tai.Before(ls.Effective.Add(offset-time.Second)) {
+				// Inside the inserted second, shown as 23:59:60
+				return ScaleTime{Scale: scale, Time: tai.Add(-offset), LeapSecond: true}, nil
+			}
+		}
+		return ScaleTime{}, fmt.Errorf("TAI reading %s is before 1972-01-01 UTC, when leap second offsets begin", tai.Format(time.RFC3339))
+	}
+	return ScaleTime{}, fmt.Errorf("invalid time scale '%s'", scale)
+}
+
+// ConvertTimeScale converts a reading from one time scale to another
+func ConvertTimeScale(s ScaleTime, to TimeScale) (ScaleTime, error) {
+	tai, err := toTAI(s)
+	if err != nil {
+		return ScaleTime{}, err
+	}
+	return fromTAI(tai, to)
+}
+
+// GPSWeekSeconds splits a GPS reading into the GPS week number (not rolled
+// over at 1024) and seconds into the week
+func GPSWeekSeconds(gps time.Time) (week int, seconds float64) {
+	elapsed := gps.UTC().Sub(GPSEpoch)
+	const weekLength = 7 * 24 * time.Hour
+	week = int(elapsed / weekLength)
+	if elapsed < 0 && elapsed%weekLength != 0 {
+		week--
+	}
+	return week, (elapsed - time.Duration(week)*weekLength).Seconds()
+}
+
+// LeapSecondElapsed returns the SI seconds elapsed between two UTC instants,
+// counting inserted leap seconds that time.Time arithmetic ignores, along
+// with the leap seconds that fall between them
+func LeapSecondElapsed(from, to time.Time) (time.Duration, []LeapSecond, error) {
+	fromOffset, err := TAIMinusUTC(from)
+	if err != nil {
+		return 0, nil, err
+	}
+	toOffset, err := TAIMinusUTC(to)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lo, hi := from, to
+	if hi.Before(lo) {
+		lo, hi = hi, lo
+	}
+	var applied []LeapSecond
+	for _, ls := range leapSecondTable[1:] {
+		if ls.Effective.After(lo) && !ls.Effective.After(hi) {
+			applied = append(applied, ls)
+		}
+	}
+	return to.Sub(from) + time.Duration(toOffset-fromOffset)*time.Second, applied, nil
+}
+
+// leapSecondLabel matches a UTC reading written with second 60
+var leapSecondLabel = regexp.MustCompile(`(\d{1,2}:\d{2}):60`)
+
+// ParseScaleTime reads a clock reading in scale. The text accepts any format
+// ParseFuzzyTimestamp does; readings in TAI, GPS and TT are taken as written
+// (no timezone applies), and a GPS reading may also be a plain number of
+// seconds since the GPS epoch. A UTC reading may name a leap second as 23:59:60.
+func ParseScaleTime(text string, scale TimeScale, options ParseOptions) (ScaleTime, error) {
+	reading, _, err := ParseScaleTimeDetailed(text, scale, options)
+	return reading, err
+}
+
+// ParseScaleTimeDetailed is ParseScaleTime that also reports a DST gap or
+// overlap resolved in a UTC reading written in local time
+func ParseScaleTimeDetailed(text string, scale TimeScale, options ParseOptions) (ScaleTime, LocalTimeResolution, error) {
+	text = strings.TrimSpace(text)
+	if scale != ScaleUTC {
+		options.Timezone = "UTC"
+		if scale == ScaleGPS && unixTimestampPattern.MatchString(text) {
+			seconds, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return ScaleTime{}, LocalTimeResolution{}, fmt.Errorf("invalid GPS seconds '%s'", text)
+			}
+			return ScaleTime{Scale: scale, Time: GPSEpoch.Add(time.Duration(seconds * float64(time.Second)))}, LocalTimeResolution{}, nil
+		}
+	}
+
+	leap := false
+	if scale == ScaleUTC && leapSecondLabel.MatchString(text) {
+		text = leapSecondLabel.ReplaceAllString(text, "$1:59")
+		leap = true
+	}
+
+	parsed, err := ParseFuzzyTimestampDetailed(text, options)
+	if err != nil {
+		return ScaleTime{}, LocalTimeResolution{}, err
+	}
+	t := parsed.Time
+	if scale != ScaleUTC {
+		// The clock reading is what was written, whatever offset it carried
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	if leap {
+		t = t.UTC()
+		next := t.Truncate(time.Second).Add(time.Second)
+		found := false
+		for _, ls := range leapSecondTable[1:] {
+			found = found || ls.Effective.Equal(next)
+		}
+		if !found {
+			return ScaleTime{}, LocalTimeResolution{}, fmt.Errorf("%s is not a leap second", strings.Replace(t.Format(time.RFC3339), ":59Z", ":60Z", 1))
+		}
+	}
+	return ScaleTime{Scale: scale, Time: t.UTC(), LeapSecond: leap}, parsed.Resolution, nil
+}
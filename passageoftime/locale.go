@@ -0,0 +1,273 @@
+package passageoftime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationUnit is a calendar or clock unit used in duration phrases
+type DurationUnit string
+
+const (
+	UnitYear   DurationUnit = "year"
+	UnitMonth  DurationUnit = "month"
+	UnitWeek   DurationUnit = "week"
+	UnitDay    DurationUnit = "day"
+	UnitHour   DurationUnit = "hour"
+	UnitMinute DurationUnit = "minute"
+	UnitSecond DurationUnit = "second"
+)
+
+// UnitAmount is a count of one unit, such as 3 days
+type UnitAmount struct {
+	Unit  DurationUnit
+	Value int
+}
+
+// pluralCategory is a CLDR plural category; locales without a "few" form
+// use only one and other
+type pluralCategory int
+
+const (
+	categoryOne pluralCategory = iota
+	categoryFew
+	categoryOther
+)
+
+// pluralForms holds a unit pattern per plural category, with {0} standing for
+// the number. Empty categories fall back to other.
+type pluralForms struct {
+	one, few, other string
+}
+
+func (f pluralForms) pick(category pluralCategory) string {
+	switch {
+	case category == categoryOne && f.one != "":
+		return f.one
+	case category == categoryFew && f.few != "":
+		return f.few
+	}
+	return f.other
+}
+
+// Locale holds the names, patterns and plural rules used to render dates,
+// times and durations in one language
+type Locale struct {
+	// Tag is the BCP 47 language tag, e.g. "de" or "en-GB"
+	Tag string
+
+	// Name is the language's own name for itself
+	Name string
+
+	months [12]string
+	// monthsInDate replaces months inside dates, for languages that put the
+	// month name in another case there (empty when the same)
+	monthsInDate [12]string
+	weekdays     [7]string
+
+	// datePattern and fullPattern (with the weekday) use the placeholders
+	// {y}, {m}, {d}, {month} and {weekday}
+	datePattern string
+	fullPattern string
+
+	// timeLayout is a Go time layout with the locale's 12- or 24-hour clock
+	timeLayout string
+
+	// dateTimeJoin combines {date} and {time}
+	dateTimeJoin string
+
+	plural func(n int) pluralCategory
+	units  map[DurationUnit]pluralForms
+	// relativeUnits overrides units inside past and future phrases, for
+	// languages whose prepositions change the noun's case
+	relativeUnits map[DurationUnit]pluralForms
+
+	listSeparator string
+	past, future  string
+	// approximately wraps an inexact amount, e.g. "about {0}"
+	approximately string
+
+	now, today, tomorrow, yesterday string
+	nextWeekday, lastWeekday        [7]string
+}
+
+// variant returns a copy of l under another tag with changes applied
+func (l *Locale) variant(tag, name string, change func(*Locale)) *Locale {
+	copied := *l
+	copied.Tag, copied.Name = tag, name
+	change(&copied)
+	return &copied
+}
+
+// DefaultLocale is the locale used when none is requested
+const DefaultLocale = "en"
+
+// GetLocale returns the locale for a language tag such as "de", "pt-BR" or
+// "en_GB". A tag with an unsupported region falls back to its language; an
+// empty tag selects DefaultLocale.
+func GetLocale(tag string) (*Locale, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if normalized == "" {
+		normalized = DefaultLocale
+	}
+	language, _, _ := strings.Cut(normalized, "-")
+	var fallback *Locale
+	for _, l := range locales {
+		switch strings.ToLower(l.Tag) {
+		case normalized:
+			return l, nil
+		case language:
+			fallback = l
+		}
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, fmt.Errorf("unsupported locale '%s' (supported: %s)", tag, strings.Join(SupportedLocales(), ", "))
+}
+
+// SupportedLocales lists the tags GetLocale accepts, not counting regional
+// fallbacks
+func SupportedLocales() []string {
+	tags := make([]string, len(locales))
+	for i, l := range locales {
+		tags[i] = l.Tag
+	}
+	return tags
+}
+
+// MonthName returns the standalone name of month
+func (l *Locale) MonthName(month time.Month) string {
+	return l.months[month-1]
+}
+
+// WeekdayName returns the name of day
+func (l *Locale) WeekdayName(day time.Weekday) string {
+	return l.weekdays[day]
+}
+
+// FormatDate renders t's date in the locale's long form, e.g. "January 2, 2006"
+// or "2. Januar 2006"
+func (l *Locale) FormatDate(t time.Time) string {
+	return l.expandDate(l.datePattern, t)
+}
+
+// FormatFullDate renders t's date with the weekday, e.g. "Monday, January 2, 2006"
+func (l *Locale) FormatFullDate(t time.Time) string {
+	return l.expandDate(l.fullPattern, t)
+}
+
+// FormatTime renders t's time of day on the locale's clock, e.g. "3:04 PM" or "15:04"
+func (l *Locale) FormatTime(t time.Time) string {
+	return t.Format(l.timeLayout)
+}
+
+// FormatDateTime renders t's date and time, e.g. "January 2, 2006 at 3:04 PM"
+func (l *Locale) FormatDateTime(t time.Time) string {
+	return l.joinDateTime(l.FormatDate(t), l.FormatTime(t))
+}
+
+func (l *Locale) joinDateTime(date, clock string) string {
+	return strings.NewReplacer("{date}", date, "{time}", clock).Replace(l.dateTimeJoin)
+}
+
+func (l *Locale) expandDate(pattern string, t time.Time) string {
+	month := l.months[t.Month()-1]
+	if l.monthsInDate[t.Month()-1] != "" {
+		month = l.monthsInDate[t.Month()-1]
+	}
+	return strings.NewReplacer(
+		"{y}", strconv.Itoa(t.Year()),
+		"{m}", strconv.Itoa(int(t.Month())),
+		"{d}", strconv.Itoa(t.Day()),
+		"{month}", month,
+		"{weekday}", l.weekdays[t.Weekday()],
+	).Replace(pattern)
+}
+
+// FormatUnit renders a count of one unit, e.g. "3 days" or "3 дня"
+func (l *Locale) FormatUnit(value int, unit DurationUnit) string {
+	return l.formatUnit(value, unit, false)
+}
+
+func (l *Locale) formatUnit(value int, unit DurationUnit, relative bool) string {
+	forms, ok := l.relativeUnits[unit]
+	if !relative || !ok {
+		forms = l.units[unit]
+	}
+	return strings.ReplaceAll(forms.pick(l.plural(value)), "{0}", strconv.Itoa(value))
+}
+
+// FormatUnits renders several unit counts as a list, e.g. "3 days, 2 hours"
+func (l *Locale) FormatUnits(amounts []UnitAmount) string {
+	return l.formatUnits(amounts, false)
+}
+
+func (l *Locale) formatUnits(amounts []UnitAmount, relative bool) string {
+	parts := make([]string, len(amounts))
+	for i, a := range amounts {
+		parts[i] = l.formatUnit(a.Value, a.Unit, relative)
+	}
+	return strings.Join(parts, l.listSeparator)
+}
+
+// FormatRelative renders unit counts as a time in the past ("3 days ago") or
+// future ("in 3 days")
+func (l *Locale) FormatRelative(amounts []UnitAmount, past bool) string {
+	return l.RelativePhrase(l.formatUnits(amounts, true), past)
+}
+
+// RelativePhrase wraps an already rendered amount in the locale's past or
+// future pattern. Prefer FormatRelative, which also picks the case the
+// pattern requires.
+func (l *Locale) RelativePhrase(amount string, past bool) string {
+	pattern := l.future
+	if past {
+		pattern = l.past
+	}
+	return strings.ReplaceAll(pattern, "{0}", amount)
+}
+
+// FormatDuration is FormatDuration in the locale: the "full" style names the
+// units in the locale's language, while "compact" and "minimal" are the same
+// in every locale
+func (l *Locale) FormatDuration(seconds float64, style string, isNegative bool) string {
+	if style == "compact" || style == "minimal" {
+		return formatDuration(seconds, style, isNegative)
+	}
+	text := FormatRelativeTime(seconds, RelativeTimeOptions{Locale: l})
+	if isNegative {
+		text = "-" + text
+	}
+	return text
+}
+
+// DescribeTime is GetTimeDescription in the locale: "today", "tomorrow",
+// "next Monday" and so on within a week of now, otherwise the date, followed
+// by the time unless isDateOnly
+func (l *Locale) DescribeTime(t, now time.Time, isDateOnly bool) string {
+	daysDiff := int(t.Sub(now).Hours() / 24)
+
+	var dayDesc string
+	switch daysDiff {
+	case 0:
+		dayDesc = l.today
+	case 1:
+		dayDesc = l.tomorrow
+	case -1:
+		dayDesc = l.yesterday
+	case 2, 3, 4, 5, 6, 7:
+		dayDesc = l.nextWeekday[t.Weekday()]
+	case -7, -6, -5, -4, -3, -2:
+		dayDesc = l.lastWeekday[t.Weekday()]
+	default:
+		dayDesc = l.FormatDate(t)
+	}
+
+	if isDateOnly {
+		return dayDesc
+	}
+	return l.joinDateTime(dayDesc, l.FormatTime(t))
+}
@@ -0,0 +1,322 @@
+package passageoftime
+
+// Locale data is derived from CLDR 45 (month and weekday names, date and
+// time patterns, unit patterns and plural rules), trimmed to the forms the
+// formatting functions use.
+
+var localeEnglish = &Locale{
+	Tag:  "en",
+	Name: "English",
+	months: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	weekdays:     [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	datePattern:  "{month} {d}, {y}",
+	fullPattern:  "{weekday}, {month} {d}, {y}",
+	timeLayout:   "3:04 PM",
+	dateTimeJoin: "{date} at {time}",
+	plural:       pluralOneOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} year", other: "{0} years"},
+		UnitMonth:  {one: "{0} month", other: "{0} months"},
+		UnitWeek:   {one: "{0} week", other: "{0} weeks"},
+		UnitDay:    {one: "{0} day", other: "{0} days"},
+		UnitHour:   {one: "{0} hour", other: "{0} hours"},
+		UnitMinute: {one: "{0} minute", other: "{0} minutes"},
+		UnitSecond: {one: "{0} second", other: "{0} seconds"},
+	},
+	listSeparator: ", ",
+	past:          "{0} ago",
+	future:        "in {0}",
+	approximately: "about {0}",
+	now:           "now",
+	today:         "today",
+	tomorrow:      "tomorrow",
+	yesterday:     "yesterday",
+	nextWeekday:   [7]string{"next Sunday", "next Monday", "next Tuesday", "next Wednesday", "next Thursday", "next Friday", "next Saturday"},
+	lastWeekday:   [7]string{"last Sunday", "last Monday", "last Tuesday", "last Wednesday", "last Thursday", "last Friday", "last Saturday"},
+}
+
+// localeBritishEnglish differs from English in day-month order and the 24-hour clock
+var localeBritishEnglish = localeEnglish.variant("en-GB", "English (United Kingdom)", func(l *Locale) {
+	l.datePattern = "{d} {month} {y}"
+	l.fullPattern = "{weekday} {d} {month} {y}"
+	l.timeLayout = "15:04"
+})
+
+var localeGerman = &Locale{
+	Tag:  "de",
+	Name: "Deutsch",
+	months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember"},
+	weekdays:     [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	datePattern:  "{d}. {month} {y}",
+	fullPattern:  "{weekday}, {d}. {month} {y}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} um {time}",
+	plural:       pluralOneOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} Jahr", other: "{0} Jahre"},
+		UnitMonth:  {one: "{0} Monat", other: "{0} Monate"},
+		UnitWeek:   {one: "{0} Woche", other: "{0} Wochen"},
+		UnitDay:    {one: "{0} Tag", other: "{0} Tage"},
+		UnitHour:   {one: "{0} Stunde", other: "{0} Stunden"},
+		UnitMinute: {one: "{0} Minute", other: "{0} Minuten"},
+		UnitSecond: {one: "{0} Sekunde", other: "{0} Sekunden"},
+	},
+	// "vor" and "in" take the dative
+	relativeUnits: map[DurationUnit]pluralForms{
+		UnitYear:  {one: "{0} Jahr", other: "{0} Jahren"},
+		UnitMonth: {one: "{0} Monat", other: "{0} Monaten"},
+		UnitDay:   {one: "{0} Tag", other: "{0} Tagen"},
+	},
+	listSeparator: ", ",
+	past:          "vor {0}",
+	future:        "in {0}",
+	approximately: "etwa {0}",
+	now:           "jetzt",
+	today:         "heute",
+	tomorrow:      "morgen",
+	yesterday:     "gestern",
+	nextWeekday:   [7]string{"nächsten Sonntag", "nächsten Montag", "nächsten Dienstag", "nächsten Mittwoch", "nächsten Donnerstag", "nächsten Freitag", "nächsten Samstag"},
+	lastWeekday:   [7]string{"letzten Sonntag", "letzten Montag", "letzten Dienstag", "letzten Mittwoch", "letzten Donnerstag", "letzten Freitag", "letzten Samstag"},
+}
+
+var localeFrench = &Locale{
+	Tag:  "fr",
+	Name: "Français",
+	months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	weekdays:     [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	datePattern:  "{d} {month} {y}",
+	fullPattern:  "{weekday} {d} {month} {y}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} à {time}",
+	plural:       pluralZeroOneOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} an", other: "{0} ans"},
+		UnitMonth:  {one: "{0} mois", other: "{0} mois"},
+		UnitWeek:   {one: "{0} semaine", other: "{0} semaines"},
+		UnitDay:    {one: "{0} jour", other: "{0} jours"},
+		UnitHour:   {one: "{0} heure", other: "{0} heures"},
+		UnitMinute: {one: "{0} minute", other: "{0} minutes"},
+		UnitSecond: {one: "{0} seconde", other: "{0} secondes"},
+	},
+	listSeparator: ", ",
+	past:          "il y a {0}",
+	future:        "dans {0}",
+	approximately: "environ {0}",
+	now:           "maintenant",
+	today:         "aujourd’hui",
+	tomorrow:      "demain",
+	yesterday:     "hier",
+	nextWeekday:   [7]string{"dimanche prochain", "lundi prochain", "mardi prochain", "mercredi prochain", "jeudi prochain", "vendredi prochain", "samedi prochain"},
+	lastWeekday:   [7]string{"dimanche dernier", "lundi dernier", "mardi dernier", "mercredi dernier", "jeudi dernier", "vendredi dernier", "samedi dernier"},
+}
+
+var localeSpanish = &Locale{
+	Tag:  "es",
+	Name: "Español",
+	months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	weekdays:     [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	datePattern:  "{d} de {month} de {y}",
+	fullPattern:  "{weekday}, {d} de {month} de {y}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date}, {time}",
+	plural:       pluralOneOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} año", other: "{0} años"},
+		UnitMonth:  {one: "{0} mes", other: "{0} meses"},
+		UnitWeek:   {one: "{0} semana", other: "{0} semanas"},
+		UnitDay:    {one: "{0} día", other: "{0} días"},
+		UnitHour:   {one: "{0} hora", other: "{0} horas"},
+		UnitMinute: {one: "{0} minuto", other: "{0} minutos"},
+		UnitSecond: {one: "{0} segundo", other: "{0} segundos"},
+	},
+	listSeparator: ", ",
+	past:          "hace {0}",
+	future:        "dentro de {0}",
+	approximately: "aproximadamente {0}",
+	now:           "ahora",
+	today:         "hoy",
+	tomorrow:      "mañana",
+	yesterday:     "ayer",
+	nextWeekday:   [7]string{"el próximo domingo", "el próximo lunes", "el próximo martes", "el próximo miércoles", "el próximo jueves", "el próximo viernes", "el próximo sábado"},
+	lastWeekday:   [7]string{"el domingo pasado", "el lunes pasado", "el martes pasado", "el miércoles pasado", "el jueves pasado", "el viernes pasado", "el sábado pasado"},
+}
+
+var localePortuguese = &Locale{
+	Tag:  "pt",
+	Name: "Português",
+	months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+		"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	weekdays:     [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	datePattern:  "{d} de {month} de {y}",
+	fullPattern:  "{weekday}, {d} de {month} de {y}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} às {time}",
+	plural:       pluralZeroOneOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} ano", other: "{0} anos"},
+		UnitMonth:  {one: "{0} mês", other: "{0} meses"},
+		UnitWeek:   {one: "{0} semana", other: "{0} semanas"},
+		UnitDay:    {one: "{0} dia", other: "{0} dias"},
+		UnitHour:   {one: "{0} hora", other: "{0} horas"},
+		UnitMinute: {one: "{0} minuto", other: "{0} minutos"},
+		UnitSecond: {one: "{0} segundo", other: "{0} segundos"},
+	},
+	listSeparator: ", ",
+	past:          "há {0}",
+	future:        "em {0}",
+	approximately: "cerca de {0}",
+	now:           "agora",
+	today:         "hoje",
+	tomorrow:      "amanhã",
+	yesterday:     "ontem",
+	nextWeekday:   [7]string{"próximo domingo", "próxima segunda-feira", "próxima terça-feira", "próxima quarta-feira", "próxima quinta-feira", "próxima sexta-feira", "próximo sábado"},
+	lastWeekday:   [7]string{"domingo passado", "segunda-feira passada", "terça-feira passada", "quarta-feira passada", "quinta-feira passada", "sexta-feira passada", "sábado passado"},
+}
+
+var localeRussian = &Locale{
+	Tag:  "ru",
+	Name: "Русский",
+	months: [12]string{"январь", "февраль", "март", "апрель", "май", "июнь",
+		"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	// Dates use the genitive: "1 марта 2025 г."
+	monthsInDate: [12]string{"января", "февраля", "марта", "апреля", "мая", "июня",
+		"июля", "августа", "сентября", "октября", "ноября", "декабря"},
+	weekdays:     [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+	datePattern:  "{d} {month} {y} г.",
+	fullPattern:  "{weekday}, {d} {month} {y} г.",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} в {time}",
+	plural:       pluralRussian,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {one: "{0} год", few: "{0} года", other: "{0} лет"},
+		UnitMonth:  {one: "{0} месяц", few: "{0} месяца", other: "{0} месяцев"},
+		UnitWeek:   {one: "{0} неделя", few: "{0} недели", other: "{0} недель"},
+		UnitDay:    {one: "{0} день", few: "{0} дня", other: "{0} дней"},
+		UnitHour:   {one: "{0} час", few: "{0} часа", other: "{0} часов"},
+		UnitMinute: {one: "{0} минута", few: "{0} минуты", other: "{0} минут"},
+		UnitSecond: {one: "{0} секунда", few: "{0} секунды", other: "{0} секунд"},
+	},
+	// "назад" and "через" take the accusative, which differs for feminine nouns
+	relativeUnits: map[DurationUnit]pluralForms{
+		UnitWeek:   {one: "{0} неделю", few: "{0} недели", other: "{0} недель"},
+		UnitMinute: {one: "{0} минуту", few: "{0} минуты", other: "{0} минут"},
+		UnitSecond: {one: "{0} секунду", few: "{0} секунды", other: "{0} секунд"},
+	},
+	listSeparator: ", ",
+	past:          "{0} назад",
+	future:        "через {0}",
+	approximately: "примерно {0}",
+	now:           "сейчас",
+	today:         "сегодня",
+	tomorrow:      "завтра",
+	yesterday:     "вчера",
+	nextWeekday:   [7]string{"в следующее воскресенье", "в следующий понедельник", "в следующий вторник", "в следующую среду", "в следующий четверг", "в следующую пятницу", "в следующую субботу"},
+	lastWeekday:   [7]string{"в прошлое воскресенье", "в прошлый понедельник", "в прошлый вторник", "в прошлую среду", "в прошлый четверг", "в прошлую пятницу", "в прошлую субботу"},
+}
+
+var localeChinese = &Locale{
+	Tag:          "zh",
+	Name:         "中文",
+	months:       [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+	weekdays:     [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+	datePattern:  "{y}年{m}月{d}日",
+	fullPattern:  "{y}年{m}月{d}日{weekday}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} {time}",
+	plural:       pluralOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {other: "{0}年"},
+		UnitMonth:  {other: "{0}个月"},
+		UnitWeek:   {other: "{0}周"},
+		UnitDay:    {other: "{0}天"},
+		UnitHour:   {other: "{0}小时"},
+		UnitMinute: {other: "{0}分钟"},
+		UnitSecond: {other: "{0}秒"},
+	},
+	listSeparator: "",
+	past:          "{0}前",
+	future:        "{0}后",
+	approximately: "大约{0}",
+	now:           "现在",
+	today:         "今天",
+	tomorrow:      "明天",
+	yesterday:     "昨天",
+	nextWeekday:   [7]string{"下周日", "下周一", "下周二", "下周三", "下周四", "下周五", "下周六"},
+	lastWeekday:   [7]string{"上周日", "上周一", "上周二", "上周三", "上周四", "上周五", "上周六"},
+}
+
+var localeJapanese = &Locale{
+	Tag:          "ja",
+	Name:         "日本語",
+	months:       [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	weekdays:     [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	datePattern:  "{y}年{m}月{d}日",
+	fullPattern:  "{y}年{m}月{d}日{weekday}",
+	timeLayout:   "15:04",
+	dateTimeJoin: "{date} {time}",
+	plural:       pluralOther,
+	units: map[DurationUnit]pluralForms{
+		UnitYear:   {other: "{0} 年"},
+		UnitMonth:  {other: "{0} か月"},
+		UnitWeek:   {other: "{0} 週間"},
+		UnitDay:    {other: "{0} 日"},
+		UnitHour:   {other: "{0} 時間"},
+		UnitMinute: {other: "{0} 分"},
+		UnitSecond: {other: "{0} 秒"},
+	},
+	listSeparator: " ",
+	past:          "{0}前",
+	future:        "{0}後",
+	approximately: "約{0}",
+	now:           "今",
+	today:         "今日",
+	tomorrow:      "明日",
+	yesterday:     "昨日",
+	nextWeekday:   [7]string{"来週の日曜日", "来週の月曜日", "来週の火曜日", "来週の水曜日", "来週の木曜日", "来週の金曜日", "来週の土曜日"},
+	lastWeekday:   [7]string{"先週の日曜日", "先週の月曜日", "先週の火曜日", "先週の水曜日", "先週の木曜日", "先週の金曜日", "先週の土曜日"},
+}
+
+// locales lists the supported locales; GetLocale falls back from a regional
+// tag such as "de-AT" to its language
+var locales = []*Locale{
+	localeEnglish, localeBritishEnglish, localeGerman, localeFrench, localeSpanish,
+	localePortuguese, localeRussian, localeChinese, localeJapanese,
+}
+
+// pluralOneOther is the rule for English, German and Spanish: one for exactly 1
+func pluralOneOther(n int) pluralCategory {
+	if n == 1 {
+		return categoryOne
+	}
+	return categoryOther
+}
+
+// pluralZeroOneOther is the rule for French and Portuguese: one for 0 and 1
+func pluralZeroOneOther(n int) pluralCategory {
+	if n == 0 || n == 1 {
+		return categoryOne
+	}
+	return categoryOther
+}
+
+// pluralRussian is the Russian rule: one for 1, 21, 31...; few for 2-4, 22-24...;
+// many (stored as other) for the rest
+func pluralRussian(n int) pluralCategory {
+	switch mod10, mod100 := n%10, n%100; {
+	case mod10 == 1 && mod100 != 11:
+		return categoryOne
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return categoryFew
+	default:
+		return categoryOther
+	}
+}
+
+// pluralOther is the rule for Chinese and Japanese, which do not inflect for number
+func pluralOther(int) pluralCategory {
+	return categoryOther
+}
@@ -0,0 +1,133 @@
+package passageoftime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// NonexistentTimePolicy decides how a local time skipped by a DST gap is resolved
+type NonexistentTimePolicy string
+
+const (
+	// NonexistentShiftForward moves the time forward by the length of the gap
+	// (2025-03-09 02:30 America/New_York becomes 03:30 EDT). This is the default.
+	NonexistentShiftForward NonexistentTimePolicy = "shift_forward"
+
+	// NonexistentShiftBack moves the time back by the length of the gap
+	// (2025-03-09 02:30 America/New_York becomes 01:30 EST)
+	NonexistentShiftBack NonexistentTimePolicy = "shift_back"
+
+	// NonexistentError rejects the time
+	NonexistentError NonexistentTimePolicy = "error"
+)
+
+// AmbiguousTimePolicy decides how a local time repeated by a DST overlap is resolved
+type AmbiguousTimePolicy string
+
+const (
+	// AmbiguousEarlier picks the first occurrence (still on daylight time). This is the default.
+	AmbiguousEarlier AmbiguousTimePolicy = "earlier"
+
+	// AmbiguousLater picks the second occurrence (already on standard time)
+	AmbiguousLater AmbiguousTimePolicy = "later"
+
+	// AmbiguousError rejects the time
+	AmbiguousError AmbiguousTimePolicy = "error"
+)
+
+// LocalTimeResolution records how a wall-clock time was mapped onto an instant
+type LocalTimeResolution struct {
+	// Nonexistent is true when the wall-clock time fell into a DST gap
+	Nonexistent bool
+
+	// Ambiguous is true when the wall-clock time occurred twice (DST overlap)
+	Ambiguous bool
+
+	// Note explains the adjustment in plain English (empty when neither flag is set)
+	Note string
+}
+
+// ParsedTimestamp is a parsed instant together with its DST resolution details
+type ParsedTimestamp struct {
+	// Time is the resolved instant
+	Time time.Time
+
+	// Resolution describes any DST gap or overlap that was resolved
+	Resolution LocalTimeResolution
+}
+
+// ParseNonexistentTimePolicy validates a policy name; an empty name selects the default
+func ParseNonexistentTimePolicy(name string) (NonexistentTimePolicy, error) {
+	switch policy := NonexistentTimePolicy(strings.ToLower(strings.TrimSpace(name))); policy {
+	case "":
+		return NonexistentShiftForward, nil
+	case NonexistentShiftForward, NonexistentShiftBack, NonexistentError:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid nonexistent time policy '%s' (expected shift_forward, shift_back, or error)", name)
+}
+
+// ParseAmbiguousTimePolicy validates a policy name; an empty name selects the default
+func ParseAmbiguousTimePolicy(name string) (AmbiguousTimePolicy, error) {
+	switch policy := AmbiguousTimePolicy(strings.ToLower(strings.TrimSpace(name))); policy {
+	case "":
+		return AmbiguousEarlier, nil
+	case AmbiguousEarlier, AmbiguousLater, AmbiguousError:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid ambiguous time policy '%s' (expected earlier, later, or error)", name)
+}
+
+// ResolveLocalTime maps the wall-clock reading of wall (its location is ignored)
+// onto an instant in loc, applying the DST policies from options.
+func ResolveLocalTime(wall time.Time, loc *time.Location, options ParseOptions) (time.Time, LocalTimeResolution, error) {
+	y, mo, d := wall.Date()
+	h, mi, s := wall.Clock()
+	ns := wall.Nanosecond()
+	const layout = "2006-01-02 15:04:05"
+	written := time.Date(y, mo, d, h, mi, s, ns, time.UTC)
+
+	instants := internal.LocalInstants(y, mo, d, h, mi, s, ns, loc)
+	switch len(instants) {
+	case 1:
+		return instants[0], LocalTimeResolution{}, nil
+
+	case 2:
+		earlier, later := instants[0], instants[1]
+		res := LocalTimeResolution{Ambiguous: true}
+		switch options.AmbiguousTime {
+		case AmbiguousError:
+			return time.Time{}, res, fmt.Errorf("local time %s is ambiguous in %s: it occurs at %s and again at %s (DST overlap)",
+				written.Format(layout), loc, earlier.Format("15:04:05 MST"), later.Format("15:04:05 MST"))
+		case AmbiguousLater:
+			res.Note = fmt.Sprintf("%s occurs twice in %s (DST overlap); using the later occurrence %s",
+				written.Format(layout), loc, later.Format("15:04:05 MST"))
+			return later, res, nil
+		default:
+			res.Note = fmt.Sprintf("%s occurs twice in %s (DST overlap); using the earlier occurrence %s",
+				written.Format(layout), loc, earlier.Format("15:04:05 MST"))
+			return earlier, res, nil
+		}
+	}
+
+	// No instant shows this wall-clock reading: it lies in a DST gap
+	before, after := internal.OffsetsAround(y, mo, d, h, mi, s, ns, loc)
+	res := LocalTimeResolution{Nonexistent: true}
+	switch options.NonexistentTime {
+	case NonexistentError:
+		return time.Time{}, res, fmt.Errorf("local time %s does not exist in %s (skipped by a DST gap)", written.Format(layout), loc)
+	case NonexistentShiftBack:
+		t := written.Add(-time.Duration(after) * time.Second).In(loc)
+		res.Note = fmt.Sprintf("%s does not exist in %s (DST gap); shifted back to %s",
+			written.Format(layout), loc, t.Format(layout+" MST"))
+		return t, res, nil
+	default:
+		t := written.Add(-time.Duration(before) * time.Second).In(loc)
+		res.Note = fmt.Sprintf("%s does not exist in %s (DST gap); shifted forward to %s",
+			written.Format(layout), loc, t.Format(layout+" MST"))
+		return t, res, nil
+	}
+}
@@ -0,0 +1,300 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Participant describes a meeting attendee's timezone and working hours
+type Participant struct {
+	// Name identifies the participant in results (defaults to the timezone)
+	Name string
+
+	// Timezone is the participant's IANA timezone identifier
+	Timezone string
+
+	// WorkStart is the local start of the working day as "HH:MM" (default "09:00")
+	WorkStart string
+
+	// WorkEnd is the local end of the working day as "HH:MM" (default "17:00").
+	// An end before the start describes a window that crosses midnight.
+	WorkEnd string
+
+	// WorkDays lists the working weekdays (default Monday through Friday)
+	WorkDays []time.Weekday
+
+	// Busy lists times the participant is unavailable (used by FindFreeSlots)
+	Busy []Interval
+}
+
+// MeetingOptions controls the meeting time search
+type MeetingOptions struct {
+	// Participants are the attendees to accommodate
+	Participants []Participant
+
+	// Start and End bound the search window
+	Start time.Time
+	End   time.Time
+
+	// Duration is the meeting length
+	Duration time.Duration
+
+	// Step is the spacing between candidate start times (default 30 minutes)
+	Step time.Duration
+
+	// MaxResults caps the number of ranked slots returned (default 10)
+	MaxResults int
+
+	// RequireAll drops slots where anyone is outside working hours
+	RequireAll bool
+}
+
+// ParticipantTime is one participant's view of a candidate slot
+type ParticipantTime struct {
+	Name        string
+	Timezone    string
+	LocalStart  time.Time
+	LocalEnd    time.Time
+	WithinHours bool
+
+	// IsWeekend is true when the slot starts on a day outside WorkDays and
+	// outside a shift that began on a work day
+	IsWeekend bool
+}
+
+// MeetingSlot is a ranked candidate meeting time
+type MeetingSlot struct {
+	Start time.Time
+	End   time.Time
+
+	// Score ranks slots from 0 (nobody comfortable) to 1 (everyone mid-day)
+	Score float64
+
+	// WithinHoursCount is the number of participants inside their working hours
+	WithinHoursCount int
+
+	// Participants holds each attendee's local view of the slot
+	Participants []ParticipantTime
+
+	// Flags explains why the slot is not ideal (outside hours, weekend)
+	Flags []string
+}
+
+// workSchedule is a participant with parsed working hours
+type workSchedule struct {
+	name     string
+	loc      *time.Location
+	start    int // minutes after local midnight
+	end      int // minutes after local midnight, > start (may exceed 1440)
+	workDays map[time.Weekday]bool
+}
+
+// FindMeetingTimes returns candidate slots for a meeting, ranked by how many
+// participants are within working hours and how close to the middle of their
+// working day the slot falls.
+func FindMeetingTimes(options MeetingOptions) ([]MeetingSlot, error) {
+	if len(options.Participants) == 0 {
+		return nil, fmt.Errorf("at least one participant is required")
+	}
+	if options.Duration <= 0 {
+		return nil, fmt.Errorf("meeting duration must be positive")
+	}
+	if !options.End.After(options.Start) {
+		return nil, fmt.Errorf("search window end must be after its start")
+	}
+
+	step := options.Step
+	if step <= 0 {
+		step = 30 * time.Minute
+	}
+	maxResults := options.MaxResults
+	if maxResults <= 0 {
+		maxResults = 10
+	}
+
+	schedules := make([]workSchedule, len(options.Participants))
+	for i, p := range options.Participants {
+		ws, err := newWorkSchedule(p)
+		if err != nil {
+			return nil, err
+		}
+		schedules[i] = ws
+	}
+
+	// Align candidates to the step on the requester's wall clock so results
+	// read as :00 and :30 rather than whatever second the search window
+	// happened to start at
+	first := alignWallClock(options.Start, step)
+
+	var slots []MeetingSlot
+	for start := first; !start.Add(options.Duration).After(options.End); start = start.Add(step) {
+		slot := evaluateSlot(start, start.Add(options.Duration), schedules)
+		if options.RequireAll && slot.WithinHoursCount < len(schedules) {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].WithinHoursCount != slots[j].WithinHoursCount {
+			return slots[i].WithinHoursCount > slots[j].WithinHoursCount
+		}
+		if slots[i].Score != slots[j].Score {
+			return slots[i].Score > slots[j].Score
+		}
+		return slots[i].Start.Before(slots[j].Start)
+	})
+
+	if len(slots) > maxResults {
+		slots = slots[:maxResults]
+	}
+	return slots, nil
+}
+
+func evaluateSlot(start, end time.Time, schedules []workSchedule) MeetingSlot {
+	slot := MeetingSlot{Start: start, End: end}
+
+	var comfort float64
+	for _, ws := range schedules {
+		localStart := start.In(ws.loc)
+		weekday := localStart.Weekday()
+
+		// A slot inside a window lies on that window's work day, even when an
+		// overnight shift carries it past midnight into a day off
+		c, within := ws.comfort(start, end)
+		isWeekend := !within && !ws.workDays[weekday]
+		comfort += c
+		if within {
+			slot.WithinHoursCount++
+		} else {
+			slot.Flags = append(slot.Flags, fmt.Sprintf("%s outside working hours (%s)", ws.name, localStart.Format("Mon 15:04")))
+		}
+		if isWeekend {
+			slot.Flags = append(slot.Flags, fmt.Sprintf("%s on a day off (%s)", ws.name, weekday))
+		}
+
+		slot.Participants = append(slot.Participants, ParticipantTime{
+			Name:        ws.name,
+			Timezone:    ws.loc.String(),
+			LocalStart:  localStart,
+			LocalEnd:    end.In(ws.loc),
+			WithinHours: within,
+			IsWeekend:   isWeekend,
+		})
+	}
+
+	slot.Score = math.Round(comfort/float64(len(schedules))*1000) / 1000
+	return slot
+}
+
+func newWorkSchedule(p Participant) (workSchedule, error) {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return workSchedule{}, fmt.Errorf("invalid timezone for participant '%s': %w", p.Name, err)
+	}
+
+	name := p.Name
+	if name == "" {
+		name = p.Timezone
+	}
+
+	startText, endText := p.WorkStart, p.WorkEnd
+	if startText == "" {
+		startText = "09:00"
+	}
+	if endText == "" {
+		endText = "17:00"
+	}
+	start, err := ParseClock(startText)
+	if err != nil {
+		return workSchedule{}, fmt.Errorf("invalid work start for '%s': %w", name, err)
+	}
+	end, err := ParseClock(endText)
+	if err != nil {
+		return workSchedule{}, fmt.Errorf("invalid work end for '%s': %w", name, err)
+	}
+	if end <= start {
+		end += 24 * 60
+	}
+
+	days := p.WorkDays
+	if len(days) == 0 {
+		days = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	workDays := make(map[time.Weekday]bool, len(days))
+	for _, d := range days {
+		workDays[d] = true
+	}
+
+	return workSchedule{name: name, loc: loc, start: start, end: end, workDays: workDays}, nil
+}
+
+// comfort scores an interval for this participant: 1 at the middle of the
+// working window falling to 0.5 at its edges, and 0 outside working hours.
+func (ws workSchedule) comfort(start, end time.Time) (float64, bool) {
+	localStart := start.In(ws.loc)
+	midnight := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, ws.loc)
+
+	// A window that crosses midnight may have opened on the previous day
+	for _, dayOffset := range []int{0, -1} {
+		day := midnight.AddDate(0, 0, dayOffset)
+		if !ws.workDays[day.Weekday()] {
+			continue
+		}
+
+		windowStart := clockOnDay(day, ws.start, ws.loc)
+		windowEnd := clockOnDay(day, ws.end, ws.loc)
+		if start.Before(windowStart) || end.After(windowEnd) {
+			continue
+		}
+
+		half := windowEnd.Sub(windowStart) / 2
+		center := windowStart.Add(half)
+		mid := start.Add(end.Sub(start) / 2)
+		distance := math.Abs(float64(mid.Sub(center)))
+		return 1 - 0.5*distance/float64(half), true
+	}
+	return 0, false
+}
+
+// clockOnDay returns the instant minutes after local midnight of day (minutes may exceed a day)
+func clockOnDay(day time.Time, minutes int, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day()+minutes/(24*60), 0, minutes%(24*60), 0, 0, loc)
+}
+
+// ParseClock parses a wall-clock time such as "09:00", "9:30" or "17:45:00"
+// and returns the number of minutes after midnight. "24:00" is accepted as end of day.
+func ParseClock(text string) (int, error) {
+	parts := strings.Split(strings.TrimSpace(text), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid clock time '%s', expected HH:MM", text)
+	}
+
+	hour, errHour := strconv.Atoi(parts[0])
+	minute, errMinute := strconv.Atoi(parts[1])
+	if errHour != nil || errMinute != nil || hour < 0 || minute < 0 || minute > 59 || hour > 24 || hour == 24 && minute != 0 {
+		return 0, fmt.Errorf("invalid clock time '%s', expected HH:MM", text)
+	}
+	if len(parts) == 3 {
+		if second, err := strconv.Atoi(parts[2]); err != nil || second < 0 || second > 59 {
+			return 0, fmt.Errorf("invalid clock time '%s', expected HH:MM", text)
+		}
+	}
+	return hour*60 + minute, nil
+}
+
+// ParseWeekday parses an English weekday name or abbreviation ("Monday", "mon")
+func ParseWeekday(text string) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(text))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || len(name) >= 3 && strings.HasPrefix(full, name) {
+			return d, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid weekday '%s'", text)
+}
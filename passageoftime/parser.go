@@ -19,6 +19,17 @@ import (
 // 3. NLP parsing (when library) - handles natural language
 // 4. Fallback - existing strict parsing
 func ParseFuzzyTimestamp(input string, options ParseOptions) (time.Time, error) {
+	parsed, err := ParseFuzzyTimestampDetailed(input, options)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.Time, nil
+}
+
+// ParseFuzzyTimestampDetailed runs the same 4-layer chain as ParseFuzzyTimestamp and
+// also reports whether a written local time was nonexistent or ambiguous because of
+// DST, resolving it according to options.NonexistentTime and options.AmbiguousTime
+func ParseFuzzyTimestampDetailed(input string, options ParseOptions) (*ParsedTimestamp, error) {
 	// Load timezone for context
 	loc, err := time.LoadLocation(options.Timezone)
 	if err != nil {
@@ -31,26 +42,49 @@ func ParseFuzzyTimestamp(input string, options ParseOptions) (time.Time, error)
 	// Layer 1: Try duration parsing first (handles relative durations like "-14d", "2h30m")
 	if options.EnableFuzzyParsing {
 		if parsed, err := parseDurationRelative(input, refInTz); err == nil {
-			return parsed, nil
+			return &ParsedTimestamp{Time: parsed}, nil
 		}
 	}
 	
 	// Layer 2: Try dateparse (handles standard timestamp formats efficiently)
 	if parsed, err := dateparse.ParseIn(input, loc); err == nil {
-		return parsed, nil
+		return resolveDateparseResult(input, parsed, loc, options)
 	}
 	
 	// Layer 3: Try NLP parsing if enabled (handles natural language)
 	if options.EnableFuzzyParsing {
 		if parsed, err := parseWithWhenLibrary(input, refInTz, loc); err == nil {
-			return parsed, nil
+			return &ParsedTimestamp{Time: parsed}, nil
 		}
 	}
 	
 	// Layer 4: Final fallback to existing strict parsing
-	return ParseTimestamp(input, options)
+	return parseTimestampDetailed(input, options)
+}
+
+// resolveDateparseResult re-resolves a dateparse result written as a plain local
+// time, because dateparse lets time.Date normalize DST gaps and overlaps silently
+func resolveDateparseResult(input string, parsed time.Time, loc *time.Location, options ParseOptions) (*ParsedTimestamp, error) {
+	// Explicit offsets and unix timestamps identify an instant on their own
+	if parsed.Location() != loc || loc == time.UTC || unixTimestampPattern.MatchString(strings.TrimSpace(input)) {
+		return &ParsedTimestamp{Time: parsed}, nil
+	}
+	
+	// Parsing in UTC keeps the wall-clock fields exactly as written
+	wall, err := dateparse.ParseIn(input, time.UTC)
+	if err != nil || wall.Location() != time.UTC {
+		return &ParsedTimestamp{Time: parsed}, nil
+	}
+	
+	t, resolution, err := ResolveLocalTime(wall, loc, options)
+	if err != nil {
+		return nil, err
+	}
+	return &ParsedTimestamp{Time: t, Resolution: resolution}, nil
 }
 
+var unixTimestampPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
 // parseWithWhenLibrary uses the when library for natural language parsing
 func parseWithWhenLibrary(input string, referenceTime time.Time, loc *time.Location) (time.Time, error) {
 	// Try compound duration parsing first (e.g. "3 days and 2 hours ago")
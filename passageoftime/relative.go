@@ -0,0 +1,213 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// durationUnits lists the units from largest to smallest with their length
+// in seconds. Months and years are Gregorian averages (30.436875 and
+// 365.2425 days), since a bare duration has no calendar position.
+var durationUnits = []struct {
+	unit    DurationUnit
+	seconds int64
+	symbol  string
+}{
+	{UnitYear, 31556952, "y"},
+	{UnitMonth, 2629746, "mo"},
+	{UnitWeek, 604800, "w"},
+	{UnitDay, 86400, "d"},
+	{UnitHour, 3600, "h"},
+	{UnitMinute, 60, "m"},
+	{UnitSecond, 1, "s"},
+}
+
+// unitIndex returns the position of unit in durationUnits, or -1
+func unitIndex(unit DurationUnit) int {
+	for i, u := range durationUnits {
+		if u.unit == unit {
+			return i
+		}
+	}
+	return -1
+}
+
+// CompareDurationUnits returns a positive number when a is longer than b,
+// a negative one when shorter and 0 when they are the same unit
+func CompareDurationUnits(a, b DurationUnit) int {
+	return unitIndex(b) - unitIndex(a)
+}
+
+// ParseDurationUnit parses a unit name such as "hours", "hour", "h" or "weeks"
+func ParseDurationUnit(name string) (DurationUnit, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "year", "years", "y", "yr", "yrs":
+		return UnitYear, nil
+	case "month", "months", "mo", "mon", "mons":
+		return UnitMonth, nil
+	case "week", "weeks", "w", "wk", "wks":
+		return UnitWeek, nil
+	case "day", "days", "d":
+		return UnitDay, nil
+	case "hour", "hours", "h", "hr", "hrs":
+		return UnitHour, nil
+	case "minute", "minutes", "m", "min", "mins":
+		return UnitMinute, nil
+	case "second", "seconds", "s", "sec", "secs":
+		return UnitSecond, nil
+	}
+	return "", fmt.Errorf("invalid unit '%s' (expected years, months, weeks, days, hours, minutes or seconds)", name)
+}
+
+// roundCount rounds x, a number of units, to a whole number in mode
+func roundCount(x float64, mode RoundMode) float64 {
+	switch mode {
+	case RoundNearest:
+		return math.Floor(x + 0.5)
+	case RoundCeil:
+		return math.Ceil(x)
+	}
+	return math.Floor(x)
+}
+
+// Phrasing selects how the direction of a duration is shown
+type Phrasing string
+
+const (
+	// PhrasingSigned prefixes negative durations with "-": "-3 days"
+	PhrasingSigned Phrasing = "signed"
+	// PhrasingRelative says "3 days ago" for negative and "in 3 days" for
+	// positive durations, and "now" for zero
+	PhrasingRelative Phrasing = "relative"
+)
+
+// ParsePhrasing parses "signed" or "relative" (also "ago"); an empty name
+// selects PhrasingSigned
+func ParsePhrasing(name string) (Phrasing, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "signed":
+		return PhrasingSigned, nil
+	case "relative", "ago", "ago_in":
+		return PhrasingRelative, nil
+	}
+	return "", fmt.Errorf("invalid phrasing '%s' (expected signed or relative)", name)
+}
+
+// RelativeTimeOptions controls FormatRelativeTime. The zero value renders
+// days down to seconds, every non-zero unit, truncated, with a "-" sign for
+// negative durations, as FormatDuration's "full" style does.
+type RelativeTimeOptions struct {
+	// MaxUnits limits how many units are shown, counted from the largest
+	// non-zero one (0 shows all)
+	MaxUnits int
+
+	// Rounding treats what is left below the smallest shown unit (default
+	// RoundFloor, which drops it)
+	Rounding RoundMode
+
+	// LargestUnit and SmallestUnit bound the units used (default days and seconds)
+	LargestUnit  DurationUnit
+	SmallestUnit DurationUnit
+
+	// Phrasing is signed ("-3 days") or relative ("3 days ago") (default signed)
+	Phrasing Phrasing
+
+	// Approximate prefixes "about" when the text is not exact, because of
+	// rounding or units left out
+	Approximate bool
+
+	// Short uses unit symbols ("3d 2h") instead of words
+	Short bool
+
+	// Locale is the language of the words (default English)
+	Locale *Locale
+}
+
+// normalized returns the options with defaults applied and the unit range
+// as indexes into durationUnits
+func (o RelativeTimeOptions) normalized() (RelativeTimeOptions, int, int) {
+	if o.Rounding == "" {
+		o.Rounding = RoundFloor
+	}
+	if o.Phrasing == "" {
+		o.Phrasing = PhrasingSigned
+	}
+	if o.Locale == nil {
+		o.Locale = localeEnglish
+	}
+	largest, smallest := unitIndex(o.LargestUnit), unitIndex(o.SmallestUnit)
+	if largest < 0 {
+		largest = unitIndex(UnitDay)
+	}
+	if smallest < 0 {
+		smallest = unitIndex(UnitSecond)
+	}
+	if smallest < largest {
+		largest = smallest
+	}
+	return o, largest, smallest
+}
+
+// FormatRelativeTime renders a duration in seconds, negative for the past,
+// following options: "2 days, 3 hours", "about 3 weeks ago", "in 5m"
+func FormatRelativeTime(seconds float64, options RelativeTimeOptions) string {
+	o, largest, smallest := options.normalized()
+	negative := seconds < 0
+	total := math.Abs(seconds)
+
+	// The first unit shown is the largest that fits, and MaxUnits counts from it
+	first := smallest
+	for i := largest; i < smallest; i++ {
+		if total >= float64(durationUnits[i].seconds) {
+			first = i
+			break
+		}
+	}
+	last := smallest
+	if o.MaxUnits > 0 && first+o.MaxUnits-1 < last {
+		last = first + o.MaxUnits - 1
+	}
+
+	step := durationUnits[last].seconds
+	count := int64(roundCount(total/float64(step), o.Rounding))
+	exact := float64(count*step) == total
+
+	var amounts []UnitAmount
+	remaining := count * step
+	for _, u := range durationUnits[largest : last+1] {
+		if v := remaining / u.seconds; v > 0 {
+			amounts = append(amounts, UnitAmount{Unit: u.unit, Value: int(v)})
+			remaining %= u.seconds
+		}
+	}
+	if len(amounts) == 0 {
+		if o.Phrasing == PhrasingRelative {
+			return o.Locale.now
+		}
+		amounts = []UnitAmount{{Unit: durationUnits[last].unit, Value: 0}}
+	}
+
+	relative := o.Phrasing == PhrasingRelative
+	var text string
+	if o.Short {
+		parts := make([]string, len(amounts))
+		for i, a := range amounts {
+			parts[i] = fmt.Sprintf("%d%s", a.Value, durationUnits[unitIndex(a.Unit)].symbol)
+		}
+		text = strings.Join(parts, " ")
+	} else {
+		text = o.Locale.formatUnits(amounts, relative)
+	}
+	if o.Approximate && !exact {
+		text = strings.ReplaceAll(o.Locale.approximately, "{0}", text)
+	}
+
+	switch {
+	case relative:
+		return o.Locale.RelativePhrase(text, negative)
+	case negative && count > 0:
+		return "-" + text
+	}
+	return text
+}
@@ -0,0 +1,352 @@
+package passageoftime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// RoundUnit is a calendar or clock unit that timestamps can be rounded to
+type RoundUnit string
+
+const (
+	RoundSecond  RoundUnit = "second"
+	RoundMinute  RoundUnit = "minute"
+	RoundHour    RoundUnit = "hour"
+	RoundDay     RoundUnit = "day"
+	RoundWeek    RoundUnit = "week"
+	RoundMonth   RoundUnit = "month"
+	RoundQuarter RoundUnit = "quarter"
+	RoundYear    RoundUnit = "year"
+)
+
+// RoundMode selects the direction of rounding
+type RoundMode string
+
+const (
+	// RoundFloor returns the start of the period containing the timestamp
+	RoundFloor RoundMode = "floor"
+
+	// RoundCeil returns the start of the next period (or the timestamp itself
+	// when it already lies on a boundary)
+	RoundCeil RoundMode = "ceil"
+
+	// RoundNearest returns whichever boundary is closer; ties round up
+	RoundNearest RoundMode = "nearest"
+)
+
+// RoundOptions controls RoundTimestamp
+type RoundOptions struct {
+	// Unit is the period unit
+	Unit RoundUnit
+
+	// Step is the number of units per period (default 1), e.g. 15 with RoundMinute.
+	// Steps are aligned within the next larger unit: minutes and hours to local
+	// midnight, days to the first of the month, months and quarters to the start
+	// of the (fiscal) year. Weeks and years are aligned to the Unix epoch.
+	// A step may not exceed the enclosing period, e.g. 24 hours or 12 months.
+	Step int
+
+	// Mode is the rounding direction (default RoundFloor)
+	Mode RoundMode
+
+	// WeekStart is the first day of the week (default Monday, as in ISO 8601)
+	WeekStart *time.Weekday
+
+	// FiscalYearStartMonth is the first month of the year for quarter and year
+	// periods (default January)
+	FiscalYearStartMonth time.Month
+}
+
+// RoundResult is a rounded timestamp together with the period containing the input
+type RoundResult struct {
+	// Time is the rounded timestamp
+	Time time.Time
+
+	// PeriodStart is the first instant of the period containing the input
+	PeriodStart time.Time
+
+	// PeriodEnd is the first instant after the period (the start of the next one)
+	PeriodEnd time.Time
+}
+
+var roundUnitPattern = regexp.MustCompile(`^(\d+)?\s*([a-z]+)$`)
+
+// maxRoundSteps is the largest step of each unit, one enclosing period's
+// worth; larger steps would overflow the period size
+var maxRoundSteps = map[RoundUnit]int{
+	RoundSecond:  86400,
+	RoundMinute:  1440,
+	RoundHour:    24,
+	RoundDay:     31,
+	RoundWeek:    53,
+	RoundMonth:   12,
+	RoundQuarter: 4,
+	RoundYear:    10000,
+}
+
+// ParseRoundUnit parses a unit such as "minute", "hours", "15 minutes", "15m" or "2w"
+// and returns the unit and step (1 when no count is given)
+func ParseRoundUnit(text string) (RoundUnit, int, error) {
+	m := roundUnitPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(text)))
+	if m == nil {
+		return "", 0, fmt.Errorf("invalid unit '%s'", text)
+	}
+
+	step := 1
+	if m[1] != "" {
+		n, err := strconv.Atoi(m[1])
+		if err != nil || n < 1 {
+			return "", 0, fmt.Errorf("invalid unit count in '%s'", text)
+		}
+		step = n
+	}
+
+	var unit RoundUnit
+	switch m[2] {
+	case "s", "sec", "secs", "second", "seconds":
+		unit = RoundSecond
+	case "m", "min", "mins", "minute", "minutes":
+		unit = RoundMinute
+	case "h", "hr", "hrs", "hour", "hours":
+		unit = RoundHour
+	case "d", "day", "days":
+		unit = RoundDay
+	case "w", "wk", "week", "weeks":
+		unit = RoundWeek
+	case "mo", "month", "months":
+		unit = RoundMonth
+	case "q", "quarter", "quarters":
+		unit = RoundQuarter
+	case "y", "yr", "year", "years":
+		unit = RoundYear
+	default:
+		return "", 0, fmt.Errorf("invalid unit '%s' (expected second, minute, hour, day, week, month, quarter, or year)", text)
+	}
+	if err := checkRoundStep(unit, step); err != nil {
+		return "", 0, err
+	}
+	return unit, step, nil
+}
+
+// checkRoundStep rejects steps larger than the period they are aligned within
+func checkRoundStep(unit RoundUnit, step int) error {
+	if max, ok := maxRoundSteps[unit]; ok && step > max {
+		return fmt.Errorf("step %d is too large for %s periods (at most %d)", step, unit, max)
+	}
+	return nil
+}
+
+// ParseRoundMode validates a rounding mode name; an empty name selects floor
+func ParseRoundMode(name string) (RoundMode, error) {
+	switch mode := RoundMode(strings.ToLower(strings.TrimSpace(name))); mode {
+	case "":
+		return RoundFloor, nil
+	case RoundFloor, RoundCeil, RoundNearest:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid rounding mode '%s' (expected floor, ceil, or nearest)", name)
+}
+
+// RoundTimestamp rounds t to a period boundary computed on the wall clock of
+// t's location, so "start of day" is local midnight even across DST changes.
+// time.Time.Truncate works on absolute time and is only correct in UTC.
+func RoundTimestamp(t time.Time, options RoundOptions) (*RoundResult, error) {
+	step := options.Step
+	if step == 0 {
+		step = 1
+	}
+	if step < 0 {
+		return nil, fmt.Errorf("step must be positive")
+	}
+	if err := checkRoundStep(options.Unit, step); err != nil {
+		return nil, err
+	}
+
+	weekStart := time.Monday
+	if options.WeekStart != nil {
+		weekStart = *options.WeekStart
+	}
+
+	fiscalStart := options.FiscalYearStartMonth
+	if fiscalStart == 0 {
+		fiscalStart = time.January
+	}
+	if fiscalStart < time.January || fiscalStart > time.December {
+		return nil, fmt.Errorf("invalid fiscal year start month: %d", fiscalStart)
+	}
+
+	mode := options.Mode
+	if mode == "" {
+		mode = RoundFloor
+	}
+
+	start, end, err := civilPeriod(civilTime(t), options.Unit, step, weekStart, fiscalStart)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RoundResult{
+		PeriodStart: resolveBoundary(start, t, true),
+		PeriodEnd:   resolveBoundary(end, t, false),
+	}
+
+	switch mode {
+	case RoundFloor:
+		result.Time = result.PeriodStart
+	case RoundCeil:
+		result.Time = result.PeriodEnd
+		if t.Equal(result.PeriodStart) {
+			result.Time = result.PeriodStart
+		}
+	case RoundNearest:
+		result.Time = result.PeriodEnd
+		if t.Sub(result.PeriodStart) < result.PeriodEnd.Sub(t) {
+			result.Time = result.PeriodStart
+		}
+	default:
+		return nil, fmt.Errorf("invalid rounding mode '%s'", mode)
+	}
+
+	return result, nil
+}
+
+// civilTime returns t's wall-clock reading as a UTC time, where calendar
+// arithmetic is free of DST effects
+func civilTime(t time.Time) time.Time {
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, mo, d, h, mi, s, t.Nanosecond(), time.UTC)
+}
+
+// alignWallClock returns the first instant at or after t whose wall-clock
+// reading in t's location is a multiple of step since local midnight, so a
+// 30-minute step lands on :00 and :30 in Asia/Kolkata as well as in UTC. When
+// no multiple remains before midnight, the next midnight is returned.
+func alignWallClock(t time.Time, step time.Duration) time.Time {
+	civil := civilTime(t)
+	y, mo, d := civil.Date()
+	day := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+	aligned := minTime(day.Add((civil.Sub(day)+step-1)/step*step), day.AddDate(0, 0, 1))
+
+	y, mo, d = aligned.Date()
+	h, mi, s := aligned.Clock()
+	result := time.Date(y, mo, d, h, mi, s, aligned.Nanosecond(), t.Location())
+	if result.Before(t) {
+		result = result.Add(step)
+	}
+	return result
+}
+
+// civilPeriod returns the wall-clock bounds of the period containing civil
+func civilPeriod(civil time.Time, unit RoundUnit, step int, weekStart time.Weekday, fiscalStart time.Month) (time.Time, time.Time, error) {
+	y, mo, d := civil.Date()
+	day := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+	nextDay := day.AddDate(0, 0, 1)
+
+	// withinDay splits the day into periods of size, the last one ending at midnight
+	withinDay := func(size time.Duration) (time.Time, time.Time) {
+		start := day.Add(civil.Sub(day) / size * size)
+		return start, minTime(start.Add(size), nextDay)
+	}
+
+	// withinYear splits the (fiscal) year into periods of the given number of months
+	withinYear := func(months int) (time.Time, time.Time) {
+		yearStart := fiscalYearStart(civil, fiscalStart)
+		elapsed := (int(mo) - int(fiscalStart) + 12) % 12
+		start := yearStart.AddDate(0, elapsed/months*months, 0)
+		return start, minTime(start.AddDate(0, months, 0), yearStart.AddDate(1, 0, 0))
+	}
+
+	switch unit {
+	case RoundSecond:
+		start, end := withinDay(time.Duration(step) * time.Second)
+		return start, end, nil
+	case RoundMinute:
+		start, end := withinDay(time.Duration(step) * time.Minute)
+		return start, end, nil
+	case RoundHour:
+		start, end := withinDay(time.Duration(step) * time.Hour)
+		return start, end, nil
+	case RoundDay:
+		monthStart := time.Date(y, mo, 1, 0, 0, 0, 0, time.UTC)
+		start := monthStart.AddDate(0, 0, (d-1)/step*step)
+		return start, minTime(start.AddDate(0, 0, step), monthStart.AddDate(0, 1, 0)), nil
+	case RoundWeek:
+		// Weeks are counted from the first week start after the Unix epoch (a Thursday)
+		epoch := time.Date(1970, 1, 1+(int(weekStart)-int(time.Thursday)+7)%7, 0, 0, 0, 0, time.UTC)
+		days := int(day.Sub(epoch).Hours() / 24)
+		start := epoch.AddDate(0, 0, floorDiv(days, 7*step)*7*step)
+		return start, start.AddDate(0, 0, 7*step), nil
+	case RoundMonth:
+		start, end := withinYear(step)
+		return start, end, nil
+	case RoundQuarter:
+		start, end := withinYear(3 * step)
+		return start, end, nil
+	case RoundYear:
+		yearStart := fiscalYearStart(civil, fiscalStart)
+		start := time.Date(floorDiv(yearStart.Year(), step)*step, fiscalStart, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(step, 0, 0), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid unit '%s'", unit)
+}
+
+// fiscalYearStart returns the first day of the (fiscal) year containing civil
+func fiscalYearStart(civil time.Time, fiscalStart time.Month) time.Time {
+	year := civil.Year()
+	if civil.Month() < fiscalStart {
+		year--
+	}
+	return time.Date(year, fiscalStart, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// resolveBoundary places a wall-clock boundary in t's location. A boundary that
+// occurs twice resolves to the occurrence on the same side of t, and one that
+// falls into a DST gap resolves to the end of the gap.
+func resolveBoundary(civil time.Time, t time.Time, isStart bool) time.Time {
+	loc := t.Location()
+	y, mo, d := civil.Date()
+	h, mi, s := civil.Clock()
+	instants := internal.LocalInstants(y, mo, d, h, mi, s, civil.Nanosecond(), loc)
+
+	switch len(instants) {
+	case 0:
+		resolved, _, _ := ResolveLocalTime(civil, loc, ParseOptions{NonexistentTime: NonexistentShiftForward})
+		return resolved
+	case 1:
+		return instants[0]
+	}
+
+	earlier, later := instants[0], instants[1]
+	if isStart {
+		if later.After(t) {
+			return earlier
+		}
+		return later
+	}
+	if earlier.After(t) {
+		return earlier
+	}
+	return later
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
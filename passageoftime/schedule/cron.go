@@ -0,0 +1,107 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cronMacros maps the @-shortcuts understood by common cron implementations
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a standard cron expression.
+//
+// Supported forms:
+//   - 5 fields: minute hour day-of-month month day-of-week
+//   - 6 fields: second minute hour day-of-month month day-of-week
+//   - macros: @yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly
+//
+// Each field accepts '*', '?', lists (1,15), ranges (1-5), steps (*/15, 0-30/10, 5/15)
+// and, for months and weekdays, English names (JAN, Mon). Day-of-week 7 is Sunday.
+// Quartz extensions (L, W, #) are not supported.
+func ParseCron(expression string) (*Schedule, error) {
+	text := strings.TrimSpace(expression)
+	if strings.HasPrefix(text, "@") {
+		expanded, ok := cronMacros[strings.ToLower(text)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported cron macro '%s'", text)
+		}
+		text = expanded
+	}
+
+	fields := strings.Fields(text)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron expression '%s' must have 5 or 6 fields, got %d", expression, len(fields))
+	}
+
+	s := &Schedule{
+		Expression: strings.TrimSpace(expression),
+		Kind:       KindCron,
+		years:      wildcardField("year", 1, 9999),
+	}
+
+	var err error
+	if s.seconds, err = parseField(fields[0], "second", 0, 59, "-", nil); err != nil {
+		return nil, err
+	}
+	if s.minutes, err = parseField(fields[1], "minute", 0, 59, "-", nil); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseField(fields[2], "hour", 0, 23, "-", nil); err != nil {
+		return nil, err
+	}
+	if s.days, err = parseField(fields[3], "day-of-month", 1, 31, "-", nil); err != nil {
+		return nil, err
+	}
+	if s.months, err = parseField(fields[4], "month", 1, 12, "-", monthNames); err != nil {
+		return nil, err
+	}
+	if s.weekdays, err = parseField(fields[5], "day-of-week", 0, 7, "-", weekdayNames); err != nil {
+		return nil, err
+	}
+	s.weekdays = normalizeSunday(s.weekdays)
+
+	// Vixie cron: a '*' in either day field means the other one decides alone;
+	// when both are restricted, either may match.
+	dayWildcard := strings.HasPrefix(fields[3], "*") || strings.HasPrefix(fields[3], "?")
+	weekdayWildcard := strings.HasPrefix(fields[5], "*") || strings.HasPrefix(fields[5], "?")
+	s.dayOr = !dayWildcard && !weekdayWildcard
+
+	return s, nil
+}
+
+// normalizeSunday folds the cron alias 7 onto 0 so weekdays match time.Weekday
+func normalizeSunday(f field) field {
+	if f.wildcard {
+		return wildcardField(f.name, 0, 6)
+	}
+
+	sunday := f.matches(7)
+	spans := make([]span, 0, len(f.spans))
+	for _, sp := range f.spans {
+		switch {
+		case sp.lo == 7:
+			sp = span{lo: 0, hi: 0, step: 1}
+		case sp.hi == 7 && sp.step == 1:
+			sp.hi = 6
+		}
+		spans = append(spans, sp)
+	}
+	f.spans = spans
+	if sunday && !f.matches(0) {
+		f.spans = append(f.spans, span{lo: 0, hi: 0, step: 1})
+	}
+	f.max = 6
+	return f
+}
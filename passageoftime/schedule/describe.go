@@ -0,0 +1,167 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// describe renders a schedule as an English sentence, e.g.
+// "Every 15 minutes, every hour from 9 through 17, on Monday through Friday"
+func describe(s *Schedule) string {
+	var parts []string
+
+	if clock, ok := describeClockTimes(s); ok {
+		parts = append(parts, clock)
+	} else {
+		parts = append(parts, describeTimeFields(s)...)
+	}
+
+	parts = append(parts, describeDays(s)...)
+
+	if !s.months.wildcard {
+		parts = append(parts, "in "+describeField(s.months, monthLabel))
+	}
+	if !s.years.wildcard {
+		parts = append(parts, "in "+describeField(s.years, plainLabel))
+	}
+	if s.Location != nil {
+		parts = append(parts, s.Location.String()+" time")
+	}
+
+	text := strings.Join(parts, ", ")
+	return strings.ToUpper(text[:1]) + text[1:]
+}
+
+// describeClockTimes handles the common case of a few fixed times of day ("at 09:00 and 17:30")
+func describeClockTimes(s *Schedule) (string, bool) {
+	minute, okMinute := s.minutes.single()
+	second, okSecond := s.seconds.single()
+	if !okMinute || !okSecond || s.hours.wildcard {
+		return "", false
+	}
+
+	hours := s.hours.values()
+	if len(hours) > 6 {
+		return "", false
+	}
+
+	clocks := make([]string, len(hours))
+	for i, h := range hours {
+		clocks[i] = fmt.Sprintf("%02d:%02d", h, minute)
+		if second != 0 {
+			clocks[i] += fmt.Sprintf(":%02d", second)
+		}
+	}
+	return "at " + joinList(clocks), true
+}
+
+func describeTimeFields(s *Schedule) []string {
+	var parts []string
+
+	secondValue, secondSingle := s.seconds.single()
+	switch {
+	case s.seconds.wildcard:
+		parts = append(parts, "every second")
+	case !(secondSingle && secondValue == 0):
+		parts = append(parts, describeUnit(s.seconds, "second"))
+	}
+
+	// "every 10 seconds" already implies every minute
+	if !s.minutes.wildcard || secondSingle && secondValue == 0 {
+		parts = append(parts, describeUnit(s.minutes, "minute"))
+	}
+
+	if !s.hours.wildcard {
+		parts = append(parts, describeUnit(s.hours, "hour"))
+	}
+	return parts
+}
+
+// describeUnit describes a time-of-day field in terms of its unit
+func describeUnit(f field, unit string) string {
+	if f.wildcard {
+		return "every " + unit
+	}
+	if v, ok := f.single(); ok {
+		return fmt.Sprintf("at %s %d", unit, v)
+	}
+	if len(f.spans) == 1 {
+		sp := f.spans[0]
+		switch {
+		case sp.step > 1 && sp.lo == f.min && sp.hi == f.max:
+			return fmt.Sprintf("every %d %ss", sp.step, unit)
+		case sp.step > 1 && sp.hi == f.max:
+			return fmt.Sprintf("every %d %ss starting at %s %d", sp.step, unit, unit, sp.lo)
+		case sp.step > 1:
+			return fmt.Sprintf("every %d %ss from %s %d through %d", sp.step, unit, unit, sp.lo, sp.hi)
+		default:
+			return fmt.Sprintf("every %s from %d through %d", unit, sp.lo, sp.hi)
+		}
+	}
+	return fmt.Sprintf("at %ss %s", unit, describeField(f, plainLabel))
+}
+
+func describeDays(s *Schedule) []string {
+	var dayOfMonth, dayOfWeek string
+	if !s.days.wildcard {
+		dayOfMonth = "on day " + describeField(s.days, plainLabel) + " of the month"
+		if len(s.days.values()) > 1 {
+			dayOfMonth = "on days " + describeField(s.days, plainLabel) + " of the month"
+		}
+	}
+	if !s.weekdays.wildcard {
+		dayOfWeek = "on " + describeField(s.weekdays, weekdayLabel)
+	}
+
+	switch {
+	case dayOfMonth != "" && dayOfWeek != "" && s.dayOr:
+		return []string{dayOfMonth + " or " + dayOfWeek}
+	case dayOfMonth != "" && dayOfWeek != "":
+		return []string{dayOfWeek, dayOfMonth}
+	case dayOfMonth != "":
+		return []string{dayOfMonth}
+	case dayOfWeek != "":
+		return []string{dayOfWeek}
+	}
+	return nil
+}
+
+// describeField lists the spans of a field using label to render values
+func describeField(f field, label func(int) string) string {
+	items := make([]string, 0, len(f.spans))
+	for _, sp := range f.spans {
+		switch {
+		case sp.lo == sp.hi:
+			items = append(items, label(sp.lo))
+		case sp.step == 1:
+			items = append(items, label(sp.lo)+" through "+label(sp.hi))
+		default:
+			items = append(items, fmt.Sprintf("every %d from %s through %s", sp.step, label(sp.lo), label(sp.hi)))
+		}
+	}
+	return joinList(items)
+}
+
+func plainLabel(v int) string {
+	return fmt.Sprintf("%d", v)
+}
+
+func monthLabel(v int) string {
+	return time.Month(v).String()
+}
+
+func weekdayLabel(v int) string {
+	return time.Weekday(v % 7).String()
+}
+
+// joinList joins items as "a", "a and b" or "a, b and c"
+func joinList(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
@@ -0,0 +1,147 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// span is one comma-separated element of a field: lo..hi every step
+type span struct {
+	lo, hi, step int
+}
+
+// field is a set of allowed values for one calendar component.
+// The spans are kept as written so schedules can be described in English.
+type field struct {
+	name     string
+	min, max int
+	spans    []span
+
+	// wildcard is true when the field was written as '*' (or '?'), i.e. unrestricted
+	wildcard bool
+}
+
+func wildcardField(name string, min, max int) field {
+	return field{
+		name:     name,
+		min:      min,
+		max:      max,
+		spans:    []span{{lo: min, hi: max, step: 1}},
+		wildcard: true,
+	}
+}
+
+func singleField(name string, min, max, value int) field {
+	return field{name: name, min: min, max: max, spans: []span{{lo: value, hi: value, step: 1}}}
+}
+
+func (f field) matches(v int) bool {
+	for _, s := range f.spans {
+		if v >= s.lo && v <= s.hi && (v-s.lo)%s.step == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// values lists every allowed value in ascending order
+func (f field) values() []int {
+	var values []int
+	for v := f.min; v <= f.max; v++ {
+		if f.matches(v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// single returns the only allowed value, if the field allows exactly one
+func (f field) single() (int, bool) {
+	if len(f.spans) == 1 && f.spans[0].lo == f.spans[0].hi {
+		return f.spans[0].lo, true
+	}
+	return 0, false
+}
+
+// parseField parses a comma-separated list of values, ranges and steps.
+// rangeSep is "-" for cron and ".." for OnCalendar; names maps lower-case
+// aliases (month or weekday names) to their numeric value.
+func parseField(text, name string, min, max int, rangeSep string, names map[string]int) (field, error) {
+	f := field{name: name, min: min, max: max}
+	if text == "*" || text == "?" {
+		return wildcardField(name, min, max), nil
+	}
+
+	for _, part := range strings.Split(text, ",") {
+		if part == "" {
+			return field{}, fmt.Errorf("empty list element in %s field '%s'", name, text)
+		}
+
+		rangeText, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return field{}, fmt.Errorf("invalid step '%s' in %s field", stepText, name)
+			}
+			step = n
+		}
+
+		var lo, hi int
+		switch {
+		case rangeText == "*" || rangeText == "?":
+			lo, hi = min, max
+		case strings.Contains(rangeText, rangeSep):
+			loText, hiText, _ := strings.Cut(rangeText, rangeSep)
+			var err error
+			if lo, err = parseValue(loText, name, names); err != nil {
+				return field{}, err
+			}
+			if hi, err = parseValue(hiText, name, names); err != nil {
+				return field{}, err
+			}
+		default:
+			v, err := parseValue(rangeText, name, names)
+			if err != nil {
+				return field{}, err
+			}
+			lo, hi = v, v
+			if hasStep {
+				// "a/n" means "starting at a, every n"
+				hi = max
+			}
+		}
+
+		if lo < min || hi > max {
+			return field{}, fmt.Errorf("%s value out of range in '%s' (allowed %d-%d)", name, part, min, max)
+		}
+		if lo > hi {
+			return field{}, fmt.Errorf("%s range '%s' is reversed", name, part)
+		}
+		f.spans = append(f.spans, span{lo: lo, hi: hi, step: step})
+	}
+
+	return f, nil
+}
+
+func parseValue(text, name string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(text)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value '%s'", name, text)
+	}
+	return v, nil
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	"sunday": 0, "monday": 1, "tuesday": 2, "wednesday": 3, "thursday": 4, "friday": 5, "saturday": 6,
+}
@@ -0,0 +1,152 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// onCalendarShortcuts maps systemd's named calendar events to their normalized form
+var onCalendarShortcuts = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+}
+
+// ParseOnCalendar parses a systemd OnCalendar expression of the form
+//
+//	[DayOfWeek] [[Year-]Month-Day] [Hour:Minute[:Second]] [Timezone]
+//
+// Every component accepts '*', lists (1,15), ranges (1..5) and repetitions (*/15, 0/10).
+// Weekdays may be abbreviated (Mon..Fri, Sat,Sun). The named shortcuts
+// minutely, hourly, daily, weekly, monthly, yearly, annually, quarterly and
+// semiannually are recognized. An omitted date means every day and an omitted
+// time means midnight. A trailing IANA timezone (or UTC) pins the schedule to that zone.
+// The '~' last-day-of-month syntax and fractional seconds are not supported.
+func ParseOnCalendar(expression string) (*Schedule, error) {
+	tokens := strings.Fields(expression)
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty OnCalendar expression")
+	}
+
+	s := &Schedule{
+		Expression: strings.TrimSpace(expression),
+		Kind:       KindOnCalendar,
+	}
+
+	// Trailing timezone
+	if len(tokens) > 1 {
+		last := tokens[len(tokens)-1]
+		if strings.Contains(last, "/") || strings.EqualFold(last, "UTC") {
+			if loc, err := time.LoadLocation(last); err == nil {
+				s.Location = loc
+				tokens = tokens[:len(tokens)-1]
+			}
+		}
+	}
+
+	if len(tokens) == 1 {
+		if expanded, ok := onCalendarShortcuts[strings.ToLower(tokens[0])]; ok {
+			tokens = strings.Fields(expanded)
+		}
+	}
+
+	var weekdayText, dateText, timeText string
+	for i, token := range tokens {
+		switch {
+		case i == 0 && unicode.IsLetter(rune(token[0])):
+			weekdayText = token
+		case strings.Contains(token, ":") && timeText == "":
+			timeText = token
+		case strings.Contains(token, "-") && dateText == "" && timeText == "":
+			dateText = token
+		default:
+			return nil, fmt.Errorf("unexpected component '%s' in OnCalendar expression '%s'", token, expression)
+		}
+	}
+
+	if strings.Contains(dateText, "~") {
+		return nil, fmt.Errorf("the '~' last-day-of-month syntax is not supported")
+	}
+
+	var err error
+	s.weekdays = wildcardField("day-of-week", 0, 6)
+	if weekdayText != "" {
+		if s.weekdays, err = parseField(weekdayText, "day-of-week", 0, 6, "..", weekdayNames); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.parseOnCalendarDate(dateText); err != nil {
+		return nil, err
+	}
+	if err = s.parseOnCalendarTime(timeText); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Schedule) parseOnCalendarDate(text string) error {
+	s.years = wildcardField("year", 1970, 2199)
+	s.months = wildcardField("month", 1, 12)
+	s.days = wildcardField("day-of-month", 1, 31)
+	if text == "" {
+		return nil
+	}
+
+	parts := strings.Split(text, "-")
+	if len(parts) == 2 {
+		parts = append([]string{"*"}, parts...)
+	}
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid OnCalendar date '%s', expected [Year-]Month-Day", text)
+	}
+
+	var err error
+	if s.years, err = parseField(parts[0], "year", 1970, 2199, "..", nil); err != nil {
+		return err
+	}
+	if s.months, err = parseField(parts[1], "month", 1, 12, "..", nil); err != nil {
+		return err
+	}
+	s.days, err = parseField(parts[2], "day-of-month", 1, 31, "..", nil)
+	return err
+}
+
+func (s *Schedule) parseOnCalendarTime(text string) error {
+	if text == "" {
+		s.hours = singleField("hour", 0, 23, 0)
+		s.minutes = singleField("minute", 0, 59, 0)
+		s.seconds = singleField("second", 0, 59, 0)
+		return nil
+	}
+
+	parts := strings.Split(text, ":")
+	if len(parts) == 2 {
+		parts = append(parts, "00")
+	}
+	if len(parts) != 3 {
+		return fmt.Errorf("invalid OnCalendar time '%s', expected Hour:Minute[:Second]", text)
+	}
+	if strings.Contains(parts[2], ".") && !strings.Contains(parts[2], "..") {
+		return fmt.Errorf("fractional seconds are not supported in '%s'", text)
+	}
+
+	var err error
+	if s.hours, err = parseField(parts[0], "hour", 0, 23, "..", nil); err != nil {
+		return err
+	}
+	if s.minutes, err = parseField(parts[1], "minute", 0, 59, "..", nil); err != nil {
+		return err
+	}
+	s.seconds, err = parseField(parts[2], "second", 0, 59, "..", nil)
+	return err
+}
@@ -0,0 +1,192 @@
+// Package schedule evaluates recurring schedules written as cron expressions
+// or systemd OnCalendar expressions.
+//
+// Fire times are computed on the wall clock of a chosen timezone, so DST
+// transitions are handled the way an operator expects: a wall-clock time that
+// does not exist on a given day (spring-forward gap) is skipped, and a
+// wall-clock time that occurs twice (fall-back overlap) fires once, at the
+// earlier instant.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// Kind identifies the syntax a schedule was written in
+type Kind string
+
+const (
+	// KindCron is a standard 5-field or 6-field (with seconds) cron expression
+	KindCron Kind = "cron"
+
+	// KindOnCalendar is a systemd.time(7) OnCalendar calendar event expression
+	KindOnCalendar Kind = "oncalendar"
+)
+
+// searchHorizonYears bounds how far Next and Previous look for fire times.
+// OnCalendar expressions may pin a year, so the horizon has to be generous.
+const searchHorizonYears = 400
+
+// Schedule is a parsed recurring schedule
+type Schedule struct {
+	// Expression is the original expression as supplied by the caller
+	Expression string
+
+	// Kind is the syntax the expression was parsed as
+	Kind Kind
+
+	// Location is the timezone embedded in the expression (OnCalendar only), or nil
+	Location *time.Location
+
+	seconds  field
+	minutes  field
+	hours    field
+	days     field
+	months   field
+	weekdays field
+	years    field
+
+	// dayOr selects cron's historical rule: when both day-of-month and
+	// day-of-week are restricted, a day matches if either one matches.
+	dayOr bool
+}
+
+// Parse parses a cron or OnCalendar expression.
+// Expressions starting with '@' or consisting of 5 or 6 plain cron fields are
+// treated as cron; everything else is treated as OnCalendar.
+func Parse(expression string) (*Schedule, error) {
+	trimmed := strings.TrimSpace(expression)
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty schedule expression")
+	}
+
+	if looksLikeCron(trimmed) {
+		return ParseCron(trimmed)
+	}
+	return ParseOnCalendar(trimmed)
+}
+
+// Next returns up to n fire times strictly after from, evaluated in loc.
+// If the expression carries its own timezone, that timezone wins over loc.
+func (s *Schedule) Next(from time.Time, n int, loc *time.Location) ([]time.Time, error) {
+	return s.collect(from, n, loc, true)
+}
+
+// Previous returns up to n fire times strictly before from, most recent first.
+// If the expression carries its own timezone, that timezone wins over loc.
+func (s *Schedule) Previous(from time.Time, n int, loc *time.Location) ([]time.Time, error) {
+	return s.collect(from, n, loc, false)
+}
+
+// Description returns an English description of the schedule
+func (s *Schedule) Description() string {
+	return describe(s)
+}
+
+func (s *Schedule) collect(from time.Time, n int, loc *time.Location, forward bool) ([]time.Time, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	if s.Location != nil {
+		loc = s.Location
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	local := from.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	step := 1
+	if !forward {
+		step = -1
+	}
+	limit := day.AddDate(step*searchHorizonYears, 0, 0)
+
+	var results []time.Time
+	for ; forward && !day.After(limit) || !forward && !day.Before(limit); day = day.AddDate(0, 0, step) {
+		if !s.matchesDay(day) {
+			continue
+		}
+
+		for _, t := range s.timesOnDay(day, loc, forward) {
+			if forward && !t.After(from) || !forward && !t.Before(from) {
+				continue
+			}
+			results = append(results, t)
+			if len(results) == n {
+				return results, nil
+			}
+		}
+	}
+
+	if len(results) == 0 {
+		direction := "upcoming"
+		if !forward {
+			direction = "past"
+		}
+		return nil, fmt.Errorf("schedule '%s' has no %s fire times within %d years", s.Expression, direction, searchHorizonYears)
+	}
+	return results, nil
+}
+
+// matchesDay reports whether the calendar date (given as midnight UTC) is a fire day
+func (s *Schedule) matchesDay(day time.Time) bool {
+	if !s.years.matches(day.Year()) || !s.months.matches(int(day.Month())) {
+		return false
+	}
+
+	dom := s.days.matches(day.Day())
+	dow := s.weekdays.matches(int(day.Weekday()))
+	if s.dayOr {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// timesOnDay lists the fire instants on one local calendar day in chronological
+// (or reverse chronological) order. Wall-clock times lost to a DST gap are
+// skipped; ambiguous ones resolve to their earlier instant.
+func (s *Schedule) timesOnDay(day time.Time, loc *time.Location, forward bool) []time.Time {
+	var times []time.Time
+	for _, h := range s.hours.values() {
+		for _, m := range s.minutes.values() {
+			for _, sec := range s.seconds.values() {
+				instants := internal.LocalInstants(day.Year(), day.Month(), day.Day(), h, m, sec, 0, loc)
+				if len(instants) == 0 {
+					continue
+				}
+				times = append(times, instants[0])
+			}
+		}
+	}
+
+	if !forward {
+		for i, j := 0, len(times)-1; i < j; i, j = i+1, j-1 {
+			times[i], times[j] = times[j], times[i]
+		}
+	}
+	return times
+}
+
+// looksLikeCron decides whether an expression uses cron syntax
+func looksLikeCron(expression string) bool {
+	if strings.HasPrefix(expression, "@") {
+		return true
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 && len(fields) != 6 {
+		return false
+	}
+	// OnCalendar separates date and time components with '-' and ':'
+	for _, f := range fields {
+		if strings.ContainsAny(f, ":") || strings.Contains(f, "..") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,278 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Zenith angles (degrees) of the sun for the solar events. Sunrise and sunset
+// use 90.833°, which accounts for atmospheric refraction and the solar disc.
+const (
+	zenithSunrise      = 90.833
+	zenithCivil        = 96.0
+	zenithNautical     = 102.0
+	zenithAstronomical = 108.0
+	zenithGoldenHour   = 84.0 // sun 6° above the horizon
+)
+
+// SolarTimes holds the solar events of one local calendar day. Events the sun
+// does not reach on that day (e.g. sunrise during polar night) are nil.
+type SolarTimes struct {
+	// Date is local midnight of the day described
+	Date time.Time
+
+	Latitude  float64
+	Longitude float64
+
+	Sunrise *time.Time
+	Sunset  *time.Time
+
+	// SolarNoon is when the sun crosses the meridian (highest point)
+	SolarNoon time.Time
+
+	// SolarNoonElevation is the sun's elevation at solar noon in degrees
+	SolarNoonElevation float64
+
+	CivilDawn        *time.Time
+	CivilDusk        *time.Time
+	NauticalDawn     *time.Time
+	NauticalDusk     *time.Time
+	AstronomicalDawn *time.Time
+	AstronomicalDusk *time.Time
+
+	// GoldenHourMorningEnd and GoldenHourEveningStart bound the golden hours,
+	// which run from sunrise until the sun is 6° high and from 6° down to sunset
+	GoldenHourMorningEnd   *time.Time
+	GoldenHourEveningStart *time.Time
+
+	// DayLength is the time between sunrise and sunset (24h during midnight sun)
+	DayLength time.Duration
+
+	// MidnightSun is true when the sun does not set; PolarNight when it does not rise
+	MidnightSun bool
+	PolarNight  bool
+}
+
+// GetSolarTimes computes sunrise, sunset, twilight, golden hour and solar noon for
+// the calendar day of date in date's location, using the NOAA solar calculator
+// equations. Latitude is positive north and longitude positive east. Results are
+// typically accurate to within a minute between ±72° latitude.
+func GetSolarTimes(date time.Time, latitude, longitude float64) (*SolarTimes, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+
+	loc := date.Location()
+	y, m, d := date.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	// Pick the UTC day whose solar noon falls on the requested local day; this
+	// matters where the timezone offset differs greatly from the longitude
+	utcDay := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	noon := utcDay.Add(minutesDuration(solarNoonUTC(julianDay(utcDay), longitude)))
+	if ny, nm, nd := noon.In(loc).Date(); nd != d || nm != m || ny != y {
+		if noon.In(loc).Before(midnight) {
+			utcDay = utcDay.AddDate(0, 0, 1)
+		} else {
+			utcDay = utcDay.AddDate(0, 0, -1)
+		}
+		noon = utcDay.Add(minutesDuration(solarNoonUTC(julianDay(utcDay), longitude)))
+	}
+
+	jd := julianDay(utcDay)
+	event := func(zenith float64, rising bool) *time.Time {
+		minutes, ok := sunriseSetUTC(rising, jd, latitude, longitude, zenith)
+		if !ok {
+			return nil
+		}
+		t := utcDay.Add(minutesDuration(minutes)).In(loc)
+		return &t
+	}
+
+	st := &SolarTimes{
+		Date:                   midnight,
+		Latitude:               latitude,
+		Longitude:              longitude,
+		SolarNoon:              noon.In(loc),
+		SolarNoonElevation:     SolarElevation(noon, latitude, longitude),
+		Sunrise:                event(zenithSunrise, true),
+		Sunset:                 event(zenithSunrise, false),
+		CivilDawn:              event(zenithCivil, true),
+		CivilDusk:              event(zenithCivil, false),
+		NauticalDawn:           event(zenithNautical, true),
+		NauticalDusk:           event(zenithNautical, false),
+		AstronomicalDawn:       event(zenithAstronomical, true),
+		AstronomicalDusk:       event(zenithAstronomical, false),
+		GoldenHourMorningEnd:   event(zenithGoldenHour, true),
+		GoldenHourEveningStart: event(zenithGoldenHour, false),
+	}
+
+	switch {
+	case st.Sunrise != nil && st.Sunset != nil:
+		st.DayLength = st.Sunset.Sub(*st.Sunrise)
+	case st.SolarNoonElevation > 90-zenithSunrise:
+		st.MidnightSun = true
+		st.DayLength = 24 * time.Hour
+	default:
+		st.PolarNight = true
+	}
+
+	return st, nil
+}
+
+// SolarElevation returns the sun's elevation above the horizon in degrees at t
+// (geometric, without refraction)
+func SolarElevation(t time.Time, latitude, longitude float64) float64 {
+	utc := t.UTC()
+	jc := julianCentury(julianDay(utc))
+	eqTime := equationOfTime(jc)
+	decl := sunDeclination(jc)
+
+	minutes := float64(utc.Hour()*60+utc.Minute()) + float64(utc.Second())/60
+	trueSolarTime := math.Mod(minutes+eqTime+4*longitude, 1440)
+	if trueSolarTime < 0 {
+		trueSolarTime += 1440
+	}
+	hourAngle := trueSolarTime/4 - 180
+
+	latR, declR := degToRad(latitude), degToRad(decl)
+	cosZenith := math.Sin(latR)*math.Sin(declR) + math.Cos(latR)*math.Cos(declR)*math.Cos(degToRad(hourAngle))
+	cosZenith = math.Max(-1, math.Min(1, cosZenith))
+	return 90 - radToDeg(math.Acos(cosZenith))
+}
+
+// GetDaylight classifies the sky at t as "day", "civil_twilight", "nautical_twilight",
+// "astronomical_twilight" or "night" and returns the sun's elevation in degrees
+func GetDaylight(t time.Time, latitude, longitude float64) (string, float64, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return "", 0, err
+	}
+
+	elevation := SolarElevation(t, latitude, longitude)
+	switch {
+	case elevation > 90-zenithSunrise:
+		return "day", elevation, nil
+	case elevation > 90-zenithCivil:
+		return "civil_twilight", elevation, nil
+	case elevation > 90-zenithNautical:
+		return "nautical_twilight", elevation, nil
+	case elevation > 90-zenithAstronomical:
+		return "astronomical_twilight", elevation, nil
+	default:
+		return "night", elevation, nil
+	}
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90, got %v", latitude)
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180, got %v", longitude)
+	}
+	return nil
+}
+
+// julianDay returns the Julian day number of t (including the fraction of the day)
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + 2440587.5
+}
+
+func julianCentury(jd float64) float64 {
+	return (jd - 2451545.0) / 36525
+}
+
+// solarNoonUTC returns solar noon in minutes after 00:00 UTC of the day starting at jd
+func solarNoonUTC(jd, longitude float64) float64 {
+	noon := 720 - 4*longitude - equationOfTime(julianCentury(jd-longitude/360))
+	// Refine with the equation of time at the first estimate
+	return 720 - 4*longitude - equationOfTime(julianCentury(jd+noon/1440))
+}
+
+// sunriseSetUTC returns, in minutes after 00:00 UTC of the day starting at jd, when
+// the sun crosses the given zenith angle while rising or setting. ok is false when
+// the sun does not reach that angle on this day.
+func sunriseSetUTC(rising bool, jd, latitude, longitude, zenith float64) (float64, bool) {
+	minutes := 720.0
+	for i := 0; i < 2; i++ {
+		jc := julianCentury(jd + minutes/1440)
+		hourAngle, ok := sunHourAngle(latitude, sunDeclination(jc), zenith)
+		if !ok {
+			return 0, false
+		}
+		if !rising {
+			hourAngle = -hourAngle
+		}
+		minutes = 720 - 4*(longitude+radToDeg(hourAngle)) - equationOfTime(jc)
+	}
+	return minutes, true
+}
+
+// sunHourAngle returns the hour angle (radians) at which the sun reaches the zenith angle
+func sunHourAngle(latitude, declination, zenith float64) (float64, bool) {
+	latR, declR := degToRad(latitude), degToRad(declination)
+	cosHA := math.Cos(degToRad(zenith))/(math.Cos(latR)*math.Cos(declR)) - math.Tan(latR)*math.Tan(declR)
+	if cosHA < -1 || cosHA > 1 || math.IsNaN(cosHA) {
+		return 0, false
+	}
+	return math.Acos(cosHA), true
+}
+
+func geomMeanLongSun(jc float64) float64 {
+	l0 := math.Mod(280.46646+jc*(36000.76983+jc*0.0003032), 360)
+	if l0 < 0 {
+		l0 += 360
+	}
+	return l0
+}
+
+func geomMeanAnomalySun(jc float64) float64 {
+	return 357.52911 + jc*(35999.05029-0.0001537*jc)
+}
+
+func eccentricityEarthOrbit(jc float64) float64 {
+	return 0.016708634 - jc*(0.000042037+0.0000001267*jc)
+}
+
+func sunEquationOfCenter(jc float64) float64 {
+	m := degToRad(geomMeanAnomalySun(jc))
+	return math.Sin(m)*(1.914602-jc*(0.004817+0.000014*jc)) +
+		math.Sin(2*m)*(0.019993-0.000101*jc) +
+		math.Sin(3*m)*0.000289
+}
+
+func sunApparentLong(jc float64) float64 {
+	trueLong := geomMeanLongSun(jc) + sunEquationOfCenter(jc)
+	return trueLong - 0.00569 - 0.00478*math.Sin(degToRad(125.04-1934.136*jc))
+}
+
+func obliquityCorrection(jc float64) float64 {
+	seconds := 21.448 - jc*(46.8150+jc*(0.00059-jc*0.001813))
+	meanObliquity := 23 + (26+seconds/60)/60
+	return meanObliquity + 0.00256*math.Cos(degToRad(125.04-1934.136*jc))
+}
+
+func sunDeclination(jc float64) float64 {
+	return radToDeg(math.Asin(math.Sin(degToRad(obliquityCorrection(jc))) * math.Sin(degToRad(sunApparentLong(jc)))))
+}
+
+// equationOfTime returns the difference between true and mean solar time in minutes
+func equationOfTime(jc float64) float64 {
+	epsilon := degToRad(obliquityCorrection(jc))
+	l0 := degToRad(geomMeanLongSun(jc))
+	e := eccentricityEarthOrbit(jc)
+	m := degToRad(geomMeanAnomalySun(jc))
+
+	y := math.Pow(math.Tan(epsilon/2), 2)
+	eqTime := y*math.Sin(2*l0) - 2*e*math.Sin(m) + 4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) - 1.25*e*e*math.Sin(2*m)
+	return radToDeg(eqTime) * 4
+}
+
+func minutesDuration(minutes float64) time.Duration {
+	return time.Duration(minutes * float64(time.Minute))
+}
+
+func degToRad(deg float64) float64 { return deg * math.Pi / 180 }
+func radToDeg(rad float64) float64 { return rad * 180 / math.Pi }
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"strings"
 	"time"
-
-	"github.com/dustin/go-humanize"
 )
 
 // CurrentDateTime returns the current date and time in the specified timezone
@@ -28,22 +26,23 @@ func CurrentDateTime(options ParseOptions) (*TimeResult, error) {
 // TimeDifference calculates the time difference between two timestamps
 func TimeDifference(timestamp1, timestamp2 string, options ParseOptions) (*DurationResult, error) {
 	// Parse both timestamps
-	t1, err := ParseTimestamp(timestamp1, options)
+	parsed1, err := parseTimestampDetailed(timestamp1, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse timestamp1: %w", err)
 	}
 	
-	t2, err := ParseTimestamp(timestamp2, options)
+	parsed2, err := parseTimestampDetailed(timestamp2, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse timestamp2: %w", err)
 	}
+	t1, t2 := parsed1.Time, parsed2.Time
 	
 	// Calculate difference
 	diff := t2.Sub(t1)
 	seconds := diff.Seconds()
 	
 	// Format human-readable duration
-	humanText := formatDurationWithHumanize(t1, t2, "full", options.Timezone)
+	humanText := formatRelativeDuration(t1, t2, options.Timezone)
 	
 	return &DurationResult{
 		HumanReadable:      humanText,
@@ -52,16 +51,18 @@ func TimeDifference(timestamp1, timestamp2 string, options ParseOptions) (*Durat
 		StartTime:         t1,
 		EndTime:           t2,
 		Timezone:          options.Timezone,
+		LocalTimeNotes:    localTimeNotes(parsed1, parsed2),
 	}, nil
 }
 
 // TimeSince calculates the time elapsed since a given timestamp until now
 func TimeSince(timestamp string, options ParseOptions) (*DurationResult, error) {
 	// Parse the timestamp
-	t, err := ParseTimestamp(timestamp, options)
+	parsed, err := parseTimestampDetailed(timestamp, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse timestamp: %w", err)
 	}
+	t := parsed.Time
 	
 	// Get current time in the specified timezone
 	loc, err := time.LoadLocation(options.Timezone)
@@ -76,7 +77,7 @@ func TimeSince(timestamp string, options ParseOptions) (*DurationResult, error)
 	seconds := diff.Seconds()
 	
 	// Format human-readable duration
-	humanText := formatDurationWithHumanize(t, now, "full", options.Timezone)
+	humanText := formatRelativeDuration(t, now, options.Timezone)
 	
 	return &DurationResult{
 		HumanReadable:      humanText,
@@ -85,14 +86,24 @@ func TimeSince(timestamp string, options ParseOptions) (*DurationResult, error)
 		StartTime:         t,
 		EndTime:           now,
 		Timezone:          options.Timezone,
+		LocalTimeNotes:    localTimeNotes(parsed),
 	}, nil
 }
 
 // ParseTimestamp parses a timestamp string in standard formats
 func ParseTimestamp(timestamp string, options ParseOptions) (time.Time, error) {
+	parsed, err := parseTimestampDetailed(timestamp, options)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.Time, nil
+}
+
+// parseTimestampDetailed is ParseTimestamp with DST gap/overlap resolution details
+func parseTimestampDetailed(timestamp string, options ParseOptions) (*ParsedTimestamp, error) {
 	loc, err := time.LoadLocation(options.Timezone)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid timezone: %w", err)
+		return nil, fmt.Errorf("invalid timezone: %w", err)
 	}
 	
 	timestamp = strings.TrimSpace(timestamp)
@@ -101,153 +112,92 @@ func ParseTimestamp(timestamp string, options ParseOptions) (time.Time, error) {
 	// Full ISO 8601 with timezone
 	if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
 		// Convert to requested timezone
-		return t.In(loc), nil
+		return &ParsedTimestamp{Time: t.In(loc)}, nil
 	}
 	
 	// ISO 8601 with nanoseconds
 	if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
-		return t.In(loc), nil
+		return &ParsedTimestamp{Time: t.In(loc)}, nil
 	}
 	
-	// ISO 8601 without timezone (assume provided timezone)
-	if t, err := time.ParseInLocation("2006-01-02T15:04:05", timestamp, loc); err == nil {
-		return t, nil
-	}
-	
-	// ISO 8601 with milliseconds without timezone
-	if t, err := time.ParseInLocation("2006-01-02T15:04:05.000", timestamp, loc); err == nil {
-		return t, nil
-	}
-	
-	// Try full timestamp format (backward compatibility)
-	if t, err := time.ParseInLocation("2006-01-02 15:04:05", timestamp, loc); err == nil {
-		return t, nil
-	}
-	
-	// Try date-only format
-	if t, err := time.ParseInLocation("2006-01-02", timestamp, loc); err == nil {
-		return t, nil
+	// Local formats are parsed as plain wall-clock readings and then placed in the
+	// provided timezone, so DST gaps and overlaps follow the configured policies
+	localLayouts := []string{
+		"2006-01-02T15:04:05",     // ISO 8601 without timezone
+		"2006-01-02T15:04:05.000", // ISO 8601 with milliseconds without timezone
+		"2006-01-02 15:04:05",     // full timestamp format (backward compatibility)
+		"2006-01-02",              // date-only format
 	}
 	
 	// Try with timezone suffix (ignore it, use provided timezone)
+	candidates := []string{timestamp}
 	parts := strings.Fields(timestamp)
 	if len(parts) == 3 && len(parts[2]) <= 4 {
 		// Likely has timezone suffix
-		dtStr := parts[0] + " " + parts[1]
-		if t, err := time.ParseInLocation("2006-01-02 15:04:05", dtStr, loc); err == nil {
-			return t, nil
+		candidates = append(candidates, parts[0]+" "+parts[1])
+	}
+	
+	for _, candidate := range candidates {
+		for _, layout := range localLayouts {
+			wall, err := time.Parse(layout, candidate)
+			if err != nil {
+				continue
+			}
+			t, resolution, err := ResolveLocalTime(wall, loc, options)
+			if err != nil {
+				return nil, err
+			}
+			return &ParsedTimestamp{Time: t, Resolution: resolution}, nil
 		}
 	}
 	
-	return time.Time{}, fmt.Errorf("invalid timestamp format: '%s'. Expected ISO 8601 (e.g., '2025-07-19T08:45:40.501Z'), 'YYYY-MM-DD HH:MM:SS', or 'YYYY-MM-DD'", timestamp)
+	return nil, fmt.Errorf("invalid timestamp format: '%s'. Expected ISO 8601 (e.g., '2025-07-19T08:45:40.501Z'), 'YYYY-MM-DD HH:MM:SS', or 'YYYY-MM-DD'", timestamp)
 }
 
-// formatDurationWithHumanize uses go-humanize for duration formatting with precise timestamps
-func formatDurationWithHumanize(referenceTime time.Time, targetTime time.Time, style string, timezone string) string {
-	// Get human-readable duration using go-humanize
-	fuzzyText := humanize.Time(targetTime)
-	
-	// Apply style preferences
-	switch style {
-	case "compact":
-		// For compact style, use a shorter format
-		diff := targetTime.Sub(referenceTime)
-		if diff < 0 {
-			diff = -diff
-			fuzzyText = "in " + humanize.Time(referenceTime.Add(-diff))[len("in "):]
+// localTimeNotes collects the DST resolution notes of the parsed timestamps
+func localTimeNotes(parsed ...*ParsedTimestamp) []string {
+	var notes []string
+	for _, p := range parsed {
+		if p.Resolution.Note != "" {
+			notes = append(notes, p.Resolution.Note)
 		}
-	case "minimal":
-		// For minimal style, show just the time difference
-		diff := targetTime.Sub(referenceTime)
-		fuzzyText = diff.String()
-	default: // "full" - use existing duration formatting 
-		diff := targetTime.Sub(referenceTime)
-		seconds := diff.Seconds()
-		isNegative := seconds < 0
-		if isNegative {
-			seconds = -seconds
-		}
-		fuzzyText = formatDuration(seconds, "full", isNegative)
 	}
-	
-	// Add precise timestamp in parentheses as required
-	return formatWithPreciseTimestamp(fuzzyText, targetTime, timezone)
+	return notes
+}
+
+// formatRelativeDuration renders the time from referenceTime to targetTime
+// with FormatRelativeTime's defaults, followed by the precise target time
+func formatRelativeDuration(referenceTime time.Time, targetTime time.Time, timezone string) string {
+	text := FormatRelativeTime(targetTime.Sub(referenceTime).Seconds(), RelativeTimeOptions{})
+	return formatWithPreciseTimestamp(text, targetTime, timezone)
 }
 
 // formatDuration - Legacy function for backward compatibility
 func formatDuration(seconds float64, style string, isNegative bool) string {
-	// Convert seconds to time for humanize compatibility
-	now := time.Now()
-	targetTime := now.Add(time.Duration(seconds) * time.Second)
-	if isNegative {
-		targetTime = now.Add(-time.Duration(seconds) * time.Second)
-	}
-	
-	// Use go-humanize for the basic formatting
-	fuzzyText := humanize.Time(targetTime)
-	
-	// Apply style preferences (simplified for compatibility)
+	var text string
 	switch style {
 	case "compact":
-		// Keep existing compact logic for now
-		days := int(seconds / 86400)
-		hours := int((int(seconds) % 86400) / 3600)
-		minutes := int((int(seconds) % 3600) / 60)
-		secs := int(seconds) % 60
-		var parts []string
-		if days > 0 {
-			parts = append(parts, fmt.Sprintf("%dd", days))
-		}
-		if hours > 0 {
-			parts = append(parts, fmt.Sprintf("%dh", hours))
-		}
-		if minutes > 0 {
-			parts = append(parts, fmt.Sprintf("%dm", minutes))
-		}
-		if secs > 0 || len(parts) == 0 {
-			parts = append(parts, fmt.Sprintf("%ds", secs))
-		}
-		fuzzyText = strings.Join(parts, " ")
+		text = FormatRelativeTime(seconds, RelativeTimeOptions{Short: true})
 	case "minimal":
-		// Keep existing minimal logic
 		days := int(seconds / 86400)
 		hours := int((int(seconds) % 86400) / 3600)
 		minutes := int((int(seconds) % 3600) / 60)
 		secs := int(seconds) % 60
 		if days > 0 {
-			fuzzyText = fmt.Sprintf("%d:%02d:%02d:%02d", days, hours, minutes, secs)
+			text = fmt.Sprintf("%d:%02d:%02d:%02d", days, hours, minutes, secs)
 		} else if hours > 0 {
-			fuzzyText = fmt.Sprintf("%d:%02d:%02d", hours, minutes, secs)
+			text = fmt.Sprintf("%d:%02d:%02d", hours, minutes, secs)
 		} else {
-			fuzzyText = fmt.Sprintf("%d:%02d", minutes, secs)
-		}
-	default: // "full" - maintain backward compatibility
-		// Use original logic for backward compatibility with tests
-		days := int(seconds / 86400)
-		hours := int((int(seconds) % 86400) / 3600)
-		minutes := int((int(seconds) % 3600) / 60)
-		secs := int(seconds) % 60
-		var parts []string
-		if days > 0 {
-			parts = append(parts, fmt.Sprintf("%d day%s", days, plural(days)))
-		}
-		if hours > 0 {
-			parts = append(parts, fmt.Sprintf("%d hour%s", hours, plural(hours)))
+			text = fmt.Sprintf("%d:%02d", minutes, secs)
 		}
-		if minutes > 0 {
-			parts = append(parts, fmt.Sprintf("%d minute%s", minutes, plural(minutes)))
-		}
-		if secs > 0 || len(parts) == 0 {
-			parts = append(parts, fmt.Sprintf("%d second%s", secs, plural(secs)))
-		}
-		fuzzyText = strings.Join(parts, ", ")
+	default: // "full"
+		text = FormatRelativeTime(seconds, RelativeTimeOptions{})
 	}
-	
+
 	if isNegative {
-		fuzzyText = "-" + fuzzyText
+		text = "-" + text
 	}
-	
-	return fuzzyText
+	return text
 }
 
 // formatWithPreciseTimestamp formats fuzzy output with precise timestamp in parentheses
@@ -265,14 +215,6 @@ func formatWithPreciseTimestamp(fuzzyText string, preciseTime time.Time, timezon
 	return fmt.Sprintf("%s (%s)", fuzzyText, preciseFormatted)
 }
 
-// Helper functions
-func plural(n int) string {
-	if n == 1 {
-		return ""
-	}
-	return "s"
-}
-
 // GetPopularTimezones returns the most commonly used timezones
 func GetPopularTimezones() []TimezoneInfo {
 	popularIds := []string{
@@ -304,18 +246,11 @@ func GetPopularTimezones() []TimezoneInfo {
 	}
 	
 	var result []TimezoneInfo
+	now := time.Now()
 	for _, id := range popularIds {
-		if loc, err := time.LoadLocation(id); err == nil {
-			now := time.Now().In(loc)
-			_, offset := now.Zone()
-			
-			result = append(result, TimezoneInfo{
-				ID:           id,
-				Name:         id, // Use ID as name for now
-				Offset:       offset,
-				OffsetString: FormatOffset(offset),
-				IsPopular:    true,
-			})
+		if info, err := GetTimezoneInfo(id, now); err == nil {
+			info.IsPopular = true
+			result = append(result, info)
 		}
 	}
 	
@@ -0,0 +1,201 @@
+package passageoftime
+
+import (
+	"fmt"
+	"time"
+)
+
+// ZoneTransition is a change of UTC offset or abbreviation in a timezone
+type ZoneTransition struct {
+	// At is the instant the new offset takes effect
+	At time.Time
+
+	// OffsetBefore and OffsetAfter are the UTC offsets in seconds on either side
+	OffsetBefore int
+	OffsetAfter  int
+
+	// AbbreviationBefore and AbbreviationAfter are the zone abbreviations on either side
+	AbbreviationBefore string
+	AbbreviationAfter  string
+
+	// IsDSTBefore and IsDSTAfter report daylight saving time on either side
+	IsDSTBefore bool
+	IsDSTAfter  bool
+
+	// LocalBefore is the wall-clock reading just as the old offset ends (e.g. 02:00)
+	LocalBefore time.Time
+
+	// LocalAfter is the wall-clock reading as the new offset begins (e.g. 03:00)
+	LocalAfter time.Time
+}
+
+// Change returns the offset change (positive when clocks go forward)
+func (tr ZoneTransition) Change() time.Duration {
+	return time.Duration(tr.OffsetAfter-tr.OffsetBefore) * time.Second
+}
+
+// Kind classifies the wall-clock effect: "gap" (clocks jump forward, local times
+// are skipped), "overlap" (clocks fall back, local times repeat) or "rename"
+// (only the abbreviation or DST flag changed)
+func (tr ZoneTransition) Kind() string {
+	switch {
+	case tr.OffsetAfter > tr.OffsetBefore:
+		return "gap"
+	case tr.OffsetAfter < tr.OffsetBefore:
+		return "overlap"
+	default:
+		return "rename"
+	}
+}
+
+// Description explains the transition in wall-clock terms
+func (tr ZoneTransition) Description() string {
+	const clock = "2006-01-02 15:04"
+	switch tr.Kind() {
+	case "gap":
+		return fmt.Sprintf("clocks jump forward %s: local times from %s to %s do not exist",
+			tr.Change(), tr.LocalBefore.Format(clock), tr.LocalAfter.Format("15:04"))
+	case "overlap":
+		return fmt.Sprintf("clocks fall back %s: local times from %s to %s occur twice",
+			-tr.Change(), tr.LocalAfter.Format(clock), tr.LocalBefore.Format("15:04"))
+	default:
+		return fmt.Sprintf("abbreviation changes from %s to %s with no offset change", tr.AbbreviationBefore, tr.AbbreviationAfter)
+	}
+}
+
+// TransitionReport describes a timezone's offset transitions and current DST rule
+type TransitionReport struct {
+	// Timezone is the IANA timezone identifier
+	Timezone string
+
+	// Transitions lists every transition in the requested range, oldest first
+	Transitions []ZoneTransition
+
+	// ObservesDST reports whether the zone switches to daylight saving time
+	// within a year of the reference time
+	ObservesDST bool
+
+	// StandardOffset and StandardAbbreviation describe standard time
+	StandardOffset       int
+	StandardAbbreviation string
+
+	// DSTOffset and DSTAbbreviation describe daylight saving time (if observed)
+	DSTOffset       int
+	DSTAbbreviation string
+
+	// CurrentRule is an English description of the rule in effect at the reference time
+	CurrentRule string
+}
+
+// maxTransitionsScanned guards against pathological ranges
+const maxTransitionsScanned = 10000
+
+// GetZoneTransitions lists the offset transitions of a timezone between from and to,
+// and describes the DST rule in effect around reference.
+// Transitions are found with time.Time.ZoneBounds, which walks the tz database
+// (including the POSIX rule that extends it into the future).
+func GetZoneTransitions(timezone string, from, to, reference time.Time) (*TransitionReport, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("range end must not be before range start")
+	}
+
+	report := &TransitionReport{
+		Timezone:    timezone,
+		Transitions: findTransitions(loc, from, to),
+	}
+
+	describeCurrentRule(report, loc, reference)
+	return report, nil
+}
+
+// findTransitions walks zone boundaries from from to to
+func findTransitions(loc *time.Location, from, to time.Time) []ZoneTransition {
+	var transitions []ZoneTransition
+
+	t := from.In(loc)
+	for i := 0; i < maxTransitionsScanned; i++ {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.After(to) {
+			break
+		}
+
+		before := end.Add(-time.Nanosecond).In(loc)
+		after := end.In(loc)
+		nameBefore, offsetBefore := before.Zone()
+		nameAfter, offsetAfter := after.Zone()
+
+		// The tz database records some boundaries that change nothing observable
+		if offsetBefore != offsetAfter || nameBefore != nameAfter || before.IsDST() != after.IsDST() {
+			transitions = append(transitions, ZoneTransition{
+				At:                 after,
+				OffsetBefore:       offsetBefore,
+				OffsetAfter:        offsetAfter,
+				AbbreviationBefore: nameBefore,
+				AbbreviationAfter:  nameAfter,
+				IsDSTBefore:        before.IsDST(),
+				IsDSTAfter:         after.IsDST(),
+				LocalBefore:        end.In(time.FixedZone(nameBefore, offsetBefore)),
+				LocalAfter:         after,
+			})
+		}
+		t = after
+	}
+
+	return transitions
+}
+
+// describeCurrentRule fills the DST fields of report from the transitions within
+// a year after the reference time
+func describeCurrentRule(report *TransitionReport, loc *time.Location, reference time.Time) {
+	ref := reference.In(loc)
+	name, offset := ref.Zone()
+	report.StandardAbbreviation, report.StandardOffset = name, offset
+
+	var start, end *ZoneTransition
+	upcoming := findTransitions(loc, ref, ref.AddDate(1, 0, 0))
+	for i := range upcoming {
+		tr := &upcoming[i]
+		if tr.IsDSTAfter && !tr.IsDSTBefore && start == nil {
+			start = tr
+		}
+		if tr.IsDSTBefore && !tr.IsDSTAfter && end == nil {
+			end = tr
+		}
+	}
+
+	if start == nil || end == nil {
+		if ref.IsDST() {
+			// Permanent DST (or a rule that has been abolished while in DST)
+			report.DSTAbbreviation, report.DSTOffset = name, offset
+			report.CurrentRule = fmt.Sprintf("No DST changes in the coming year; %s (%s) stays in effect", name, formatOffset(offset))
+			return
+		}
+		report.CurrentRule = fmt.Sprintf("No DST; %s (%s) all year", name, formatOffset(offset))
+		return
+	}
+
+	report.ObservesDST = true
+	report.StandardAbbreviation, report.StandardOffset = end.AbbreviationAfter, end.OffsetAfter
+	report.DSTAbbreviation, report.DSTOffset = start.AbbreviationAfter, start.OffsetAfter
+	report.CurrentRule = fmt.Sprintf("Daylight saving %s (%s) from the %s at %s local time until the %s at %s local time; otherwise %s (%s)",
+		report.DSTAbbreviation, formatOffset(report.DSTOffset),
+		describeNthWeekday(start.LocalBefore), start.LocalBefore.Format("15:04"),
+		describeNthWeekday(end.LocalBefore), end.LocalBefore.Format("15:04"),
+		report.StandardAbbreviation, formatOffset(report.StandardOffset))
+}
+
+// describeNthWeekday renders a date as e.g. "second Sunday of March" or "last Sunday of October"
+func describeNthWeekday(t time.Time) string {
+	ordinals := []string{"first", "second", "third", "fourth", "fifth"}
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	ordinal := ordinals[(t.Day()-1)/7]
+	if t.Day()+7 > daysInMonth {
+		ordinal = "last"
+	}
+	return fmt.Sprintf("%s %s of %s", ordinal, t.Weekday(), t.Month())
+}
@@ -33,6 +33,9 @@ type DurationResult struct {
 	
 	// Timezone is the timezone identifier used for calculations
 	Timezone string
+	
+	// LocalTimeNotes explains any DST gap or overlap resolved while parsing the inputs
+	LocalTimeNotes []string
 }
 
 // TimezoneInfo represents timezone information
@@ -51,6 +54,20 @@ type TimezoneInfo struct {
 	
 	// IsPopular indicates if this is a commonly used timezone
 	IsPopular bool
+	
+	// CountryCodes are the ISO 3166 codes of the countries using the zone,
+	// its primary country first; empty for zones such as UTC
+	CountryCodes []string
+	
+	// CountryNames are the names of CountryCodes, e.g. "Germany"
+	CountryNames []string
+	
+	// Latitude and Longitude locate the zone's principal city in decimal degrees
+	Latitude  float64
+	Longitude float64
+	
+	// Comment is the tz database note telling zones of a country apart
+	Comment string
 }
 
 // ParseOptions controls parsing behavior
@@ -63,4 +80,12 @@ type ParseOptions struct {
 	
 	// ReferenceTime is the reference time for relative parsing
 	ReferenceTime time.Time
+	
+	// NonexistentTime controls local times skipped by a DST gap
+	// (defaults to NonexistentShiftForward)
+	NonexistentTime NonexistentTimePolicy
+	
+	// AmbiguousTime controls local times repeated by a DST overlap
+	// (defaults to AmbiguousEarlier)
+	AmbiguousTime AmbiguousTimePolicy
 }
\ No newline at end of file
@@ -0,0 +1,139 @@
+package passageoftime
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// ZoneInfo describes a timezone at an instant and over its history
+type ZoneInfo struct {
+	// Timezone is the identifier as requested
+	Timezone string
+
+	// Canonical is the zone the identifier links to (itself if not a link)
+	Canonical string
+
+	// Aliases lists the other identifiers for the same zone
+	Aliases []string
+
+	// At is the instant examined, in the zone
+	At time.Time
+
+	// Abbreviation, Offset (seconds east of UTC) and IsDST describe the zone at At
+	Abbreviation string
+	Offset       int
+	IsDST        bool
+
+	// PeriodStart and PeriodEnd bound the span around At with the same
+	// offset and abbreviation (zero when unbounded)
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Report holds the transitions in the requested range and the DST rule
+	// in effect at At
+	Report *TransitionReport
+
+	// LastDSTEnd is the most recent switch back from daylight saving time
+	// when the zone no longer observes DST (nil otherwise)
+	LastDSTEnd *ZoneTransition
+
+	// TzdataVersion is the tz database release in use ("unknown" when it
+	// cannot be determined) and TzdataSource where it was found
+	TzdataVersion string
+	TzdataSource  string
+}
+
+// GetZoneInfo describes timezone at the instant at, with its transitions
+// between from and to
+func GetZoneInfo(timezone string, at, from, to time.Time) (*ZoneInfo, error) {
+	report, err := GetZoneTransitions(timezone, from, to, at)
+	if err != nil {
+		return nil, err
+	}
+	loc, _ := time.LoadLocation(timezone)
+	local := at.In(loc)
+	name, offset := local.Zone()
+	start, end := local.ZoneBounds()
+
+	info := &ZoneInfo{
+		Timezone:     timezone,
+		Canonical:    internal.CanonicalTimezoneID(timezone),
+		Aliases:      internal.TimezoneAliases(timezone),
+		At:           local,
+		Abbreviation: name,
+		Offset:       offset,
+		IsDST:        local.IsDST(),
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		Report:       report,
+	}
+	info.TzdataVersion, info.TzdataSource = TzdataVersion()
+
+	if !report.ObservesDST {
+		for i := len(report.Transitions) - 1; i >= 0; i-- {
+			if tr := report.Transitions[i]; tr.IsDSTBefore && !tr.IsDSTAfter {
+				info.LastDSTEnd = &tr
+				break
+			}
+		}
+	}
+	return info, nil
+}
+
+// zoneinfoDirs are the system directories Go's time package loads zones from
+// on Unix, in its search order
+var zoneinfoDirs = []string{"/usr/share/zoneinfo/", "/usr/share/lib/zoneinfo/", "/usr/lib/locale/TZ/", "/etc/zoneinfo/"}
+
+// TzdataVersion reports the tz database release time.LoadLocation reads,
+// from the "# version" header of tzdata.zi or a +VERSION file next to the
+// zone files. Go's bundled zoneinfo (used on Windows and when no system
+// database is installed) does not record its version.
+func TzdataVersion() (version, source string) {
+	dirs := zoneinfoDirs
+	if runtime.GOOS == "windows" {
+		dirs = nil
+	}
+	if env := os.Getenv("ZONEINFO"); env != "" {
+		if fi, err := os.Stat(env); err == nil && !fi.IsDir() {
+			return "unknown", env
+		}
+		dirs = append([]string{env}, dirs...)
+	}
+
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(dir, "UTC")); err != nil {
+			continue
+		}
+		if version := readTzdataVersion(filepath.Join(dir, "tzdata.zi")); version != "" {
+			return version, filepath.Join(dir, "tzdata.zi")
+		}
+		if data, err := os.ReadFile(filepath.Join(dir, "+VERSION")); err == nil {
+			return strings.TrimSpace(string(data)), filepath.Join(dir, "+VERSION")
+		}
+		return "unknown", dir
+	}
+	return "unknown", "Go's bundled zoneinfo"
+}
+
+// readTzdataVersion returns the version from a tzdata.zi header
+func readTzdataVersion(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for i := 0; i < 5 && scanner.Scan(); i++ {
+		if line := scanner.Text(); strings.HasPrefix(line, "# version ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# version "))
+		}
+	}
+	return ""
+}
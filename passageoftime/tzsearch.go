@@ -0,0 +1,316 @@
+package passageoftime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// TimezoneMatch is a zone found by SearchTimezones
+type TimezoneMatch struct {
+	// ID is the IANA timezone identifier
+	ID string
+
+	// Score ranks the match from 0 to 1; exact names score highest
+	Score float64
+
+	// Reasons says what matched, best first, e.g. `city "New York" (1 typo)`
+	Reasons []string
+}
+
+// searchEntry is one searchable name of a zone
+type searchEntry struct {
+	term   string
+	zone   string
+	label  string
+	weight float64
+	exact  bool // abbreviations and country codes only match exactly
+}
+
+// searchCities are major cities that are not the name of a zone
+var searchCities = map[string]string{
+	"Mumbai":           "Asia/Kolkata",
+	"Delhi":            "Asia/Kolkata",
+	"New Delhi":        "Asia/Kolkata",
+	"Bangalore":        "Asia/Kolkata",
+	"Bengaluru":        "Asia/Kolkata",
+	"Chennai":          "Asia/Kolkata",
+	"Hyderabad":        "Asia/Kolkata",
+	"Pune":             "Asia/Kolkata",
+	"Beijing":          "Asia/Shanghai",
+	"Shenzhen":         "Asia/Shanghai",
+	"Guangzhou":        "Asia/Shanghai",
+	"Osaka":            "Asia/Tokyo",
+	"Kyoto":            "Asia/Tokyo",
+	"Hanoi":            "Asia/Bangkok",
+	"Abu Dhabi":        "Asia/Dubai",
+	"Tel Aviv":         "Asia/Jerusalem",
+	"San Francisco":    "America/Los_Angeles",
+	"Seattle":          "America/Los_Angeles",
+	"San Diego":        "America/Los_Angeles",
+	"Las Vegas":        "America/Los_Angeles",
+	"Portland":         "America/Los_Angeles",
+	"Boston":           "America/New_York",
+	"Washington":       "America/New_York",
+	"Washington DC":    "America/New_York",
+	"Philadelphia":     "America/New_York",
+	"Atlanta":          "America/New_York",
+	"Miami":            "America/New_York",
+	"Houston":          "America/Chicago",
+	"Dallas":           "America/Chicago",
+	"Austin":           "America/Chicago",
+	"Minneapolis":      "America/Chicago",
+	"Salt Lake City":   "America/Denver",
+	"Montreal":         "America/Toronto",
+	"Ottawa":           "America/Toronto",
+	"Rio de Janeiro":   "America/Sao_Paulo",
+	"Munich":           "Europe/Berlin",
+	"Frankfurt":        "Europe/Berlin",
+	"Hamburg":          "Europe/Berlin",
+	"Cologne":          "Europe/Berlin",
+	"Milan":            "Europe/Rome",
+	"Barcelona":        "Europe/Madrid",
+	"Geneva":           "Europe/Zurich",
+	"Manchester":       "Europe/London",
+	"Edinburgh":        "Europe/London",
+	"Rotterdam":        "Europe/Amsterdam",
+	"St Petersburg":    "Europe/Moscow",
+	"Saint Petersburg": "Europe/Moscow",
+	"Wellington":       "Pacific/Auckland",
+	"Canberra":         "Australia/Sydney",
+	"Cape Town":        "Africa/Johannesburg",
+}
+
+// searchCountryAliases are common country names the tz database spells differently
+var searchCountryAliases = map[string]string{
+	"United Kingdom":           "GB",
+	"UK":                       "GB",
+	"Great Britain":            "GB",
+	"England":                  "GB",
+	"Scotland":                 "GB",
+	"Wales":                    "GB",
+	"USA":                      "US",
+	"United States of America": "US",
+	"South Korea":              "KR",
+	"North Korea":              "KP",
+	"Czechia":                  "CZ",
+	"Holland":                  "NL",
+	"UAE":                      "AE",
+	"Ivory Coast":              "CI",
+	"Burma":                    "MM",
+	"Swaziland":                "SZ",
+}
+
+var (
+	searchIndex     []searchEntry
+	searchIndexOnce sync.Once
+)
+
+// normalizeSearchText lowercases s and turns ID separators and punctuation
+// into single spaces
+func normalizeSearchText(s string) string {
+	s = strings.NewReplacer("_", " ", "/", " ", "-", " ", ".", " ", ",", " ", "(", " ", ")", " ", "&", " and ").Replace(strings.ToLower(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// buildSearchIndex collects the IDs, cities, countries, tz comments,
+// abbreviations and Windows names of every zone
+func buildSearchIndex() []searchEntry {
+	var entries []searchEntry
+	add := func(name, zone, label string, weight float64, exact bool) {
+		if term := normalizeSearchText(name); term != "" {
+			entries = append(entries, searchEntry{term: term, zone: zone, label: label, weight: weight, exact: exact})
+		}
+	}
+
+	year := time.Now().Year()
+	abbreviations := make(map[string]bool)
+	for _, id := range GetAllTimezoneIDs() {
+		add(id, id, fmt.Sprintf("ID %q", id), 1, false)
+		if i := strings.LastIndex(id, "/"); i >= 0 {
+			city := strings.ReplaceAll(id[i+1:], "_", " ")
+			add(city, id, fmt.Sprintf("city %q", city), 0.95, false)
+		}
+
+		loc, err := time.LoadLocation(id)
+		if err != nil {
+			continue
+		}
+		for _, month := range []time.Month{time.January, time.July} {
+			abbr, _ := time.Date(year, month, 1, 12, 0, 0, 0, loc).Zone()
+			if abbr == "" || strings.ContainsAny(abbr[:1], "+-0123456789") || abbreviations[abbr+" "+id] {
+				continue
+			}
+			abbreviations[abbr+" "+id] = true
+			add(abbr, id, fmt.Sprintf("abbreviation %s", abbr), 0.8, true)
+		}
+	}
+
+	for city, zone := range searchCities {
+		add(city, zone, fmt.Sprintf("city %q", city), 0.9, false)
+	}
+
+	for windows, zone := range internal.WindowsToIANA {
+		label := fmt.Sprintf("Windows zone %q", windows)
+		add(windows, zone, label, 0.95, false)
+		if canonical := internal.CanonicalTimezoneID(zone); canonical != zone {
+			add(windows, canonical, label, 0.95, false)
+		}
+	}
+
+	countryAliases := make(map[string][]string)
+	for alias, code := range searchCountryAliases {
+		countryAliases[code] = append(countryAliases[code], alias)
+	}
+	for _, code := range internal.CountryCodes() {
+		name, _ := internal.CountryName(code)
+		names := append([]string{name}, countryAliases[code]...)
+		if i := strings.Index(name, " ("); i > 0 {
+			names = append(names, name[:i])
+		}
+		for _, zone := range internal.CountryTimezones(code) {
+			if location, _ := internal.TimezoneLocation(zone); location.Comment != "" && location.CountryCodes[0] == code {
+				add(location.Comment, zone, fmt.Sprintf("tz comment %q", location.Comment), 0.7, false)
+			}
+			weight := 0.85
+			label := fmt.Sprintf("country %s (%s)", name, code)
+			if internal.TimezoneCountries(zone)[0] != code {
+				weight = 0.75
+				label = fmt.Sprintf("also used in %s (%s)", name, code)
+			}
+			for _, n := range names {
+				add(n, zone, label, weight, false)
+			}
+			add(code, zone, label, weight, true)
+		}
+	}
+
+	return entries
+}
+
+// SearchTimezones finds zones by IANA ID, city, country name or ISO code,
+// abbreviation (e.g. "PST") or Windows zone name, tolerating typos in longer
+// names. Results are ranked by score, then popular zones, then canonical IDs
+// before aliases.
+func SearchTimezones(query string) []TimezoneMatch {
+	searchIndexOnce.Do(func() { searchIndex = buildSearchIndex() })
+
+	q := normalizeSearchText(query)
+	if q == "" {
+		return nil
+	}
+	raw := strings.ToLower(strings.TrimSpace(query))
+
+	type reason struct {
+		text  string
+		score float64
+	}
+	found := make(map[string][]reason)
+	note := func(zone, text string, score float64) {
+		for _, r := range found[zone] {
+			if r.text == text {
+				return
+			}
+		}
+		found[zone] = append(found[zone], reason{text, score})
+	}
+
+	for _, e := range searchIndex {
+		switch {
+		case e.term == q:
+			note(e.zone, e.label, e.weight)
+		case e.exact:
+		case len(q) >= 3 && strings.HasPrefix(e.term, q):
+			note(e.zone, e.label+" (prefix)", e.weight*0.8)
+		case len(q) >= 4:
+			if d := searchTypos(q, e.term); d == 1 {
+				note(e.zone, e.label+" (1 typo)", e.weight*0.8)
+			} else if d > 1 {
+				note(e.zone, fmt.Sprintf("%s (%d typos)", e.label, d), e.weight*0.65)
+			}
+		}
+	}
+	for _, id := range GetAllTimezoneIDs() {
+		if _, ok := found[id]; ok {
+			continue
+		}
+		if strings.Contains(strings.ToLower(id), raw) || strings.Contains(normalizeSearchText(id), q) {
+			note(id, fmt.Sprintf("ID contains %q", strings.TrimSpace(query)), 0.5)
+		}
+	}
+
+	popular := make(map[string]bool)
+	for _, id := range GetPopularTimezoneIDs() {
+		popular[id] = true
+	}
+
+	matches := make([]TimezoneMatch, 0, len(found))
+	for zone, reasons := range found {
+		sort.SliceStable(reasons, func(i, j int) bool { return reasons[i].score > reasons[j].score })
+		m := TimezoneMatch{ID: zone, Score: math.Round(reasons[0].score*100) / 100}
+		for _, r := range reasons {
+			m.Reasons = append(m.Reasons, r.text)
+		}
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if popular[a.ID] != popular[b.ID] {
+			return popular[a.ID]
+		}
+		aCanonical, bCanonical := internal.CanonicalTimezoneID(a.ID) == a.ID, internal.CanonicalTimezoneID(b.ID) == b.ID
+		if aCanonical != bCanonical {
+			return aCanonical
+		}
+		return a.ID < b.ID
+	})
+	return matches
+}
+
+// searchTypos returns the number of edits (insertions, deletions,
+// substitutions and adjacent transpositions) between query and term when it
+// is small enough to be a typo: 1 for terms up to 7 characters, 2 beyond.
+// It returns 0 when they are equal or too far apart.
+func searchTypos(query, term string) int {
+	a, b := []rune(query), []rune(term)
+	limit := 1
+	if len(b) > 7 {
+		limit = 2
+	}
+	if len(a)-len(b) > limit || len(b)-len(a) > limit {
+		return 0
+	}
+
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	if d := prev[len(b)]; d <= limit {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+package passageoftime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/internal"
+)
+
+// ZoneIDConversion is a Windows time zone ID matched with IANA zones
+// through the Unicode CLDR windowsZones mapping
+type ZoneIDConversion struct {
+	// Windows is the Windows time zone ID, e.g. "W. Europe Standard Time"
+	Windows string
+
+	// IANA is the preferred IANA zone, as CLDR spells it
+	IANA string
+
+	// IANAZones are all the zones CLDR lists for the territory, IANA first
+	IANAZones []string
+
+	// Canonical is the current tz database name of IANA, which differs for
+	// older names CLDR keeps such as Asia/Calcutta (now Asia/Kolkata)
+	Canonical string
+
+	// Territory is the ISO 3166 code of the mapping used, "001" for the
+	// Windows zone's default or "ZZ" for fixed-offset Etc zones
+	Territory string
+
+	// TerritoryName names Territory when it is a country
+	TerritoryName string
+
+	// Fallback is set when the requested territory has no entry of its own
+	// and the default "001" zone was used
+	Fallback bool
+}
+
+// IsWindowsZoneID reports whether id is a Windows time zone ID known to
+// CLDR, ignoring case
+func IsWindowsZoneID(id string) bool {
+	_, ok := internal.LookupWindowsZoneID(id)
+	return ok
+}
+
+// WindowsToIANAZone converts a Windows time zone ID to IANA zones. territory
+// is an optional ISO 3166 code selecting the country's zone, e.g. "Romance
+// Standard Time" is Europe/Paris by default but Europe/Brussels in BE.
+func WindowsToIANAZone(windows, territory string) (*ZoneIDConversion, error) {
+	id, ok := internal.LookupWindowsZoneID(windows)
+	if !ok {
+		return nil, fmt.Errorf("unknown Windows time zone ID '%s' (e.g. 'W. Europe Standard Time', 'Eastern Standard Time')", windows)
+	}
+	territory, err := normalizeTerritory(territory)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, used, _ := internal.WindowsToIANAForTerritory(id, territory)
+	conversion := &ZoneIDConversion{
+		Windows:   id,
+		IANA:      zones[0],
+		IANAZones: zones,
+		Canonical: internal.CanonicalTimezoneID(zones[0]),
+		Territory: used,
+		Fallback:  territory != "" && used != territory,
+	}
+	conversion.TerritoryName, _ = internal.CountryName(used)
+	return conversion, nil
+}
+
+// IANAToWindowsZone converts an IANA zone, or any of its aliases, to the
+// Windows time zone ID and territory CLDR lists it under. territory is an
+// optional ISO 3166 code selecting that country's entry; it is an error when
+// the country's entries do not include the zone.
+func IANAToWindowsZone(iana, territory string) (*ZoneIDConversion, error) {
+	iana = strings.TrimSpace(iana)
+	if _, err := time.LoadLocation(iana); err != nil || iana == "" || iana == "Local" {
+		return nil, fmt.Errorf("invalid IANA timezone '%s'", iana)
+	}
+	territory, err := normalizeTerritory(territory)
+	if err != nil {
+		return nil, err
+	}
+	mapping, ok := internal.IANAToWindows(iana, territory)
+	if !ok {
+		if territory != "" {
+			return nil, fmt.Errorf("CLDR has no Windows time zone for '%s' in territory %s", iana, territory)
+		}
+		return nil, fmt.Errorf("CLDR has no Windows time zone for '%s'", iana)
+	}
+
+	conversion := &ZoneIDConversion{
+		Windows:   mapping.Windows,
+		IANA:      iana,
+		IANAZones: mapping.IANA,
+		Canonical: internal.CanonicalTimezoneID(iana),
+		Territory: mapping.Territory,
+	}
+	conversion.TerritoryName, _ = internal.CountryName(mapping.Territory)
+	return conversion, nil
+}
+
+// normalizeTerritory upper-cases an optional ISO 3166 code and rejects
+// unknown ones; "001" and "ZZ" are CLDR's default and fixed-offset entries
+func normalizeTerritory(territory string) (string, error) {
+	territory = strings.ToUpper(strings.TrimSpace(territory))
+	if territory != "" {
+		if _, known := internal.CountryName(territory); !known && territory != "001" && territory != "ZZ" {
+			return "", fmt.Errorf("unknown territory '%s' (expected an ISO 3166 alpha-2 code such as US or DE)", territory)
+		}
+	}
+	return territory, nil
+}
@@ -0,0 +1,322 @@
+package passageoftime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ClockRange is a span of wall-clock time within a day, in minutes after
+// local midnight. End is after Start and may exceed 24:00 for a range that
+// crosses midnight.
+type ClockRange struct {
+	Start int
+	End   int
+}
+
+// String renders the range as "HH:MM-HH:MM"
+func (r ClockRange) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", r.Start/60, r.Start%60, r.End/60%24, r.End%60)
+}
+
+// ParseClockRange parses a range such as "09:00-17:00" or "22:00-06:00" (which
+// crosses midnight)
+func ParseClockRange(text string) (ClockRange, error) {
+	parts := strings.Split(strings.TrimSpace(text), "-")
+	if len(parts) != 2 {
+		return ClockRange{}, fmt.Errorf("invalid time range '%s', expected HH:MM-HH:MM", text)
+	}
+	start, err := ParseClock(parts[0])
+	if err != nil {
+		return ClockRange{}, err
+	}
+	end, err := ParseClock(parts[1])
+	if err != nil {
+		return ClockRange{}, err
+	}
+	if end <= start {
+		end += 24 * 60
+	}
+	return ClockRange{Start: start, End: end}, nil
+}
+
+// WorkingHours maps each weekday to its working ranges; weekdays without an
+// entry are days off
+type WorkingHours map[time.Weekday][]ClockRange
+
+// DefaultWorkingHours returns Monday through Friday, 09:00-17:00
+func DefaultWorkingHours() WorkingHours {
+	hours := WorkingHours{}
+	for d := time.Monday; d <= time.Friday; d++ {
+		hours[d] = []ClockRange{{Start: 9 * 60, End: 17 * 60}}
+	}
+	return hours
+}
+
+var workingHoursEntry = regexp.MustCompile(`^\s*([A-Za-z,\s-]+?)\s*[:=]?\s+(\d.*)$`)
+
+// ParseWorkingHours reads a schedule written one entry per weekday group, e.g.
+// "mon-fri 09:00-17:00", "sat 10:00-14:00" or "wed 08:00-12:00, 13:00-17:00".
+// Days may be names, ranges ("mon-thu"), lists ("mon,wed") or "weekdays",
+// "weekends" and "daily"; ranges are separated by commas or spaces. A later
+// entry replaces the hours of an earlier one for the same day, and an entry
+// of "off" marks the days as non-working.
+func ParseWorkingHours(entries []string) (WorkingHours, error) {
+	hours := WorkingHours{}
+	for _, entry := range entries {
+		text := strings.TrimSpace(entry)
+		var daysText, rangesText string
+		if fields := strings.Fields(text); len(fields) == 2 && strings.EqualFold(fields[1], "off") {
+			daysText, rangesText = fields[0], ""
+		} else if m := workingHoursEntry.FindStringSubmatch(text); m != nil {
+			daysText, rangesText = m[1], m[2]
+		} else {
+			return nil, fmt.Errorf("invalid working hours '%s', expected e.g. 'mon-fri 09:00-17:00'", entry)
+		}
+
+		days, err := parseWeekdaySet(daysText)
+		if err != nil {
+			return nil, fmt.Errorf("invalid working hours '%s': %w", entry, err)
+		}
+		var ranges []ClockRange
+		for _, rangeText := range strings.FieldsFunc(rangesText, func(r rune) bool { return r == ',' || r == ' ' }) {
+			r, err := ParseClockRange(rangeText)
+			if err != nil {
+				return nil, fmt.Errorf("invalid working hours '%s': %w", entry, err)
+			}
+			ranges = append(ranges, r)
+		}
+		for _, d := range days {
+			if len(ranges) == 0 {
+				delete(hours, d)
+			} else {
+				hours[d] = ranges
+			}
+		}
+	}
+	return hours, nil
+}
+
+// parseWeekdaySet parses weekday names, ranges and lists such as "mon-fri",
+// "mon,wed,fri" or "weekdays"
+func parseWeekdaySet(text string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, part := range strings.Split(text, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		switch part {
+		case "weekdays":
+			days = append(days, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+			continue
+		case "weekends", "weekend":
+			days = append(days, time.Saturday, time.Sunday)
+			continue
+		case "daily", "everyday", "all":
+			for d := time.Sunday; d <= time.Saturday; d++ {
+				days = append(days, d)
+			}
+			continue
+		}
+
+		bounds := strings.Split(part, "-")
+		first, err := ParseWeekday(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(bounds) == 1 {
+			days = append(days, first)
+			continue
+		}
+		last, err := ParseWeekday(bounds[len(bounds)-1])
+		if err != nil || len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid weekday range '%s'", part)
+		}
+		// Ranges wrap around the week, so "fri-mon" is Friday to Monday
+		for d := first; ; d = (d + 1) % 7 {
+			days = append(days, d)
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// WorkingTimeOptions controls WorkingTimeBetween, AddWorkingTime and AddWorkingDays
+type WorkingTimeOptions struct {
+	// Start and End bound the period to measure (deadlines count from Start)
+	Start time.Time
+	End   time.Time
+
+	// Location is the timezone the working hours are kept in
+	Location *time.Location
+
+	// Hours is the weekly schedule (default DefaultWorkingHours)
+	Hours WorkingHours
+
+	// Breaks are daily unpaid ranges, such as a lunch break, removed from
+	// every working day
+	Breaks []ClockRange
+
+	// Holidays are dates (taken in Location) with no working time
+	Holidays []time.Time
+
+	// Pauses are periods when the clock is stopped, such as while waiting on
+	// a customer
+	Pauses []Interval
+}
+
+// normalized returns the options with defaults applied and holidays keyed by date
+func (o WorkingTimeOptions) normalized() (WorkingTimeOptions, map[string]bool) {
+	if o.Location == nil {
+		o.Location = time.UTC
+	}
+	if o.Hours == nil {
+		o.Hours = DefaultWorkingHours()
+	}
+	holidays := map[string]bool{}
+	for _, h := range o.Holidays {
+		holidays[h.In(o.Location).Format("2006-01-02")] = true
+	}
+	return o, holidays
+}
+
+// dayWindows returns the working periods that belong to day (local midnight),
+// less breaks and pauses
+func (o WorkingTimeOptions) dayWindows(day time.Time) []Interval {
+	ranges := o.Hours[day.Weekday()]
+	if len(ranges) == 0 {
+		return nil
+	}
+	var windows []Interval
+	for _, r := range ranges {
+		windows = append(windows, Interval{Start: clockOnDay(day, r.Start, o.Location), End: clockOnDay(day, r.End, o.Location)})
+	}
+	stops := append([]Interval{}, o.Pauses...)
+	for _, r := range o.Breaks {
+		stops = append(stops, Interval{Start: clockOnDay(day, r.Start, o.Location), End: clockOnDay(day, r.End, o.Location)})
+	}
+	return SubtractIntervals(windows, stops)
+}
+
+// firstDay returns local midnight of the day before t, the earliest day whose
+// ranges (crossing midnight) can include t
+func firstDay(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, loc)
+}
+
+// WorkingDay is the working time counted on one day
+type WorkingDay struct {
+	// Date is local midnight of the day the working ranges belong to
+	Date time.Time
+
+	// Intervals are the counted working periods
+	Intervals []Interval
+
+	// Worked is the total of Intervals
+	Worked time.Duration
+
+	// Holiday is set when the day's working hours were skipped as a holiday
+	Holiday bool
+}
+
+// WorkingTime is the result of WorkingTimeBetween
+type WorkingTime struct {
+	// Total is the working time between the instants
+	Total time.Duration
+
+	// Days breaks Total down by day, covering days with scheduled hours
+	Days []WorkingDay
+}
+
+// WorkingTimeBetween counts the working time between two instants: the parts
+// of the period inside the weekly working hours, less breaks, pauses and
+// holidays. A range that crosses midnight belongs to the day it starts on.
+func WorkingTimeBetween(options WorkingTimeOptions) (*WorkingTime, error) {
+	if options.End.Before(options.Start) {
+		return nil, fmt.Errorf("end %s is before start %s", options.End.Format(time.RFC3339), options.Start.Format(time.RFC3339))
+	}
+	options, holidays := options.normalized()
+
+	period := Interval{Start: options.Start, End: options.End}
+	result := &WorkingTime{}
+	for day := firstDay(options.Start, options.Location); day.Before(options.End); day = day.AddDate(0, 0, 1) {
+		counted := IntersectIntervals(options.dayWindows(day), []Interval{period})
+		if len(counted) == 0 {
+			continue
+		}
+
+		workingDay := WorkingDay{Date: day}
+		if holidays[day.Format("2006-01-02")] {
+			workingDay.Holiday = true
+		} else {
+			workingDay.Intervals = counted
+			workingDay.Worked = TotalDuration(counted)
+			result.Total += workingDay.Worked
+		}
+		result.Days = append(result.Days, workingDay)
+	}
+	return result, nil
+}
+
+// maxDeadlineDays bounds the search for a deadline, so a schedule with
+// little or no working time fails instead of looping
+const maxDeadlineDays = 3660
+
+// AddWorkingTime returns the instant when amount of working time has passed
+// after options.Start under the schedule, breaks, pauses and holidays
+// (options.End is ignored). A zero amount returns the start itself.
+func AddWorkingTime(options WorkingTimeOptions, amount time.Duration) (time.Time, error) {
+	if amount < 0 {
+		return time.Time{}, fmt.Errorf("working time to add must not be negative")
+	}
+	options, holidays := options.normalized()
+	if amount == 0 {
+		return options.Start, nil
+	}
+
+	remaining := amount
+	day := firstDay(options.Start, options.Location)
+	for i := 0; i < maxDeadlineDays; i, day = i+1, day.AddDate(0, 0, 1) {
+		if holidays[day.Format("2006-01-02")] {
+			continue
+		}
+		for _, iv := range options.dayWindows(day) {
+			if !iv.End.After(options.Start) {
+				continue
+			}
+			if iv.Start.Before(options.Start) {
+				iv.Start = options.Start
+			}
+			if d := iv.Duration(); d < remaining {
+				remaining -= d
+				continue
+			}
+			return iv.Start.Add(remaining), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no deadline within %d days; check the working hours and holidays", maxDeadlineDays)
+}
+
+// AddWorkingDays returns the end of the working hours on the nth working day
+// after the day options.Start falls on (options.End is ignored). Days without
+// working hours and holidays are skipped.
+func AddWorkingDays(options WorkingTimeOptions, n int) (time.Time, error) {
+	if n < 1 {
+		return time.Time{}, fmt.Errorf("working days to add must be at least 1")
+	}
+	options, holidays := options.normalized()
+
+	day := firstDay(options.Start, options.Location).AddDate(0, 0, 2)
+	for i := 0; i < maxDeadlineDays; i, day = i+1, day.AddDate(0, 0, 1) {
+		windows := options.dayWindows(day)
+		if len(windows) == 0 || holidays[day.Format("2006-01-02")] {
+			continue
+		}
+		if n--; n == 0 {
+			return windows[len(windows)-1].End, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no deadline within %d days; check the working hours and holidays", maxDeadlineDays)
+}
@@ -0,0 +1,223 @@
+package passageoftime
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ZoneGroupsEnv names the environment variable holding user-defined zone groups,
+// formatted as "team=Europe/Berlin,America/New_York;ops=UTC,Asia/Tokyo".
+const ZoneGroupsEnv = "PASSAGE_OF_TIME_ZONE_GROUPS"
+
+// builtinZoneGroups are the named groups available without configuration
+var builtinZoneGroups = map[string][]string{
+	"us": {
+		"America/New_York",
+		"America/Chicago",
+		"America/Denver",
+		"America/Phoenix",
+		"America/Los_Angeles",
+		"America/Anchorage",
+		"Pacific/Honolulu",
+	},
+	"americas": {
+		"America/Los_Angeles",
+		"America/Denver",
+		"America/Chicago",
+		"America/New_York",
+		"America/Mexico_City",
+		"America/Bogota",
+		"America/Sao_Paulo",
+		"America/Argentina/Buenos_Aires",
+	},
+	"europe": {
+		"Europe/London",
+		"Europe/Lisbon",
+		"Europe/Paris",
+		"Europe/Berlin",
+		"Europe/Helsinki",
+		"Europe/Istanbul",
+		"Europe/Moscow",
+	},
+	"apac": {
+		"Asia/Kolkata",
+		"Asia/Singapore",
+		"Asia/Shanghai",
+		"Asia/Tokyo",
+		"Australia/Sydney",
+		"Pacific/Auckland",
+	},
+}
+
+// ZoneTime is one instant rendered in one timezone
+type ZoneTime struct {
+	// Timezone is the IANA timezone identifier
+	Timezone string
+
+	// Local is the instant in this timezone
+	Local time.Time
+
+	// Offset is the UTC offset in seconds at this instant
+	Offset int
+
+	// OffsetString is the formatted offset (e.g., "+05:30")
+	OffsetString string
+
+	// Abbreviation is the zone abbreviation in effect (e.g., "CEST")
+	Abbreviation string
+
+	// IsDST reports whether daylight saving time is in effect
+	IsDST bool
+
+	// DayShift is the local calendar date relative to the reference timezone,
+	// in days; it reaches ±2 between the far ends of the offset range (e.g.
+	// Pacific/Kiritimati at UTC+14 against Pacific/Pago_Pago at UTC-11)
+	DayShift int
+}
+
+// DayShiftLabel describes DayShift in words
+func (z ZoneTime) DayShiftLabel() string {
+	switch z.DayShift {
+	case -1:
+		return "previous day"
+	case 0:
+		return "same day"
+	case 1:
+		return "next day"
+	default:
+		return fmt.Sprintf("%+d days", z.DayShift)
+	}
+}
+
+// ConvertTimezones renders one instant in each of the given timezones.
+// Day shifts are computed against the calendar date in the reference timezone.
+func ConvertTimezones(t time.Time, zones []string, reference *time.Location) ([]ZoneTime, error) {
+	if reference == nil {
+		reference = t.Location()
+	}
+	ref := t.In(reference)
+	refDate := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+
+	results := make([]ZoneTime, 0, len(zones))
+	for _, zone := range zones {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone '%s': %w", zone, err)
+		}
+
+		local := t.In(loc)
+		abbreviation, offset := local.Zone()
+		localDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+		results = append(results, ZoneTime{
+			Timezone:     zone,
+			Local:        local,
+			Offset:       offset,
+			OffsetString: formatOffset(offset),
+			Abbreviation: abbreviation,
+			IsDST:        local.IsDST(),
+			DayShift:     int(localDate.Sub(refDate).Hours() / 24),
+		})
+	}
+
+	return results, nil
+}
+
+// GetZoneGroup returns the timezones in a named group.
+// User-defined groups from ZoneGroupsEnv take precedence over the built-in
+// groups (us, americas, europe, apac, popular).
+func GetZoneGroup(name string) ([]string, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+
+	if zones, ok := parseZoneGroups(os.Getenv(ZoneGroupsEnv))[key]; ok {
+		return zones, nil
+	}
+	if key == "popular" {
+		return GetPopularTimezoneIDs(), nil
+	}
+	if zones, ok := builtinZoneGroups[key]; ok {
+		return append([]string(nil), zones...), nil
+	}
+
+	return nil, fmt.Errorf("unknown timezone group '%s' (available: %s)", name, strings.Join(GetZoneGroupNames(), ", "))
+}
+
+// GetZoneGroupNames lists every available group name, sorted
+func GetZoneGroupNames() []string {
+	names := []string{"popular"}
+	for name := range builtinZoneGroups {
+		names = append(names, name)
+	}
+	for name := range parseZoneGroups(os.Getenv(ZoneGroupsEnv)) {
+		if _, builtin := builtinZoneGroups[name]; !builtin && name != "popular" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseZoneGroups parses "name=Zone,Zone;name=Zone" group definitions
+func parseZoneGroups(value string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, definition := range strings.Split(value, ";") {
+		name, list, ok := strings.Cut(definition, "=")
+		if !ok {
+			continue
+		}
+		var zones []string
+		for _, zone := range strings.Split(list, ",") {
+			if zone = strings.TrimSpace(zone); zone != "" {
+				zones = append(zones, zone)
+			}
+		}
+		if len(zones) > 0 {
+			groups[strings.ToLower(strings.TrimSpace(name))] = zones
+		}
+	}
+	return groups
+}
+
+// RenderZoneTable renders converted times as an aligned plain-text table
+func RenderZoneTable(rows []ZoneTime) string {
+	header := []string{"Zone", "Local time", "Offset", "Abbr", "Day"}
+	cells := [][]string{header}
+	for _, row := range rows {
+		day := "same"
+		if row.DayShift != 0 {
+			day = fmt.Sprintf("%+d", row.DayShift)
+		}
+		cells = append(cells, []string{
+			row.Timezone,
+			row.Local.Format("Mon 2006-01-02 15:04"),
+			row.OffsetString,
+			row.Abbreviation,
+			day,
+		})
+	}
+
+	widths := make([]int, len(header))
+	for _, line := range cells {
+		for i, cell := range line {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, line := range cells {
+		for i, cell := range line {
+			if i == len(line)-1 {
+				b.WriteString(cell)
+			} else {
+				b.WriteString(fmt.Sprintf("%-*s  ", widths[i], cell))
+			}
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
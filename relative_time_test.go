@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestFormatRelativeTime tests unit limits, rounding, phrasing and approximate wording
+func TestFormatRelativeTime(t *testing.T) {
+	german, _ := passageoftime.GetLocale("de")
+	tests := []struct {
+		name    string
+		seconds float64
+		options passageoftime.RelativeTimeOptions
+		want    string
+	}{
+		{"defaults match full style", 93784, passageoftime.RelativeTimeOptions{}, "1 day, 2 hours, 3 minutes, 4 seconds"},
+		{"signed negative", -3600, passageoftime.RelativeTimeOptions{}, "-1 hour"},
+		{"two units", 93784, passageoftime.RelativeTimeOptions{MaxUnits: 2}, "1 day, 2 hours"},
+		{"approximate when units dropped", 93784, passageoftime.RelativeTimeOptions{MaxUnits: 2, Approximate: true}, "about 1 day, 2 hours"},
+		{"exact is not approximate", 7200, passageoftime.RelativeTimeOptions{MaxUnits: 1, Approximate: true}, "2 hours"},
+		{"weeks ago", -1987200, passageoftime.RelativeTimeOptions{LargestUnit: passageoftime.UnitWeek, MaxUnits: 1, Approximate: true, Phrasing: passageoftime.PhrasingRelative}, "about 3 weeks ago"},
+		{"years", 400 * 86400, passageoftime.RelativeTimeOptions{LargestUnit: passageoftime.UnitYear, MaxUnits: 1}, "1 year"},
+		{"nearest carries", 3599.6, passageoftime.RelativeTimeOptions{SmallestUnit: passageoftime.UnitMinute, Rounding: passageoftime.RoundNearest}, "1 hour"},
+		{"ceil", 61, passageoftime.RelativeTimeOptions{SmallestUnit: passageoftime.UnitMinute, Rounding: passageoftime.RoundCeil}, "2 minutes"},
+		{"floor below smallest unit", 59, passageoftime.RelativeTimeOptions{SmallestUnit: passageoftime.UnitMinute}, "0 minutes"},
+		{"relative zero", 0.4, passageoftime.RelativeTimeOptions{Phrasing: passageoftime.PhrasingRelative}, "now"},
+		{"short future", 7500, passageoftime.RelativeTimeOptions{Short: true, Phrasing: passageoftime.PhrasingRelative}, "in 2h 5m"},
+		{"localized dative", 3 * 86400, passageoftime.RelativeTimeOptions{Locale: german, Phrasing: passageoftime.PhrasingRelative}, "in 3 Tagen"},
+		{"localized approximate", -1987200, passageoftime.RelativeTimeOptions{Locale: german, LargestUnit: passageoftime.UnitWeek, MaxUnits: 1, Approximate: true, Phrasing: passageoftime.PhrasingRelative}, "vor etwa 3 Wochen"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := passageoftime.FormatRelativeTime(tt.seconds, tt.options); got != tt.want {
+				t.Errorf("FormatRelativeTime(%v) = %q, want %q", tt.seconds, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHandlersRelativeTimeOptions tests the rendering options on the duration tools
+func TestHandlersRelativeTimeOptions(t *testing.T) {
+	past := time.Now().UTC().Add(-50 * time.Hour).Format("2006-01-02 15:04:05")
+	got, err := handleTimeSince(context.Background(), nil, &mcp.CallToolParamsFor[TimeSinceArgs]{Arguments: TimeSinceArgs{
+		Timestamp: past, MaxUnits: 1, Phrasing: "relative", Approximate: true,
+	}})
+	if err != nil {
+		t.Fatalf("handleTimeSince() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "formatted:about 2 days ago (") {
+		t.Errorf("handleTimeSince() = %v", text)
+	}
+
+	got, err = handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: FormatDurationArgs{
+		Seconds: 93784, Style: "compact", MaxUnits: 2, Rounding: "nearest",
+	}})
+	if err != nil {
+		t.Fatalf("handleFormatDuration() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; text != "1d 2h" {
+		t.Errorf("handleFormatDuration() = %v", text)
+	}
+
+	for _, args := range []FormatDurationArgs{
+		{Seconds: 60, LargestUnit: "hours", SmallestUnit: "days"},
+		{Seconds: 60, Rounding: "sideways"},
+		{Seconds: 60, Phrasing: "backwards"},
+		{Seconds: 60, MaxUnits: -1},
+	} {
+		if _, err := handleFormatDuration(context.Background(), nil, &mcp.CallToolParamsFor[FormatDurationArgs]{Arguments: args}); err == nil {
+			t.Errorf("handleFormatDuration(%+v) should fail", args)
+		}
+	}
+}
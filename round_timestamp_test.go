@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestRoundTimestamp tests wall-clock rounding to period boundaries
+func TestRoundTimestamp(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	sunday := time.Sunday
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		options   passageoftime.RoundOptions
+		want      string
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "floor to day in a half-hour offset zone",
+			t:         time.Date(2025, 7, 16, 3, 10, 0, 0, kolkata),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundDay},
+			want:      "2025-07-16T00:00:00+05:30",
+			wantStart: "2025-07-16T00:00:00+05:30",
+			wantEnd:   "2025-07-17T00:00:00+05:30",
+		},
+		{
+			name:      "nearest 15 minutes",
+			t:         time.Date(2025, 7, 16, 10, 8, 0, 0, ny),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundMinute, Step: 15, Mode: passageoftime.RoundNearest},
+			want:      "2025-07-16T10:15:00-04:00",
+			wantStart: "2025-07-16T10:00:00-04:00",
+			wantEnd:   "2025-07-16T10:15:00-04:00",
+		},
+		{
+			name:      "ISO week start",
+			t:         time.Date(2025, 7, 20, 18, 0, 0, 0, ny), // Sunday
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundWeek},
+			want:      "2025-07-14T00:00:00-04:00",
+			wantStart: "2025-07-14T00:00:00-04:00",
+			wantEnd:   "2025-07-21T00:00:00-04:00",
+		},
+		{
+			name:      "week starting Sunday",
+			t:         time.Date(2025, 7, 20, 18, 0, 0, 0, ny),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundWeek, WeekStart: &sunday},
+			want:      "2025-07-20T00:00:00-04:00",
+			wantStart: "2025-07-20T00:00:00-04:00",
+			wantEnd:   "2025-07-27T00:00:00-04:00",
+		},
+		{
+			name:      "fiscal quarter ceiling",
+			t:         time.Date(2025, 11, 15, 12, 0, 0, 0, ny),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundQuarter, Mode: passageoftime.RoundCeil, FiscalYearStartMonth: time.October},
+			want:      "2026-01-01T00:00:00-05:00",
+			wantStart: "2025-10-01T00:00:00-04:00",
+			wantEnd:   "2026-01-01T00:00:00-05:00",
+		},
+		{
+			name:      "ceil on a boundary stays",
+			t:         time.Date(2025, 3, 1, 0, 0, 0, 0, ny),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundMonth, Mode: passageoftime.RoundCeil},
+			want:      "2025-03-01T00:00:00-05:00",
+			wantStart: "2025-03-01T00:00:00-05:00",
+			wantEnd:   "2025-04-01T00:00:00-04:00",
+		},
+		{
+			name:      "day across spring forward is 23 hours",
+			t:         time.Date(2025, 3, 9, 12, 0, 0, 0, ny), // 11 hours after midnight, 12 before the next
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundDay, Mode: passageoftime.RoundNearest},
+			want:      "2025-03-09T00:00:00-05:00",
+			wantStart: "2025-03-09T00:00:00-05:00",
+			wantEnd:   "2025-03-10T00:00:00-04:00",
+		},
+		{
+			name:      "hour in the repeated fall-back hour",
+			t:         time.Date(2025, 11, 2, 6, 40, 0, 0, time.UTC).In(ny), // 01:40 EST, second occurrence
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundHour},
+			want:      "2025-11-02T01:00:00-05:00",
+			wantStart: "2025-11-02T01:00:00-05:00",
+			wantEnd:   "2025-11-02T02:00:00-05:00",
+		},
+		{
+			name:      "6-hour blocks are aligned to midnight",
+			t:         time.Date(2025, 7, 16, 13, 0, 0, 0, time.UTC),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundHour, Step: 6},
+			want:      "2025-07-16T12:00:00Z",
+			wantStart: "2025-07-16T12:00:00Z",
+			wantEnd:   "2025-07-16T18:00:00Z",
+		},
+		{
+			name:      "year",
+			t:         time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+			options:   passageoftime.RoundOptions{Unit: passageoftime.RoundYear},
+			want:      "2024-01-01T00:00:00Z",
+			wantStart: "2024-01-01T00:00:00Z",
+			wantEnd:   "2025-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := passageoftime.RoundTimestamp(tt.t, tt.options)
+			if err != nil {
+				t.Fatalf("RoundTimestamp() error = %v", err)
+			}
+			if s := got.Time.Format(time.RFC3339); s != tt.want {
+				t.Errorf("Time = %s, want %s", s, tt.want)
+			}
+			if s := got.PeriodStart.Format(time.RFC3339); s != tt.wantStart {
+				t.Errorf("PeriodStart = %s, want %s", s, tt.wantStart)
+			}
+			if s := got.PeriodEnd.Format(time.RFC3339); s != tt.wantEnd {
+				t.Errorf("PeriodEnd = %s, want %s", s, tt.wantEnd)
+			}
+		})
+	}
+}
+
+// TestRoundTimestampOversizedStep tests that steps beyond the enclosing period are rejected rather than overflowing
+func TestRoundTimestampOversizedStep(t *testing.T) {
+	at := time.Date(2025, 7, 16, 14, 37, 0, 0, time.UTC)
+	for _, options := range []passageoftime.RoundOptions{
+		{Unit: passageoftime.RoundSecond, Step: 1 << 55},
+		{Unit: passageoftime.RoundHour, Step: 1 << 40},
+		{Unit: passageoftime.RoundDay, Step: 32},
+		{Unit: passageoftime.RoundWeek, Step: 1 << 62},
+	} {
+		if got, err := passageoftime.RoundTimestamp(at, options); err == nil {
+			t.Errorf("RoundTimestamp(%s x%d) = %+v, want an error", options.Unit, options.Step, got)
+		}
+	}
+}
+
+// TestParseRoundUnit tests unit and step parsing
+func TestParseRoundUnit(t *testing.T) {
+	tests := []struct {
+		input   string
+		unit    passageoftime.RoundUnit
+		step    int
+		wantErr bool
+	}{
+		{"minute", passageoftime.RoundMinute, 1, false},
+		{"15 minutes", passageoftime.RoundMinute, 15, false},
+		{"6h", passageoftime.RoundHour, 6, false},
+		{"Quarter", passageoftime.RoundQuarter, 1, false},
+		{"2 weeks", passageoftime.RoundWeek, 2, false},
+		{"fortnight", "", 0, true},
+		{"0 days", "", 0, true},
+		{"1440 minutes", passageoftime.RoundMinute, 1440, false},
+		{"1441 minutes", "", 0, true},
+		{"36028797018963968 seconds", "", 0, true},
+		{"25h", "", 0, true},
+		{"5 quarters", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		unit, step, err := passageoftime.ParseRoundUnit(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseRoundUnit(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if unit != tt.unit || step != tt.step {
+			t.Errorf("ParseRoundUnit(%q) = %s, %d, want %s, %d", tt.input, unit, step, tt.unit, tt.step)
+		}
+	}
+}
+
+// TestHandleRoundTimestamp tests the round_timestamp handler
+func TestHandleRoundTimestamp(t *testing.T) {
+	params := &mcp.CallToolParamsFor[RoundTimestampArgs]{
+		Arguments: RoundTimestampArgs{
+			Timestamp:            "2025-08-20 14:30:00",
+			Unit:                 "quarter",
+			FiscalYearStartMonth: 10,
+			Timezone:             "Europe/London",
+		},
+	}
+
+	got, err := handleRoundTimestamp(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("handleRoundTimestamp() error = %v", err)
+	}
+
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"result:2025-07-01 00:00:00", "period_end:2025-10-01T00:00:00+01:00", "period_end_inclusive:2025-09-30T23:59:59+01:00", "mode:floor"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleRoundTimestamp() = %v, want to contain %v", text, want)
+		}
+	}
+
+	params.Arguments.Mode = "sideways"
+	if _, err := handleRoundTimestamp(context.Background(), nil, params); err == nil {
+		t.Error("handleRoundTimestamp() should reject an invalid mode")
+	}
+}
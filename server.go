@@ -3,11 +3,16 @@ package main
 import (
 	"context"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/calendars"
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime/schedule"
 )
 
 const defaultTimezone = "UTC"
@@ -25,6 +30,15 @@ type TimeDifferenceArgs struct {
 	Timezone                    string `json:"timezone,omitempty" mcp:"Timezone for parsing ambiguous timestamps"`
 	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
 	EnableFuzzyParsing          bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (-14d, 2h30m), 2) dateparse formats, 3) natural language ('tomorrow'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime             string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime               string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+	Locale                      string `json:"locale,omitempty" mcp:"Language for human-readable text: en (default), en-GB, de, fr, es, pt, ru, zh or ja"`
+	MaxUnits                    int    `json:"max_units,omitempty" mcp:"Show at most this many units, counted from the largest non-zero one (e.g. 2 gives '3 days, 4 hours')"`
+	Rounding                    string `json:"rounding,omitempty" mcp:"How to treat what is left below the smallest unit shown: floor (default), nearest or ceil"`
+	LargestUnit                 string `json:"largest_unit,omitempty" mcp:"Largest unit to use: years, months, weeks, days (default), hours, minutes or seconds. Months and years are Gregorian averages."`
+	SmallestUnit                string `json:"smallest_unit,omitempty" mcp:"Smallest unit to use (default seconds)"`
+	Phrasing                    string `json:"phrasing,omitempty" mcp:"signed (default, e.g. '-3 days') or relative ('3 days ago', 'in 3 days')"`
+	Approximate                 bool   `json:"approximate,omitempty" mcp:"If true, prefix 'about' when rounding or dropped units make the text inexact (e.g. 'about 3 weeks')"`
 }
 
 type TimeSinceArgs struct {
@@ -32,6 +46,15 @@ type TimeSinceArgs struct {
 	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone for parsing and current time"`
 	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
 	EnableFuzzyParsing          bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (-1w, -24h), 2) dateparse formats, 3) natural language ('yesterday'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime             string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime               string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+	Locale                      string `json:"locale,omitempty" mcp:"Language for human-readable text: en (default), en-GB, de, fr, es, pt, ru, zh or ja"`
+	MaxUnits                    int    `json:"max_units,omitempty" mcp:"Show at most this many units, counted from the largest non-zero one (e.g. 2 gives '3 days, 4 hours')"`
+	Rounding                    string `json:"rounding,omitempty" mcp:"How to treat what is left below the smallest unit shown: floor (default), nearest or ceil"`
+	LargestUnit                 string `json:"largest_unit,omitempty" mcp:"Largest unit to use: years, months, weeks, days (default), hours, minutes or seconds. Months and years are Gregorian averages."`
+	SmallestUnit                string `json:"smallest_unit,omitempty" mcp:"Smallest unit to use (default seconds)"`
+	Phrasing                    string `json:"phrasing,omitempty" mcp:"signed (default, e.g. '-3 days') or relative ('3 days ago', 'in 3 days')"`
+	Approximate                 bool   `json:"approximate,omitempty" mcp:"If true, prefix 'about' when rounding or dropped units make the text inexact (e.g. 'about 3 weeks')"`
 }
 
 type ParseTimestampArgs struct {
@@ -40,6 +63,9 @@ type ParseTimestampArgs struct {
 	TargetTimezone              string `json:"target_timezone,omitempty" mcp:"Desired output timezone"`
 	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
 	EnableFuzzyParsing          bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime             string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime               string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+	Locale                      string `json:"locale,omitempty" mcp:"Language for human-readable text: en (default), en-GB, de, fr, es, pt, ru, zh or ja"`
 }
 
 type AddTimeArgs struct {
@@ -49,6 +75,9 @@ type AddTimeArgs struct {
 	Timezone                    string  `json:"timezone,omitempty" mcp:"Timezone for calculations"`
 	AutodetectAndUseUserTimezone bool    `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
 	EnableFuzzyParsing          bool    `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (7d, -1M, 2y), 2) dateparse formats, 3) natural language ('yesterday'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime             string  `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime               string  `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+	Locale                      string  `json:"locale,omitempty" mcp:"Language for human-readable text: en (default), en-GB, de, fr, es, pt, ru, zh or ja"`
 }
 
 type TimestampContextArgs struct {
@@ -56,17 +85,277 @@ type TimestampContextArgs struct {
 	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone for context"`
 	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
 	EnableFuzzyParsing          bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (-1y, 6M, 90d), 2) dateparse formats, 3) natural language ('next month'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime             string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime               string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+	Latitude                    *float64 `json:"latitude,omitempty" mcp:"Optional latitude in degrees (north positive); with longitude, adds is_daylight from the sun's actual position"`
+	Longitude                   *float64 `json:"longitude,omitempty" mcp:"Optional longitude in degrees (east positive)"`
 }
 
 type FormatDurationArgs struct {
-	Seconds float64 `json:"seconds" mcp:"Duration in seconds (can be negative)"`
-	Style   string  `json:"style,omitempty" mcp:"Format style: full, compact, minimal"`
+	Seconds                      float64 `json:"seconds" mcp:"Duration in seconds (can be negative)"`
+	Style                        string  `json:"style,omitempty" mcp:"Format style: full, compact, minimal"`
+	Locale                       string  `json:"locale,omitempty" mcp:"Language for human-readable text: en (default), en-GB, de, fr, es, pt, ru, zh or ja"`
+	MaxUnits                     int     `json:"max_units,omitempty" mcp:"Show at most this many units, counted from the largest non-zero one (e.g. 2 gives '3 days, 4 hours')"`
+	Rounding                     string  `json:"rounding,omitempty" mcp:"How to treat what is left below the smallest unit shown: floor (default), nearest or ceil"`
+	LargestUnit                  string  `json:"largest_unit,omitempty" mcp:"Largest unit to use: years, months, weeks, days (default), hours, minutes or seconds. Months and years are Gregorian averages."`
+	SmallestUnit                 string  `json:"smallest_unit,omitempty" mcp:"Smallest unit to use (default seconds)"`
+	Phrasing                     string  `json:"phrasing,omitempty" mcp:"signed (default, e.g. '-3 days') or relative ('3 days ago', 'in 3 days')"`
+	Approximate                  bool    `json:"approximate,omitempty" mcp:"If true, prefix 'about' when rounding or dropped units make the text inexact (e.g. 'about 3 weeks')"`
+	Reference                    string  `json:"reference,omitempty" mcp:"Optional start timestamp; when given, the time reached after the duration is appended in parentheses"`
+	Timezone                     string  `json:"timezone,omitempty" mcp:"Timezone for the reference timestamp and the appended end time (default UTC)"`
+	AutodetectAndUseUserTimezone bool    `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool    `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, the reference also accepts durations (1d, -2w) and natural language ('next Friday')."`
+	NonexistentTime              string  `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string  `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
 }
 
 type ListTimezonesArgs struct {
-	Filter  string `json:"filter,omitempty" mcp:"Optional filter to search timezones by region, city, or IANA ID (e.g., 'America', 'London', 'Pacific')"`
+	Filter  string `json:"filter,omitempty" mcp:"Optional search by IANA ID, region, city, country name or ISO code, abbreviation or Windows zone name, tolerating typos (e.g., 'America', 'Mumbai', 'Germany', 'PST', 'Eastern Standard Time'). Results are ranked with a score and match reasons"`
 	Limit   int    `json:"limit,omitempty" mcp:"Maximum number of timezones to return (default: 25 popular timezones, max: 100 per page)"`
 	Page    int    `json:"page,omitempty" mcp:"Page number for pagination (1-based, default: 1). Use with limit to paginate through all 597+ timezones"`
+	Country string `json:"country,omitempty" mcp:"Optional ISO 3166 alpha-2 country code (e.g., 'US', 'DE', 'IN') to list only the zones used in that country"`
+}
+
+type CronScheduleArgs struct {
+	Expression                   string `json:"expression" mcp:"Cron expression (5 or 6 fields, or @daily-style macro, e.g. '0 */6 * * 1-5') or systemd OnCalendar expression (e.g. 'Mon..Fri *-*-* 09:00', 'daily')"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone the schedule is evaluated in (e.g., 'Europe/Berlin'). Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	From                         string `json:"from,omitempty" mcp:"Reference timestamp to search from (defaults to now). Accepts the same formats as parse_timestamp."`
+	Count                        int    `json:"count,omitempty" mcp:"Number of fire times to return in each direction (default: 5, max: 100)"`
+	Direction                    string `json:"direction,omitempty" mcp:"Which fire times to return: next, previous, or both (default: next)"`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of 'from': 1) durations (-1d, 2h), 2) dateparse formats, 3) natural language ('tomorrow'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type MeetingParticipantArgs struct {
+	Name      string   `json:"name,omitempty" mcp:"Participant name used in results (defaults to the timezone)"`
+	Timezone  string   `json:"timezone" mcp:"Participant's IANA timezone (e.g., 'Australia/Sydney')"`
+	WorkStart string   `json:"work_start,omitempty" mcp:"Local start of working hours as HH:MM (default: 09:00)"`
+	WorkEnd   string   `json:"work_end,omitempty" mcp:"Local end of working hours as HH:MM (default: 17:00); may be earlier than work_start for overnight shifts"`
+	WorkDays  []string `json:"work_days,omitempty" mcp:"Working weekdays (e.g., ['Mon','Tue','Wed','Thu','Fri']); defaults to Monday-Friday"`
+}
+
+type FindMeetingTimesArgs struct {
+	Participants                 []MeetingParticipantArgs `json:"participants" mcp:"Participants with their timezones and working hours"`
+	StartDate                    string                   `json:"start_date,omitempty" mcp:"Start of the search range (defaults to now). Accepts the same formats as parse_timestamp."`
+	EndDate                      string                   `json:"end_date,omitempty" mcp:"End of the search range (defaults to 7 days after start); a date-only value includes that whole day"`
+	DurationMinutes              int                      `json:"duration_minutes,omitempty" mcp:"Meeting length in minutes (default: 30)"`
+	StepMinutes                  int                      `json:"step_minutes,omitempty" mcp:"Spacing between candidate start times in minutes (default: 30)"`
+	MaxResults                   int                      `json:"max_results,omitempty" mcp:"Maximum number of ranked slots to return (default: 10, max: 50)"`
+	RequireAll                   bool                     `json:"require_all,omitempty" mcp:"If true, only return slots inside everyone's working hours"`
+	Timezone                     string                   `json:"timezone,omitempty" mcp:"Timezone for interpreting the date range and rendering slot times. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool                     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool                     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of the date range: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string                   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string                   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type ConvertTimezonesArgs struct {
+	Timestamp                    string   `json:"timestamp,omitempty" mcp:"Instant to convert (defaults to now): standard formats, durations (2h, -1d), natural language ('tomorrow at 3pm'), or dateparse formats"`
+	SourceTimezone               string   `json:"source_timezone,omitempty" mcp:"Timezone of the input and reference for day shifts. Defaults to 'UTC'."`
+	Zones                        []string `json:"zones,omitempty" mcp:"Target IANA timezones (e.g., ['Asia/Tokyo', 'Europe/London'])"`
+	Group                        string   `json:"group,omitempty" mcp:"Named timezone group to add to zones: popular, us, americas, europe, apac, or a group from PASSAGE_OF_TIME_ZONE_GROUPS (e.g., 'team')"`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback. Supports EN, RU, PT_BR, ZH, NL."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type TimezoneTransitionsArgs struct {
+	Timezone                     string `json:"timezone,omitempty" mcp:"IANA timezone to inspect (e.g., 'America/New_York'). Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	Start                        string `json:"start,omitempty" mcp:"Start of the range (defaults to one year ago). Accepts the same formats as parse_timestamp."`
+	End                          string `json:"end,omitempty" mcp:"End of the range (defaults to one year from now). Accepts the same formats as parse_timestamp."`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of start/end: 1) durations (-1y, 2y), 2) dateparse formats, 3) natural language ('next month'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type RoundTimestampArgs struct {
+	Timestamp                    string `json:"timestamp,omitempty" mcp:"Timestamp to round (defaults to now). Accepts the same formats as parse_timestamp."`
+	Unit                         string `json:"unit" mcp:"Period unit, optionally with a count: second, minute, hour, day, week, month, quarter, year, or e.g. '15 minutes', '6h', '2 weeks'"`
+	Mode                         string `json:"mode,omitempty" mcp:"Rounding mode: floor (start of period, default), ceil (start of next period), or nearest"`
+	WeekStart                    string `json:"week_start,omitempty" mcp:"First day of the week for week periods (default: Monday, as in ISO 8601)"`
+	FiscalYearStartMonth         int    `json:"fiscal_year_start_month,omitempty" mcp:"First month (1-12) of the year for quarter and year periods (default: 1). E.g. 10 for a fiscal year starting in October."`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone whose wall clock defines the period boundaries. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type CalendarInfoArgs struct {
+	Timestamp                    string `json:"timestamp,omitempty" mcp:"Date or timestamp to describe (defaults to now). Accepts the same formats as parse_timestamp."`
+	FiscalYearStartMonth         int    `json:"fiscal_year_start_month,omitempty" mcp:"First month (1-12) of the fiscal year (default: 1). E.g. 10 for US federal, 4 for the UK, India and Japan, 7 for Australia."`
+	FiscalYearNaming             string `json:"fiscal_year_naming,omitempty" mcp:"How the fiscal year is named: end (default, the year it ends in: US federal and Australia, October 2025 starts FY2026), start (the year it starts in: Japan, April 2025 starts FY2025) or span (both years: India and the UK, FY2025-26)"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone whose calendar is used. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type SolarTimesArgs struct {
+	Latitude                     float64 `json:"latitude" mcp:"Latitude in degrees, north positive (e.g., 69.65 for Tromsø)"`
+	Longitude                    float64 `json:"longitude" mcp:"Longitude in degrees, east positive (e.g., -74.006 for New York)"`
+	Date                         string  `json:"date,omitempty" mcp:"Date to compute (defaults to today). Accepts the same formats as parse_timestamp."`
+	Timezone                     string  `json:"timezone,omitempty" mcp:"Timezone for the calendar day and the returned times. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool    `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool    `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of date: 1) durations (1d, -2w), 2) dateparse formats, 3) natural language ('next Friday'), 4) fallback."`
+	NonexistentTime              string  `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string  `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type ConvertCalendarArgs struct {
+	Date                         string   `json:"date,omitempty" mcp:"Date to convert (defaults to today), written in the 'from' calendar: e.g. '2025-03-21', '1404/01/01', '1 Farvardin 1404', 'Reiwa 7', '令和7年3月15日', '民國114年3月15日', '1 Tishri 5786', '1 Ramadan 1446', '2025-L06-01' (Chinese leap month). A year on its own returns its Gregorian date range."`
+	From                         string   `json:"from,omitempty" mcp:"Calendar the date is written in (default: gregorian): gregorian, persian (jalali, solar_hijri), buddhist (thai), roc (minguo), japanese, ethiopian, coptic, hebrew (jewish), islamic (tabular), islamic_umalqura (hijri, umm_al_qura), chinese (lunar)"`
+	To                           []string `json:"to,omitempty" mcp:"Calendars to convert to (default: all)"`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone for 'today' and natural language Gregorian input. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, Gregorian input also accepts durations (1d, -2w) and natural language ('next Friday')."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type HolidayDatesArgs struct {
+	Holiday                      string `json:"holiday" mcp:"Holiday to look up, e.g. 'rosh_hashanah', 'passover', 'hanukkah', 'eid_al_fitr', 'eid_al_adha', 'ramadan', 'lunar_new_year', 'mid_autumn_festival', 'nowruz'"`
+	Year                         int    `json:"year,omitempty" mcp:"Gregorian year (defaults to the current year)"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone used to determine the current year. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+}
+
+type IntervalOpsArgs struct {
+	Operation                    string   `json:"operation" mcp:"Operation: intersection, union, subtract (intervals minus other_intervals), gaps (free time within window), merge, or contains (whether timestamp or each of other_intervals lies within intervals)"`
+	Intervals                    []string `json:"intervals" mcp:"Intervals as 'start/end', 'start to end' or 'start/90m', e.g. '2025-03-10T09:00/2025-03-10T10:30' or 'tomorrow 9am to tomorrow 11am'. Each side accepts the same formats as parse_timestamp."`
+	OtherIntervals               []string `json:"other_intervals,omitempty" mcp:"Second list of intervals for intersection, union, subtract and contains"`
+	Window                       string   `json:"window,omitempty" mcp:"Bounding interval for gaps, e.g. '2025-03-10T09:00/2025-03-10T17:00'"`
+	Timestamp                    string   `json:"timestamp,omitempty" mcp:"Point in time for contains"`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone for interpreting and rendering times. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of interval ends: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+// FreeSlotParticipantArgs describes a participant for find_free_slots
+type FreeSlotParticipantArgs struct {
+	Name      string   `json:"name,omitempty" mcp:"Participant name used in results (defaults to the timezone)"`
+	Timezone  string   `json:"timezone" mcp:"Participant's IANA timezone (e.g., 'Australia/Sydney')"`
+	WorkStart string   `json:"work_start,omitempty" mcp:"Local start of working hours as HH:MM (default: 09:00)"`
+	WorkEnd   string   `json:"work_end,omitempty" mcp:"Local end of working hours as HH:MM (default: 17:00); may be earlier than work_start for overnight shifts"`
+	WorkDays  []string `json:"work_days,omitempty" mcp:"Working weekdays (e.g., ['Mon','Tue','Wed','Thu','Fri']); defaults to Monday-Friday"`
+	Busy      []string `json:"busy,omitempty" mcp:"Busy intervals as 'start/end' or 'start to end' (same formats as interval_ops)"`
+}
+
+type FindFreeSlotsArgs struct {
+	Participants                 []FreeSlotParticipantArgs `json:"participants" mcp:"Participants with their timezones, working hours and busy intervals"`
+	StartDate                    string                    `json:"start_date,omitempty" mcp:"Start of the search range (defaults to now). Accepts the same formats as parse_timestamp."`
+	EndDate                      string                    `json:"end_date,omitempty" mcp:"End of the search range (defaults to 7 days after start); a date-only value includes that whole day"`
+	DurationMinutes              int                       `json:"duration_minutes,omitempty" mcp:"Minimum free slot length in minutes (default: 30)"`
+	BufferMinutes                int                       `json:"buffer_minutes,omitempty" mcp:"Minutes to keep free before and after each busy interval (default: 0)"`
+	IgnoreWorkingHours           bool                      `json:"ignore_working_hours,omitempty" mcp:"If true, search the whole range instead of only the hours when everyone is working"`
+	MaxResults                   int                       `json:"max_results,omitempty" mcp:"Maximum number of slots to return (default: 20, max: 100)"`
+	Timezone                     string                    `json:"timezone,omitempty" mcp:"Timezone for interpreting the date range and busy intervals and rendering slot times. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool                      `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool                      `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of the date range and busy intervals: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string                    `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string                    `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type AnalyzeTimestampsArgs struct {
+	Timestamps                   []string `json:"timestamps" mcp:"Timestamps to analyze, in any mix of formats parse_timestamp accepts (e.g. a CSV column); at most 1000"`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone for entries without an offset and for the normalized ISO output. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type TimeScalesArgs struct {
+	Timestamp                    string `json:"timestamp,omitempty" mcp:"Instant to convert, in any format parse_timestamp accepts; a UTC leap second may be written as 23:59:60, and a GPS reading may be seconds since the GPS epoch. Defaults to now."`
+	Scale                        string `json:"scale,omitempty" mcp:"Time scale the timestamp is read in: utc (default), tai, gps or tt. TAI, GPS and TT readings ignore timezones."`
+	EndTimestamp                 string `json:"end_timestamp,omitempty" mcp:"Optional second instant in the same scale; reports the SI seconds elapsed from timestamp including any leap seconds in between"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone for UTC readings without an offset. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type DecodeIDTimestampArgs struct {
+	ID                           string `json:"id" mcp:"ID to decode: a UUID (v1, v6 or v7), ULID, KSUID, MongoDB ObjectID or Snowflake"`
+	Kind                         string `json:"kind,omitempty" mcp:"Force the ID format instead of detecting it: uuid, ulid, ksuid, objectid or snowflake"`
+	SnowflakeEpoch               string `json:"snowflake_epoch,omitempty" mcp:"Epoch for Snowflake IDs: twitter (default), discord, Unix milliseconds, or a timestamp (read in timezone unless it carries an offset)"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone for the decoded timestamp and a snowflake_epoch timestamp. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type WorkingTimeBetweenArgs struct {
+	Start                        string   `json:"start" mcp:"Start of the period (e.g. when a ticket was opened)"`
+	End                          string   `json:"end,omitempty" mcp:"End of the period (e.g. the first response). Defaults to now."`
+	Schedule                     []string `json:"schedule,omitempty" mcp:"Working hours per weekday, e.g. ['mon-thu 09:00-17:00', 'fri 09:00-13:00', 'sat off']; a day may list several ranges. Defaults to mon-fri 09:00-17:00."`
+	Breaks                       []string `json:"breaks,omitempty" mcp:"Daily breaks removed from every working day, e.g. ['12:00-13:00']"`
+	Holidays                     []string `json:"holidays,omitempty" mcp:"Dates with no working time, e.g. ['2025-12-25', '2025-12-26']"`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone the working hours are kept in and for inputs without an offset. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type SLADeadlineArgs struct {
+	Start                        string   `json:"start" mcp:"When the SLA clock starts (e.g. when the ticket was opened)"`
+	Duration                     string   `json:"duration" mcp:"Business time allowed: hours/minutes as a Go duration ('6h', '90m', '1h30m') or business days ('2d', '3 days'), where a day ends at the close of the Nth working day after the start"`
+	Schedule                     []string `json:"schedule,omitempty" mcp:"Working hours per weekday, e.g. ['mon-thu 09:00-17:00', 'fri 09:00-13:00', 'sat off']. Defaults to mon-fri 09:00-17:00."`
+	Breaks                       []string `json:"breaks,omitempty" mcp:"Daily breaks when the clock stops, e.g. ['12:00-13:00']"`
+	Holidays                     []string `json:"holidays,omitempty" mcp:"Dates with no working time, e.g. ['2025-12-25']"`
+	Pauses                       []string `json:"pauses,omitempty" mcp:"Periods when the SLA clock is paused, e.g. ['2025-03-10T10:00/2025-03-10T14:00'] while waiting on a customer"`
+	DisplayTimezones             []string `json:"display_timezones,omitempty" mcp:"Additional timezones to show the due time in"`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone the working hours are kept in and for inputs without an offset. Defaults to 'UTC'."`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type TimezoneInfoArgs struct {
+	Timezone                     string `json:"timezone" mcp:"IANA timezone or alias to describe (e.g. 'Asia/Kolkata', 'Asia/Calcutta', 'US/Eastern')"`
+	At                           string `json:"at,omitempty" mcp:"Instant to report the offset and abbreviation at (defaults to now), e.g. '1942-09-01'. Times without an offset are read in the zone itself."`
+	HistoryStart                 string `json:"history_start,omitempty" mcp:"Start of the transition history (defaults to the zone's earliest record)"`
+	HistoryEnd                   string `json:"history_end,omitempty" mcp:"End of the transition history (defaults to one year after now or 'at', whichever is later)"`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, 2w), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type FormatTimestampArgs struct {
+	Timestamp                    string   `json:"timestamp" mcp:"Timestamp to render: standard formats, Unix seconds, durations (-2h, 1d), natural language ('tomorrow at 3pm'), or dateparse formats"`
+	Formats                      []string `json:"formats,omitempty" mcp:"Named formats to render (default: all): rfc3339, rfc3339_nano, iso8601_basic, iso_week_date, iso_ordinal_date, json, rfc2822, rfc1123, http_date, rfc850, rfc822, ansic, unix_date, sql_datetime, sql_date, sql_time, kitchen, unix, unix_ms, unix_us, unix_ns, unix_float, syslog, syslog_rfc5424, common_log, log, go_log"`
+	Precision                    *int     `json:"precision,omitempty" mcp:"Fractional second digits (0-9) for formats that carry a fraction (rfc3339, iso8601_basic, json, sql_datetime, sql_time, unix_float, syslog_rfc5424, log); defaults per format. syslog_rfc5424 uses at most 6."`
+	Timezone                     string   `json:"timezone,omitempty" mcp:"Timezone to render in and to read times without an offset (default UTC)"`
+	AutodetectAndUseUserTimezone bool     `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool     `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string   `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string   `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type ParseDurationArgs struct {
+	Duration                     string `json:"duration" mcp:"Duration to read: words in EN, DE, FR, ES, PT, RU, ZH or JA ('2 hours 30 minutes', 'a fortnight', 'half an hour', '3 Tage'), shorthand ('1d 4h', '2h30m', '3M' for months), clock ('1:15:30') or ISO 8601 ('PT2H', 'P1Y2M')"`
+	Reference                    string `json:"reference,omitempty" mcp:"Optional start timestamp; with months or years, gives the exact end time and length from this point"`
+	Timezone                     string `json:"timezone,omitempty" mcp:"Timezone for the reference timestamp (default UTC)"`
+	AutodetectAndUseUserTimezone bool   `json:"autodetect_and_use_user_timezone,omitempty" mcp:"If true, automatically detect and use the system's local timezone instead of UTC default."`
+	EnableFuzzyParsing           bool   `json:"enable_fuzzy_parsing,omitempty" mcp:"If true, enables 4-layer parsing of the reference: 1) durations (1d, -2h), 2) dateparse formats, 3) natural language ('next Monday'), 4) fallback."`
+	NonexistentTime              string `json:"nonexistent_time,omitempty" mcp:"How to resolve a local time skipped by a DST gap: shift_forward (default), shift_back, or error"`
+	AmbiguousTime                string `json:"ambiguous_time,omitempty" mcp:"How to resolve a local time repeated by a DST overlap: earlier (default), later, or error"`
+}
+
+type ConvertZoneIDArgs struct {
+	ZoneID    string `json:"zone_id" mcp:"Windows time zone ID (e.g. 'W. Europe Standard Time') or IANA zone (e.g. 'Europe/Berlin', 'Asia/Kolkata')"`
+	Direction string `json:"direction,omitempty" mcp:"auto (default: detect from zone_id), windows_to_iana, or iana_to_windows"`
+	Territory string `json:"territory,omitempty" mcp:"ISO 3166 country code selecting that country's CLDR entry: for a Windows ID, e.g. 'BE' maps 'Romance Standard Time' to Europe/Brussels instead of Europe/Paris; for an IANA zone, the zone must be listed for the country"`
 }
 
 // registerTools registers all time-related tools with the MCP server
@@ -116,8 +405,128 @@ func registerTools(server *mcp.Server) {
 	// Register list_timezones tool
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "list_timezones",
-		Description: "List IANA timezone identifiers with pagination. Returns 25 popular timezones by default. Use limit (max 100) and page parameters to paginate through all 597+ timezones. Supports filtering by region/city.",
+		Description: "List IANA timezone identifiers with pagination. Returns 25 popular timezones by default. Use limit (max 100) and page parameters to paginate through all 597+ timezones. Supports filtering by region/city and by ISO country code; each zone reports its countries, coordinates and tz comment.",
 	}, handleListTimezones)
+
+	// Register cron_schedule tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "cron_schedule",
+		Description: "Evaluate a cron or systemd OnCalendar expression: returns the next/previous fire times in a timezone (DST-aware) and an English description of the schedule",
+	}, handleCronSchedule)
+
+	// Register find_meeting_times tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "find_meeting_times",
+		Description: "Find meeting times that overlap the working hours of participants in different timezones. Returns ranked slots with each participant's local time and flags for slots outside working hours or on weekends.",
+	}, handleFindMeetingTimes)
+
+	// Register convert_timezones tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_timezones",
+		Description: "World clock: convert one instant into many timezones (or a named group such as 'team'). Returns local time, offset, abbreviation and day shift per zone plus a compact table.",
+	}, handleConvertTimezones)
+
+	// Register timezone_transitions tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "timezone_transitions",
+		Description: "List past and upcoming DST/offset transitions for a timezone, with before/after offsets, abbreviations and the local wall-clock gap or overlap. Also reports whether the zone observes DST and its current rule.",
+	}, handleTimezoneTransitions)
+
+	// Register round_timestamp tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "round_timestamp",
+		Description: "Round a timestamp to a period boundary on the local wall clock (floor, ceil or nearest), e.g. start of the ISO week, nearest 15 minutes, or end of the fiscal quarter. Also returns the start and end of the containing period.",
+	}, handleRoundTimestamp)
+
+	// Register calendar_info tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "calendar_info",
+		Description: "Calendar facts for a date: ISO and US week numbers, ISO week-year, quarter, day of year, days in month/year, leap year, fiscal year/quarter for a configurable start month, and percent elapsed of the current day/week/month/quarter/year",
+	}, handleCalendarInfo)
+
+	// Register solar_times tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "solar_times",
+		Description: "Sunrise, sunset, solar noon, civil/nautical/astronomical twilight, golden hour and day length for coordinates on a date (offline NOAA algorithm). Reports midnight sun and polar night.",
+	}, handleSolarTimes)
+
+	// Register convert_calendar tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_calendar",
+		Description: "Convert dates between the Gregorian calendar and other calendar systems (Persian/Solar Hijri, Thai Buddhist, ROC/Minguo, Japanese imperial era, Ethiopian, Coptic, Hebrew, Islamic tabular and Umm al-Qura, Chinese lunisolar with leap months). Accepts dates written in any of them, e.g. 'Reiwa 7' or '1404/01/01'.",
+	}, handleConvertCalendar)
+
+	// Register holiday_dates tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "holiday_dates",
+		Description: "Find the Gregorian dates of holidays fixed in the Hebrew, Islamic (Umm al-Qura), Chinese, Persian and Ethiopian calendars, e.g. Rosh Hashanah, Eid al-Fitr or Lunar New Year, for a given year",
+	}, handleHolidayDates)
+
+	// Register interval_ops tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "interval_ops",
+		Description: "Interval algebra on time ranges: intersection, union, subtraction, gaps within a window, merging overlapping intervals, and containment checks",
+	}, handleIntervalOps)
+
+	// Register find_free_slots tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "find_free_slots",
+		Description: "Find every free slot of at least N minutes when no participant is busy, within everyone's working hours (default Mon-Fri 09:00-17:00 local), with optional buffers around busy intervals. Slots are ranked by how well they suit each participant's local time of day.",
+	}, handleFindFreeSlots)
+
+	// Register analyze_timestamps tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "analyze_timestamps",
+		Description: "Parse a list of mixed-format timestamps, normalize them to ISO 8601 in one timezone and sort them; reports consecutive gaps, min/max/mean/median gap and overall span, and flags duplicates, future dates, outliers and unparseable entries with reasons",
+	}, handleAnalyzeTimestamps)
+
+	// Register time_scales tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "time_scales",
+		Description: "Convert an instant between UTC, TAI, GPS and TT using an embedded leap-second table (with its expiry date), including GPS week and seconds of week; with end_timestamp, computes the true elapsed SI seconds and lists the leap seconds applied",
+	}, handleTimeScales)
+
+	// Register decode_id_timestamp tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "decode_id_timestamp",
+		Description: "Extract the creation timestamp embedded in an ID: detects and decodes UUIDv1/v6/v7, ULID, KSUID, MongoDB ObjectID and Twitter/Discord-style Snowflake IDs (configurable epoch); returns the timestamp in the requested timezone and the time since it",
+	}, handleDecodeIDTimestamp)
+
+	// Register working_time_between tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "working_time_between",
+		Description: "Count the working (business) hours between two instants given a per-weekday schedule, timezone, holidays and daily breaks such as lunch; returns working seconds and hours with a per-day breakdown",
+	}, handleWorkingTimeBetween)
+
+	// Register sla_deadline tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "sla_deadline",
+		Description: "Compute an SLA due time by adding business hours, minutes or days to a start time under a working-hours schedule, holidays, breaks and pause windows; returns the due instant in several timezones and the remaining time from now",
+	}, handleSLADeadline)
+
+	// Register timezone_info tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "timezone_info",
+		Description: "Describe a timezone at any instant, past or future: its UTC offset, abbreviation and DST flag then (e.g. Asia/Kolkata on 1942-09-01), its full transition history, when it last left DST, its canonical ID and aliases, and the tzdata version in use",
+	}, handleTimezoneInfo)
+
+	// Register format_timestamp tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "format_timestamp",
+		Description: "Render a timestamp in one or more named formats for other systems: RFC 3339 with chosen fractional precision, RFC 2822, RFC 1123, HTTP-date, RFC 850, ANSI C, SQL DATETIME, ISO week and ordinal dates, Kitchen, Unix seconds/ms/us/ns, syslog and log-file formats",
+	}, handleFormatTimestamp)
+
+	// Register parse_duration tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "parse_duration",
+		Description: "Convert a written duration into seconds, the inverse of format_duration: English and localized phrases ('2 hours 30 minutes', 'a fortnight', 'half an hour', 'vor 3 Tagen'), shorthand ('1d 4h'), clock readings ('1:15:30') and ISO 8601 ('PT2H'). Reports calendar components for months and years and rejects ambiguous input such as '90' or '1:30' with an explanation.",
+	}, handleParseDuration)
+
+	// Register convert_zone_id tool
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "convert_zone_id",
+		Description: "Convert between Windows time zone IDs and IANA zones using the Unicode CLDR mapping, in either direction. Windows IDs map to the zone of a given ISO country (e.g. 'Pacific Standard Time' in CA is America/Vancouver); IANA zones and their aliases map to the Windows ID and territory, optionally a given country's, for Windows hosts and .NET TimeZoneInfo.",
+	}, handleConvertZoneID)
 }
 
 // Tool handlers
@@ -170,20 +579,34 @@ func handleTimeDifference(ctx context.Context, ss *mcp.ServerSession, params *mc
 		Timezone:           timezone,
 		ReferenceTime:      time.Now(),
 	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
 
 	durationResult, err := passageoftime.TimeDifference(args.Timestamp1, args.Timestamp2, options)
 	if err != nil {
 		return nil, err
 	}
+	locale, err := passageoftime.GetLocale(args.Locale)
+	if err != nil {
+		return nil, err
+	}
+	renderOptions, err := relativeTimeOptions(locale, args.MaxUnits, args.Rounding, args.LargestUnit, args.SmallestUnit, args.Phrasing, args.Approximate)
+	if err != nil {
+		return nil, err
+	}
 
 	seconds := durationResult.Duration
 	isNegative := seconds < 0
 	
 	result := map[string]interface{}{
 		"seconds":     seconds,
-		"formatted":   durationResult.PreciseDescription,
+		"formatted":   passageoftime.FormatWithPreciseTimestamp(passageoftime.FormatRelativeTime(seconds, renderOptions), durationResult.EndTime, timezone),
 		"is_negative": isNegative,
 	}
+	if len(durationResult.LocalTimeNotes) > 0 {
+		result["local_time_notes"] = durationResult.LocalTimeNotes
+	}
 
 	if unit != "auto" {
 		var divisor float64
@@ -227,23 +650,42 @@ func handleTimeSince(ctx context.Context, ss *mcp.ServerSession, params *mcp.Cal
 		Timezone:           timezone,
 		ReferenceTime:      time.Now(),
 	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
 
 	durationResult, err := passageoftime.TimeSince(args.Timestamp, options)
 	if err != nil {
 		return nil, err
 	}
+	locale, err := passageoftime.GetLocale(args.Locale)
+	if err != nil {
+		return nil, err
+	}
+	renderOptions, err := relativeTimeOptions(locale, args.MaxUnits, args.Rounding, args.LargestUnit, args.SmallestUnit, args.Phrasing, args.Approximate)
+	if err != nil {
+		return nil, err
+	}
 
 	seconds := durationResult.Duration
+	// The elapsed time is positive for a past timestamp, which reads "ago"
+	offset := seconds
+	if renderOptions.Phrasing == passageoftime.PhrasingRelative {
+		offset = -seconds
+	}
 
 	// Generate context using library function
 	context := passageoftime.GetTimeContext(durationResult.StartTime, durationResult.EndTime, seconds)
 
 	result := map[string]interface{}{
 		"seconds":   seconds,
-		"formatted": durationResult.PreciseDescription,
+		"formatted": passageoftime.FormatWithPreciseTimestamp(passageoftime.FormatRelativeTime(offset, renderOptions), durationResult.EndTime, timezone),
 		"context":   context,
 		"timezone":  timezone,
 	}
+	if len(durationResult.LocalTimeNotes) > 0 {
+		result["local_time_notes"] = durationResult.LocalTimeNotes
+	}
 
 	return &mcp.CallToolResultFor[struct{}]{
 		Content: []mcp.Content{
@@ -276,11 +718,20 @@ func handleParseTimestamp(ctx context.Context, ss *mcp.ServerSession, params *mc
 		Timezone:           parseTz,
 		ReferenceTime:      time.Now(),
 	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
 
-	t, err := passageoftime.ParseFuzzyTimestamp(args.Timestamp, options)
+	parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
 	if err != nil {
 		return nil, fmt.Errorf("invalid timestamp: %w", err)
 	}
+	t := parsed.Time
+
+	locale, err := passageoftime.GetLocale(args.Locale)
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert to target timezone if different
 	if args.SourceTimezone != "" && args.SourceTimezone != targetTimezone {
@@ -294,13 +745,14 @@ func handleParseTimestamp(ctx context.Context, ss *mcp.ServerSession, params *mc
 	result := map[string]interface{}{
 		"iso":                t.Format(time.RFC3339),
 		"unix":               fmt.Sprintf("%d", t.Unix()),
-		"human":              t.Format("January 2, 2006 at 3:04 PM MST"),
+		"human":              locale.FormatDateTime(t) + " " + t.Format("MST"),
 		"timezone":           targetTimezone,
 		"day_of_week":        t.Format("Monday"),
 		"date":               t.Format("2006-01-02"),
 		"time":               t.Format("15:04:05"),
 		"source_timezone":    parseTz,
 	}
+	addLocalTimeFlags(result, parsed.Resolution)
 
 	return &mcp.CallToolResultFor[struct{}]{
 		Content: []mcp.Content{
@@ -327,11 +779,20 @@ func handleAddTime(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallT
 		Timezone:           timezone,
 		ReferenceTime:      time.Now(),
 	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
 
-	t, err := passageoftime.ParseFuzzyTimestamp(args.Timestamp, options)
+	parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
 	if err != nil {
 		return nil, fmt.Errorf("invalid timestamp: %w", err)
 	}
+	t := parsed.Time
+
+	locale, err := passageoftime.GetLocale(args.Locale)
+	if err != nil {
+		return nil, err
+	}
 
 	// Remember if input was date-only
 	isDateOnly := len(args.Timestamp) == 10 // YYYY-MM-DD
@@ -358,7 +819,7 @@ func handleAddTime(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallT
 	// Generate description using library function
 	loc, _ := time.LoadLocation(timezone)
 	now := time.Now().In(loc)
-	description := passageoftime.GetTimeDescription(resultTime, now, isDateOnly)
+	description := locale.DescribeTime(resultTime, now, isDateOnly)
 
 	// Format result to match input format
 	var resultStr string
@@ -373,6 +834,7 @@ func handleAddTime(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallT
 		"iso":         resultTime.Format(time.RFC3339),
 		"description": description,
 	}
+	addLocalTimeFlags(result, parsed.Resolution)
 
 	return &mcp.CallToolResultFor[struct{}]{
 		Content: []mcp.Content{
@@ -399,11 +861,15 @@ func handleTimestampContext(ctx context.Context, ss *mcp.ServerSession, params *
 		Timezone:           timezone,
 		ReferenceTime:      time.Now(),
 	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
 
-	t, err := passageoftime.ParseFuzzyTimestamp(args.Timestamp, options)
+	parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
 	if err != nil {
 		return nil, fmt.Errorf("invalid timestamp: %w", err)
 	}
+	t := parsed.Time
 
 	loc, _ := time.LoadLocation(timezone)
 	now := time.Now().In(loc)
@@ -473,6 +939,22 @@ func handleTimestampContext(ctx context.Context, ss *mcp.ServerSession, params *
 		"typical_activity":  typicalActivity,
 		"relative_day":      relativeDay,
 	}
+	addLocalTimeFlags(result, parsed.Resolution)
+
+	// Fixed hour ranges say nothing about daylight in e.g. Tromsø in winter, so
+	// report the sun's position when coordinates are given
+	if args.Latitude != nil || args.Longitude != nil {
+		if args.Latitude == nil || args.Longitude == nil {
+			return nil, fmt.Errorf("latitude and longitude must be given together")
+		}
+		phase, elevation, err := passageoftime.GetDaylight(t, *args.Latitude, *args.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		result["is_daylight"] = phase == "day"
+		result["daylight_phase"] = phase
+		result["solar_elevation_degrees"] = math.Round(elevation*10) / 10
+	}
 
 	return &mcp.CallToolResultFor[struct{}]{
 		Content: []mcp.Content{
@@ -495,17 +977,64 @@ func handleFormatDuration(ctx context.Context, ss *mcp.ServerSession, params *mc
 		seconds = -seconds
 	}
 
-	// Use passageoftime library for duration formatting
-	durationFormatted := passageoftime.FormatDuration(seconds, style, isNegative)
-	
-	// Add precise timestamp like other handlers
-	now := time.Now()
-	targetTime := now.Add(time.Duration(seconds) * time.Second)
-	if isNegative {
-		targetTime = now.Add(-time.Duration(seconds) * time.Second)
+	locale, err := passageoftime.GetLocale(args.Locale)
+	if err != nil {
+		return nil, err
+	}
+	renderOptions, err := relativeTimeOptions(locale, args.MaxUnits, args.Rounding, args.LargestUnit, args.SmallestUnit, args.Phrasing, args.Approximate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use passageoftime library for duration formatting; minimal is a clock
+	// reading and ignores the unit options
+	var durationFormatted string
+	switch style {
+	case "minimal":
+		durationFormatted = passageoftime.FormatDuration(seconds, style, isNegative)
+	case "compact":
+		renderOptions.Short = true
+		durationFormatted = passageoftime.FormatRelativeTime(args.Seconds, renderOptions)
+	default:
+		durationFormatted = passageoftime.FormatRelativeTime(args.Seconds, renderOptions)
 	}
 	
-	result := passageoftime.FormatWithPreciseTimestamp(durationFormatted, targetTime, "UTC")
+	// Anchor the duration only when a reference is given
+	result := durationFormatted
+	if args.Reference != "" {
+		timezone := args.Timezone
+		if timezone == "" {
+			if args.AutodetectAndUseUserTimezone {
+				timezone = passageoftime.GetSystemTimezone()
+			} else {
+				timezone = defaultTimezone
+			}
+		}
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone: %w", err)
+		}
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Reference, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reference: %w", err)
+		}
+		end := parsed.Time.Add(time.Duration(args.Seconds * float64(time.Second)))
+		result = passageoftime.FormatWithPreciseTimestamp(durationFormatted, end, timezone)
+
+		// The output is plain text, so DST resolutions are appended only when present
+		flags := map[string]interface{}{}
+		addLocalTimeFlags(flags, parsed.Resolution)
+		if len(flags) > 0 {
+			result = fmt.Sprintf("%s %v", result, flags)
+		}
+	}
 
 	return &mcp.CallToolResultFor[struct{}]{
 		Content: []mcp.Content{
@@ -520,7 +1049,7 @@ func handleListTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp
 	// Set defaults and validate pagination parameters
 	limit := args.Limit
 	page := args.Page
-	usePopularDefault := limit == 0 && args.Filter == "" && page == 0
+	usePopularDefault := limit == 0 && args.Filter == "" && args.Country == "" && page == 0
 	
 	if limit == 0 {
 		if usePopularDefault {
@@ -540,17 +1069,34 @@ func handleListTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp
 	var sourceTimezones []string
 	if usePopularDefault {
 		sourceTimezones = passageoftime.GetPopularTimezoneIDs()
+	} else if args.Country != "" && args.Filter == "" {
+		countryTimezones, err := passageoftime.GetCountryTimezoneIDs(args.Country)
+		if err != nil {
+			return nil, err
+		}
+		sourceTimezones = countryTimezones
 	} else {
 		sourceTimezones = passageoftime.GetAllTimezoneIDs()
 	}
 	
-	// Apply filter if provided
+	// Apply filter if provided, ranking the matches
 	var filteredTimezones []string
-	filter := strings.ToLower(args.Filter)
+	matches := make(map[string]passageoftime.TimezoneMatch)
 	
-	for _, tz := range sourceTimezones {
-		if filter == "" || strings.Contains(strings.ToLower(tz), filter) {
-			filteredTimezones = append(filteredTimezones, tz)
+	if args.Filter == "" {
+		filteredTimezones = sourceTimezones
+	} else {
+		if args.Country != "" {
+			if _, err := passageoftime.GetCountryTimezoneIDs(args.Country); err != nil {
+				return nil, err
+			}
+		}
+		for _, match := range passageoftime.SearchTimezones(args.Filter) {
+			if args.Country != "" && !passageoftime.TimezoneInCountry(match.ID, args.Country) {
+				continue
+			}
+			filteredTimezones = append(filteredTimezones, match.ID)
+			matches[match.ID] = match
 		}
 	}
 	
@@ -573,22 +1119,35 @@ func handleListTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp
 	now := time.Now()
 	
 	for i, tzID := range filteredTimezones {
-		loc, err := time.LoadLocation(tzID)
+		info, err := passageoftime.GetTimezoneInfo(tzID, now)
 		if err != nil {
 			continue // Skip invalid timezones
 		}
 		
+		loc, _ := time.LoadLocation(tzID)
 		nowInTz := now.In(loc)
-		_, offset := nowInTz.Zone()
-		offsetHours := float64(offset) / 3600
+		offsetHours := float64(info.Offset) / 3600
 		
 		timezoneInfos[i] = map[string]interface{}{
 			"id":          tzID,
-			"name":        nowInTz.Location().String(),
+			"name":        info.Name,
 			"offset":      offsetHours,
-			"offset_str":  passageoftime.FormatOffset(offset),
+			"offset_str":  info.OffsetString,
 			"current_time": nowInTz.Format("2006-01-02 15:04:05 MST"),
 		}
+		if len(info.CountryCodes) > 0 {
+			timezoneInfos[i]["countries"] = info.CountryCodes
+			timezoneInfos[i]["country_names"] = info.CountryNames
+			timezoneInfos[i]["latitude"] = info.Latitude
+			timezoneInfos[i]["longitude"] = info.Longitude
+			if info.Comment != "" {
+				timezoneInfos[i]["comment"] = info.Comment
+			}
+		}
+		if match, ok := matches[tzID]; ok {
+			timezoneInfos[i]["score"] = match.Score
+			timezoneInfos[i]["match_reasons"] = match.Reasons
+		}
 	}
 	
 	// Calculate pagination metadata
@@ -610,6 +1169,7 @@ func handleListTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp
 		"has_next_page":  hasNextPage,
 		"has_prev_page":  hasPrevPage,
 		"filter":         args.Filter,
+		"country":        strings.ToUpper(args.Country),
 		"using_popular":  usePopularDefault,
 		"timezones":      timezoneInfos,
 	}
@@ -619,4 +1179,2167 @@ func handleListTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp
 			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
 		},
 	}, nil
-}
\ No newline at end of file
+}
+
+func handleCronSchedule(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[CronScheduleArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	count := args.Count
+	if count <= 0 {
+		count = 5
+	} else if count > 100 {
+		count = 100
+	}
+
+	direction := args.Direction
+	if direction == "" {
+		direction = "next"
+	}
+	if direction != "next" && direction != "previous" && direction != "both" {
+		return nil, fmt.Errorf("invalid direction: %s (expected next, previous, or both)", direction)
+	}
+
+	sched, err := schedule.Parse(args.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	from := time.Now().In(loc)
+	var resolution passageoftime.LocalTimeResolution
+	if args.From != "" {
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.From, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from timestamp: %w", err)
+		}
+		from, resolution = parsed.Time, parsed.Resolution
+	}
+
+	// An OnCalendar timezone suffix overrides the requested timezone
+	if sched.Location != nil {
+		timezone = sched.Location.String()
+	}
+
+	result := map[string]interface{}{
+		"expression":  sched.Expression,
+		"type":        string(sched.Kind),
+		"description": sched.Description(),
+		"timezone":    timezone,
+		"from":        from.Format(time.RFC3339),
+	}
+	addLocalTimeFlags(result, resolution)
+
+	if direction == "next" || direction == "both" {
+		times, err := sched.Next(from, count, loc)
+		if err != nil {
+			return nil, err
+		}
+		result["next"] = formatFireTimes(times)
+	}
+
+	if direction == "previous" || direction == "both" {
+		times, err := sched.Previous(from, count, loc)
+		if err != nil {
+			return nil, err
+		}
+		result["previous"] = formatFireTimes(times)
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// formatFireTimes renders schedule fire times for tool output
+func formatFireTimes(times []time.Time) []map[string]interface{} {
+	formatted := make([]map[string]interface{}, len(times))
+	for i, t := range times {
+		formatted[i] = map[string]interface{}{
+			"iso":         t.Format(time.RFC3339),
+			"local":       t.Format("2006-01-02 15:04:05 MST"),
+			"day_of_week": t.Format("Monday"),
+		}
+	}
+	return formatted
+}
+
+func handleFindMeetingTimes(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[FindMeetingTimesArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      time.Now(),
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	start := time.Now().In(loc)
+	var resolutions []passageoftime.LocalTimeResolution
+	if args.StartDate != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.StartDate, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+		start = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+
+	end := start.AddDate(0, 0, 7)
+	if args.EndDate != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.EndDate, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+		end = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+		// A bare date covers the whole day
+		if len(strings.TrimSpace(args.EndDate)) == 10 {
+			end = end.AddDate(0, 0, 1)
+		}
+	}
+	if end.Sub(start) > 92*24*time.Hour {
+		return nil, fmt.Errorf("search range is limited to 92 days")
+	}
+
+	duration := args.DurationMinutes
+	if duration <= 0 {
+		duration = 30
+	}
+	step := args.StepMinutes
+	if step <= 0 {
+		step = 30
+	}
+	maxResults := args.MaxResults
+	if maxResults <= 0 {
+		maxResults = 10
+	} else if maxResults > 50 {
+		maxResults = 50
+	}
+
+	participants := make([]passageoftime.Participant, len(args.Participants))
+	for i, p := range args.Participants {
+		participant, err := newParticipant(p.Name, p.Timezone, p.WorkStart, p.WorkEnd, p.WorkDays)
+		if err != nil {
+			return nil, err
+		}
+		participants[i] = participant
+	}
+
+	slots, err := passageoftime.FindMeetingTimes(passageoftime.MeetingOptions{
+		Participants: participants,
+		Start:        start,
+		End:          end,
+		Duration:     time.Duration(duration) * time.Minute,
+		Step:         time.Duration(step) * time.Minute,
+		MaxResults:   maxResults,
+		RequireAll:   args.RequireAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slotInfos := make([]map[string]interface{}, len(slots))
+	for i, slot := range slots {
+		locals := make([]map[string]interface{}, len(slot.Participants))
+		for j, p := range slot.Participants {
+			locals[j] = map[string]interface{}{
+				"name":         p.Name,
+				"timezone":     p.Timezone,
+				"local_start":  p.LocalStart.Format("Mon 2006-01-02 15:04 MST"),
+				"local_end":    p.LocalEnd.Format("Mon 2006-01-02 15:04 MST"),
+				"within_hours": p.WithinHours,
+				"is_weekend":   p.IsWeekend,
+			}
+		}
+
+		slotInfos[i] = map[string]interface{}{
+			"rank":               i + 1,
+			"start":              slot.Start.In(loc).Format(time.RFC3339),
+			"end":                slot.End.In(loc).Format(time.RFC3339),
+			"score":              slot.Score,
+			"within_hours_count": slot.WithinHoursCount,
+			"all_within_hours":   slot.WithinHoursCount == len(slot.Participants),
+			"flags":              slot.Flags,
+			"participants":       locals,
+		}
+	}
+
+	result := map[string]interface{}{
+		"timezone":         timezone,
+		"range_start":      start.Format(time.RFC3339),
+		"range_end":        end.Format(time.RFC3339),
+		"duration_minutes": duration,
+		"returned_count":   len(slotInfos),
+		"slots":            slotInfos,
+	}
+	addLocalTimeFlags(result, resolutions...)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleConvertTimezones(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[ConvertTimezonesArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	sourceTimezone := args.SourceTimezone
+	if sourceTimezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			sourceTimezone = passageoftime.GetSystemTimezone()
+		} else {
+			sourceTimezone = defaultTimezone
+		}
+	}
+
+	sourceLoc, err := time.LoadLocation(sourceTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source timezone: %w", err)
+	}
+
+	t := time.Now().In(sourceLoc)
+	var resolution passageoftime.LocalTimeResolution
+	if args.Timestamp != "" {
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           sourceTimezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		t, resolution = parsed.Time, parsed.Resolution
+	}
+
+	zones := append([]string(nil), args.Zones...)
+	if args.Group != "" {
+		groupZones, err := passageoftime.GetZoneGroup(args.Group)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, groupZones...)
+	}
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("provide zones or a group (available groups: %s)", strings.Join(passageoftime.GetZoneGroupNames(), ", "))
+	}
+
+	rows, err := passageoftime.ConvertTimezones(t, zones, sourceLoc)
+	if err != nil {
+		return nil, err
+	}
+
+	conversions := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		conversions[i] = map[string]interface{}{
+			"timezone":     row.Timezone,
+			"local":        row.Local.Format("2006-01-02 15:04:05"),
+			"iso":          row.Local.Format(time.RFC3339),
+			"day_of_week":  row.Local.Format("Monday"),
+			"offset":       row.OffsetString,
+			"abbreviation": row.Abbreviation,
+			"is_dst":       row.IsDST,
+			"day_shift":    row.DayShiftLabel(),
+		}
+	}
+
+	result := map[string]interface{}{
+		"source":          t.Format(time.RFC3339),
+		"source_timezone": sourceTimezone,
+		"utc":             t.UTC().Format(time.RFC3339),
+		"conversions":     conversions,
+		"table":           passageoftime.RenderZoneTable(rows),
+	}
+	addLocalTimeFlags(result, resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleTimezoneTransitions(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TimezoneTransitionsArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      time.Now(),
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	start := now.AddDate(-1, 0, 0)
+	end := now.AddDate(1, 0, 0)
+	var resolutions []passageoftime.LocalTimeResolution
+	if args.Start != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Start, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start: %w", err)
+		}
+		start = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+	if args.End != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.End, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end: %w", err)
+		}
+		end = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+
+	report, err := passageoftime.GetZoneTransitions(timezone, start, end, now)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]map[string]interface{}, len(report.Transitions))
+	for i, tr := range report.Transitions {
+		transitions[i] = transitionInfo(tr, now)
+	}
+
+	result := map[string]interface{}{
+		"timezone":              timezone,
+		"range_start":           start.Format(time.RFC3339),
+		"range_end":             end.Format(time.RFC3339),
+		"observes_dst":          report.ObservesDST,
+		"standard_offset":       passageoftime.FormatOffset(report.StandardOffset),
+		"standard_abbreviation": report.StandardAbbreviation,
+		"current_rule":          report.CurrentRule,
+		"transition_count":      len(transitions),
+		"transitions":           transitions,
+	}
+	if report.DSTAbbreviation != "" {
+		result["dst_offset"] = passageoftime.FormatOffset(report.DSTOffset)
+		result["dst_abbreviation"] = report.DSTAbbreviation
+	}
+	addLocalTimeFlags(result, resolutions...)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// transitionInfo renders a zone transition for a tool result
+func transitionInfo(tr passageoftime.ZoneTransition, now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"at_utc":              tr.At.UTC().Format(time.RFC3339),
+		"at_local":            tr.At.Format(time.RFC3339),
+		"offset_before":       passageoftime.FormatOffset(tr.OffsetBefore),
+		"offset_after":        passageoftime.FormatOffset(tr.OffsetAfter),
+		"abbreviation_before": tr.AbbreviationBefore,
+		"abbreviation_after":  tr.AbbreviationAfter,
+		"is_dst_after":        tr.IsDSTAfter,
+		"kind":                tr.Kind(),
+		"change_minutes":      int(tr.Change().Minutes()),
+		"description":         tr.Description(),
+		"is_past":             tr.At.Before(now),
+	}
+}
+
+// applyLocalTimePolicies validates the DST gap/overlap policy arguments and stores them in options
+func applyLocalTimePolicies(options *passageoftime.ParseOptions, nonexistent, ambiguous string) error {
+	nonexistentPolicy, err := passageoftime.ParseNonexistentTimePolicy(nonexistent)
+	if err != nil {
+		return err
+	}
+	ambiguousPolicy, err := passageoftime.ParseAmbiguousTimePolicy(ambiguous)
+	if err != nil {
+		return err
+	}
+	options.NonexistentTime = nonexistentPolicy
+	options.AmbiguousTime = ambiguousPolicy
+	return nil
+}
+
+// localTimeAdjustment describes the DST resolutions of one entry of a list
+// argument, or returns nil when the entry needed none
+func localTimeAdjustment(entry string, resolutions ...passageoftime.LocalTimeResolution) map[string]interface{} {
+	adjustment := map[string]interface{}{"entry": entry}
+	addLocalTimeFlags(adjustment, resolutions...)
+	if len(adjustment) == 1 {
+		return nil
+	}
+	return adjustment
+}
+
+// addLocalTimeAdjustments records per-entry DST resolutions in a tool result
+// and raises the result's own flags for any of them
+func addLocalTimeAdjustments(result map[string]interface{}, adjustments []map[string]interface{}) {
+	if len(adjustments) == 0 {
+		return
+	}
+	for _, adjustment := range adjustments {
+		for _, flag := range []string{"nonexistent_local_time", "ambiguous_local_time"} {
+			if adjustment[flag] == true {
+				result[flag] = true
+			}
+		}
+	}
+	result["local_time_adjustments"] = adjustments
+}
+
+// addLocalTimeFlags records DST gap or overlap resolutions in a tool result;
+// tools that parse several times pass one resolution per time
+func addLocalTimeFlags(result map[string]interface{}, resolutions ...passageoftime.LocalTimeResolution) {
+	var notes []string
+	for _, resolution := range resolutions {
+		if resolution.Nonexistent {
+			result["nonexistent_local_time"] = true
+		}
+		if resolution.Ambiguous {
+			result["ambiguous_local_time"] = true
+		}
+		if resolution.Note != "" {
+			notes = append(notes, resolution.Note)
+		}
+	}
+	if len(notes) > 0 {
+		result["local_time_note"] = strings.Join(notes, "; ")
+	}
+}
+
+func handleRoundTimestamp(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[RoundTimestampArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	unit, step, err := passageoftime.ParseRoundUnit(args.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := passageoftime.ParseRoundMode(args.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	roundOptions := passageoftime.RoundOptions{
+		Unit:                 unit,
+		Step:                 step,
+		Mode:                 mode,
+		FiscalYearStartMonth: time.Month(args.FiscalYearStartMonth),
+	}
+	if args.WeekStart != "" {
+		weekStart, err := passageoftime.ParseWeekday(args.WeekStart)
+		if err != nil {
+			return nil, err
+		}
+		roundOptions.WeekStart = &weekStart
+	}
+
+	t := time.Now().In(loc)
+	var resolution passageoftime.LocalTimeResolution
+	if args.Timestamp != "" {
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		t, resolution = parsed.Time.In(loc), parsed.Resolution
+	}
+
+	rounded, err := passageoftime.RoundTimestamp(t, roundOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"input":                t.Format(time.RFC3339Nano),
+		"result":               rounded.Time.Format("2006-01-02 15:04:05"),
+		"iso":                  rounded.Time.Format(time.RFC3339),
+		"day_of_week":          rounded.Time.Format("Monday"),
+		"period_start":         rounded.PeriodStart.Format(time.RFC3339),
+		"period_end":           rounded.PeriodEnd.Format(time.RFC3339),
+		"period_end_inclusive": rounded.PeriodEnd.Add(-time.Second).Format(time.RFC3339),
+		"unit":                 string(unit),
+		"step":                 step,
+		"mode":                 string(mode),
+		"timezone":             timezone,
+	}
+	addLocalTimeFlags(result, resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleCalendarInfo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[CalendarInfoArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	t := time.Now().In(loc)
+	var resolution passageoftime.LocalTimeResolution
+	if args.Timestamp != "" {
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		t, resolution = parsed.Time.In(loc), parsed.Resolution
+	}
+
+	naming, err := passageoftime.ParseFiscalYearNaming(args.FiscalYearNaming)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := passageoftime.GetCalendarInfo(t, time.Month(args.FiscalYearStartMonth))
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make(map[string]float64, len(info.Progress))
+	for _, p := range info.Progress {
+		progress[p.Period] = math.Round(p.Percent*100) / 100
+	}
+
+	result := map[string]interface{}{
+		"date":                    t.Format("2006-01-02"),
+		"day_of_week":             t.Format("Monday"),
+		"iso_week":                fmt.Sprintf("%d-W%02d", info.ISOYear, info.ISOWeek),
+		"iso_week_number":         info.ISOWeek,
+		"iso_week_year":           info.ISOYear,
+		"us_week_number":          info.USWeek,
+		"quarter":                 fmt.Sprintf("Q%d", info.Quarter),
+		"day_of_year":             info.DayOfYear,
+		"days_in_month":           info.DaysInMonth,
+		"days_in_year":            info.DaysInYear,
+		"is_leap_year":            info.IsLeapYear,
+		"fiscal_year":             info.FiscalYearLabel(naming),
+		"fiscal_year_naming":      string(naming),
+		"fiscal_quarter":          fmt.Sprintf("Q%d", info.FiscalQuarter),
+		"fiscal_year_start_month": info.FiscalYearStartMonth.String(),
+		"percent_elapsed":         progress,
+		"timezone":                timezone,
+	}
+	addLocalTimeFlags(result, resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleSolarTimes(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[SolarTimesArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	date := time.Now().In(loc)
+	var resolution passageoftime.LocalTimeResolution
+	if args.Date != "" {
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Date, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %w", err)
+		}
+		date, resolution = parsed.Time.In(loc), parsed.Resolution
+	}
+
+	st, err := passageoftime.GetSolarTimes(date, args.Latitude, args.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"date":                      st.Date.Format("2006-01-02"),
+		"timezone":                  timezone,
+		"sunrise":                   formatSolarEvent(st.Sunrise),
+		"sunset":                    formatSolarEvent(st.Sunset),
+		"solar_noon":                formatSolarEvent(&st.SolarNoon),
+		"solar_noon_elevation":      math.Round(st.SolarNoonElevation*10) / 10,
+		"civil_dawn":                formatSolarEvent(st.CivilDawn),
+		"civil_dusk":                formatSolarEvent(st.CivilDusk),
+		"nautical_dawn":             formatSolarEvent(st.NauticalDawn),
+		"nautical_dusk":             formatSolarEvent(st.NauticalDusk),
+		"astronomical_dawn":         formatSolarEvent(st.AstronomicalDawn),
+		"astronomical_dusk":         formatSolarEvent(st.AstronomicalDusk),
+		"golden_hour_morning_end":   formatSolarEvent(st.GoldenHourMorningEnd),
+		"golden_hour_evening_start": formatSolarEvent(st.GoldenHourEveningStart),
+		"day_length":                passageoftime.FormatDuration(st.DayLength.Seconds(), "compact", false),
+		"day_length_seconds":        math.Round(st.DayLength.Seconds()),
+		"midnight_sun":              st.MidnightSun,
+		"polar_night":               st.PolarNight,
+	}
+	addLocalTimeFlags(result, resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// formatSolarEvent renders a solar event time, or "none" when the sun does not reach it
+func formatSolarEvent(t *time.Time) string {
+	if t == nil {
+		return "none"
+	}
+	return t.Format("2006-01-02 15:04:05 MST")
+}
+
+func handleConvertCalendar(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[ConvertCalendarArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	from := args.From
+	if from == "" {
+		from = "gregorian"
+	}
+	source, err := calendars.Get(from)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the input to a Gregorian day (or, for a bare year, a range of days)
+	var day time.Time
+	result := map[string]interface{}{
+		"from": source.ID(),
+	}
+	switch {
+	case args.Date == "":
+		day = time.Now().In(loc)
+	case source.ID() == "gregorian":
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Date, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date: %w", err)
+		}
+		day = parsed.Time.In(loc)
+		addLocalTimeFlags(result, parsed.Resolution)
+	default:
+		date, err := source.Parse(args.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s date: %w", source.Name(), err)
+		}
+		if date.IsYearOnly() {
+			first, last, err := calendars.YearSpan(source, date)
+			if err != nil {
+				return nil, err
+			}
+			fy, fm, fd := calendars.JDNToGregorian(first)
+			ly, lm, ld := calendars.JDNToGregorian(last)
+			result["input"] = source.Format(date)
+			result["gregorian_start"] = fmt.Sprintf("%04d-%02d-%02d", fy, fm, fd)
+			result["gregorian_end"] = fmt.Sprintf("%04d-%02d-%02d", ly, lm, ld)
+			result["days"] = last - first + 1
+			return &mcp.CallToolResultFor[struct{}]{
+				Content: []mcp.Content{
+					&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+				},
+			}, nil
+		}
+		day, err = calendars.ToTime(source, date, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s date: %w", source.Name(), err)
+		}
+		result["input"] = source.Format(date)
+	}
+
+	targets := args.To
+	if len(targets) == 0 {
+		targets = calendars.IDs()
+	}
+
+	var conversions []map[string]interface{}
+	for _, target := range targets {
+		cal, err := calendars.Get(target)
+		if err != nil {
+			return nil, err
+		}
+		entry := map[string]interface{}{
+			"calendar": cal.ID(),
+			"name":     cal.Name(),
+		}
+		if date, err := calendars.FromTime(cal, day); err != nil {
+			entry["error"] = err.Error()
+		} else {
+			entry["date"] = date.String()
+			entry["formatted"] = cal.Format(date)
+			entry["month_name"] = cal.MonthName(date)
+			if date.Era != "" {
+				entry["era"] = date.Era
+			}
+		}
+		conversions = append(conversions, entry)
+	}
+
+	result["gregorian"] = day.Format("2006-01-02")
+	result["day_of_week"] = day.Format("Monday")
+	result["conversions"] = conversions
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleHolidayDates(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[HolidayDatesArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	holiday, err := calendars.GetHoliday(args.Holiday)
+	if err != nil {
+		return nil, err
+	}
+
+	year := args.Year
+	if year == 0 {
+		timezone := args.Timezone
+		if timezone == "" {
+			if args.AutodetectAndUseUserTimezone {
+				timezone = passageoftime.GetSystemTimezone()
+			} else {
+				timezone = defaultTimezone
+			}
+		}
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone: %w", err)
+		}
+		year = time.Now().In(loc).Year()
+	}
+
+	occurrences, err := holiday.Occurrences(year)
+	if err != nil {
+		return nil, err
+	}
+	cal, _ := calendars.Get(holiday.Calendar)
+
+	var dates []map[string]interface{}
+	for _, o := range occurrences {
+		fy, fm, fd := calendars.JDNToGregorian(o.First)
+		ly, lm, ld := calendars.JDNToGregorian(o.Last)
+		first := time.Date(fy, time.Month(fm), fd, 0, 0, 0, 0, time.UTC)
+		dates = append(dates, map[string]interface{}{
+			"start":         first.Format("2006-01-02"),
+			"end":           fmt.Sprintf("%04d-%02d-%02d", ly, lm, ld),
+			"day_of_week":   first.Format("Monday"),
+			"calendar_date": cal.Format(o.Date),
+		})
+	}
+
+	result := map[string]interface{}{
+		"holiday":  holiday.Name,
+		"calendar": cal.Name(),
+		"year":     year,
+		"days":     holiday.Days,
+		"dates":    dates,
+	}
+	if len(dates) == 0 {
+		result["dates"] = "none"
+	}
+	if holiday.Note != "" {
+		result["note"] = holiday.Note
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleIntervalOps(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[IntervalOpsArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      time.Now(),
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	intervals, adjustments, err := parseIntervals(args.Intervals, "intervals", options)
+	if err != nil {
+		return nil, err
+	}
+	others, otherAdjustments, err := parseIntervals(args.OtherIntervals, "other_intervals", options)
+	if err != nil {
+		return nil, err
+	}
+	adjustments = append(adjustments, otherAdjustments...)
+
+	operation := strings.ToLower(strings.TrimSpace(args.Operation))
+	result := map[string]interface{}{
+		"operation": operation,
+		"timezone":  timezone,
+	}
+
+	var output []passageoftime.Interval
+	switch operation {
+	case "intersection", "intersect", "overlap":
+		output = passageoftime.IntersectIntervals(intervals, others)
+	case "union":
+		output = passageoftime.UnionIntervals(intervals, others)
+	case "subtract", "subtraction", "difference":
+		output = passageoftime.SubtractIntervals(intervals, others)
+	case "merge":
+		output = passageoftime.MergeIntervals(intervals)
+	case "gaps", "free":
+		if args.Window == "" {
+			return nil, fmt.Errorf("gaps requires a window")
+		}
+		parsed, err := passageoftime.ParseIntervalDetailed(args.Window, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window: %w", err)
+		}
+		window := parsed.Interval
+		if adjustment := localTimeAdjustment("window", parsed.StartResolution, parsed.EndResolution); adjustment != nil {
+			adjustments = append(adjustments, adjustment)
+		}
+		output = passageoftime.FindGaps(intervals, window)
+		result["window"] = intervalInfo(window, loc)
+		result["busy_duration"] = formatIntervalDuration(passageoftime.TotalDuration(passageoftime.IntersectIntervals(intervals, []passageoftime.Interval{window})))
+	case "contains", "containment":
+		var checks []map[string]interface{}
+		if args.Timestamp != "" {
+			parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp: %w", err)
+			}
+			t := parsed.Time
+			if adjustment := localTimeAdjustment("timestamp", parsed.Resolution); adjustment != nil {
+				adjustments = append(adjustments, adjustment)
+			}
+			checks = append(checks, containmentCheck(intervals, passageoftime.Interval{Start: t, End: t}, t.In(loc).Format(time.RFC3339), loc))
+		}
+		for i, other := range others {
+			checks = append(checks, containmentCheck(intervals, other, args.OtherIntervals[i], loc))
+		}
+		if len(checks) == 0 {
+			return nil, fmt.Errorf("contains requires a timestamp or other_intervals")
+		}
+		allContained := true
+		for _, check := range checks {
+			allContained = allContained && check["contained"].(bool)
+		}
+		result["checks"] = checks
+		result["all_contained"] = allContained
+		addLocalTimeAdjustments(result, adjustments)
+		return &mcp.CallToolResultFor[struct{}]{
+			Content: []mcp.Content{
+				&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown operation '%s' (expected intersection, union, subtract, gaps, merge or contains)", args.Operation)
+	}
+
+	infos := make([]map[string]interface{}, len(output))
+	for i, iv := range output {
+		infos[i] = intervalInfo(iv, loc)
+	}
+	result["intervals"] = infos
+	result["count"] = len(infos)
+	result["total_duration"] = formatIntervalDuration(passageoftime.TotalDuration(output))
+	addLocalTimeAdjustments(result, adjustments)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// parseIntervals parses a list of interval arguments, naming the argument in
+// errors, and returns the DST adjustments of its entries
+func parseIntervals(texts []string, name string, options passageoftime.ParseOptions) ([]passageoftime.Interval, []map[string]interface{}, error) {
+	intervals := make([]passageoftime.Interval, len(texts))
+	var adjustments []map[string]interface{}
+	for i, text := range texts {
+		parsed, err := passageoftime.ParseIntervalDetailed(text, options)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s[%d]: %w", name, i, err)
+		}
+		intervals[i] = parsed.Interval
+		if adjustment := localTimeAdjustment(fmt.Sprintf("%s[%d]", name, i), parsed.StartResolution, parsed.EndResolution); adjustment != nil {
+			adjustments = append(adjustments, adjustment)
+		}
+	}
+	return intervals, adjustments, nil
+}
+
+// intervalInfo renders an interval for a tool result
+func intervalInfo(iv passageoftime.Interval, loc *time.Location) map[string]interface{} {
+	return map[string]interface{}{
+		"start":    iv.Start.In(loc).Format(time.RFC3339),
+		"end":      iv.End.In(loc).Format(time.RFC3339),
+		"duration": formatIntervalDuration(iv.Duration()),
+	}
+}
+
+func formatIntervalDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	return passageoftime.FormatDuration(d.Seconds(), "compact", false)
+}
+
+// containmentCheck reports whether target lies within the intervals
+func containmentCheck(intervals []passageoftime.Interval, target passageoftime.Interval, label string, loc *time.Location) map[string]interface{} {
+	check := map[string]interface{}{
+		"target": label,
+	}
+	if container, ok := passageoftime.IntervalsContain(intervals, target); ok {
+		check["contained"] = true
+		check["within"] = intervalInfo(container, loc)
+	} else {
+		check["contained"] = false
+		var overlap time.Duration
+		for _, iv := range passageoftime.IntersectIntervals(intervals, []passageoftime.Interval{target}) {
+			overlap += iv.Duration()
+		}
+		if overlap > 0 {
+			check["overlap"] = formatIntervalDuration(overlap)
+		}
+	}
+	return check
+}
+
+// newParticipant builds a participant from tool arguments
+func newParticipant(name, timezone, workStart, workEnd string, workDays []string) (passageoftime.Participant, error) {
+	var days []time.Weekday
+	for _, dayName := range workDays {
+		day, err := passageoftime.ParseWeekday(dayName)
+		if err != nil {
+			return passageoftime.Participant{}, err
+		}
+		days = append(days, day)
+	}
+	return passageoftime.Participant{
+		Name:      name,
+		Timezone:  timezone,
+		WorkStart: workStart,
+		WorkEnd:   workEnd,
+		WorkDays:  days,
+	}, nil
+}
+
+func handleFindFreeSlots(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[FindFreeSlotsArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      time.Now(),
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	start := time.Now().In(loc)
+	var resolutions []passageoftime.LocalTimeResolution
+	if args.StartDate != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.StartDate, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+		start = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+
+	end := start.AddDate(0, 0, 7)
+	if args.EndDate != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.EndDate, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+		end = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+		// A bare date covers the whole day
+		if len(strings.TrimSpace(args.EndDate)) == 10 {
+			end = end.AddDate(0, 0, 1)
+		}
+	}
+	if end.Sub(start) > 92*24*time.Hour {
+		return nil, fmt.Errorf("search range is limited to 92 days")
+	}
+
+	duration := args.DurationMinutes
+	if duration <= 0 {
+		duration = 30
+	}
+	maxResults := args.MaxResults
+	if maxResults <= 0 {
+		maxResults = 20
+	} else if maxResults > 100 {
+		maxResults = 100
+	}
+
+	participants := make([]passageoftime.Participant, len(args.Participants))
+	var adjustments []map[string]interface{}
+	for i, p := range args.Participants {
+		participant, err := newParticipant(p.Name, p.Timezone, p.WorkStart, p.WorkEnd, p.WorkDays)
+		if err != nil {
+			return nil, err
+		}
+		label := p.Name
+		if label == "" {
+			label = p.Timezone
+		}
+		var busyAdjustments []map[string]interface{}
+		participant.Busy, busyAdjustments, err = parseIntervals(p.Busy, label+" busy", options)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, busyAdjustments...)
+		participants[i] = participant
+	}
+
+	slots, err := passageoftime.FindFreeSlots(passageoftime.FreeSlotOptions{
+		Participants:       participants,
+		Start:              start,
+		End:                end,
+		MinDuration:        time.Duration(duration) * time.Minute,
+		Buffer:             time.Duration(args.BufferMinutes) * time.Minute,
+		IgnoreWorkingHours: args.IgnoreWorkingHours,
+	})
+	if err != nil {
+		return nil, err
+	}
+	totalCount := len(slots)
+	if len(slots) > maxResults {
+		slots = slots[:maxResults]
+	}
+
+	slotInfos := make([]map[string]interface{}, len(slots))
+	for i, slot := range slots {
+		locals := make([]map[string]interface{}, len(slot.Suggested.Participants))
+		for j, p := range slot.Suggested.Participants {
+			locals[j] = map[string]interface{}{
+				"name":         p.Name,
+				"timezone":     p.Timezone,
+				"local_start":  p.LocalStart.Format("Mon 2006-01-02 15:04 MST"),
+				"local_end":    p.LocalEnd.Format("Mon 2006-01-02 15:04 MST"),
+				"within_hours": p.WithinHours,
+			}
+		}
+
+		slotInfos[i] = map[string]interface{}{
+			"rank":            i + 1,
+			"start":           slot.Start.In(loc).Format(time.RFC3339),
+			"end":             slot.End.In(loc).Format(time.RFC3339),
+			"duration":        formatIntervalDuration(slot.Duration()),
+			"score":           slot.Score,
+			"suggested_start": slot.Suggested.Start.In(loc).Format(time.RFC3339),
+			"suggested_end":   slot.Suggested.End.In(loc).Format(time.RFC3339),
+			"flags":           slot.Suggested.Flags,
+			"participants":    locals,
+		}
+	}
+
+	result := map[string]interface{}{
+		"timezone":         timezone,
+		"range_start":      start.Format(time.RFC3339),
+		"range_end":        end.Format(time.RFC3339),
+		"duration_minutes": duration,
+		"buffer_minutes":   args.BufferMinutes,
+		"free_slot_count":  totalCount,
+		"returned_count":   len(slotInfos),
+		"slots":            slotInfos,
+	}
+	addLocalTimeFlags(result, resolutions...)
+	addLocalTimeAdjustments(result, adjustments)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleAnalyzeTimestamps(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[AnalyzeTimestampsArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	if len(args.Timestamps) == 0 {
+		return nil, fmt.Errorf("at least one timestamp is required")
+	}
+	if len(args.Timestamps) > 1000 {
+		return nil, fmt.Errorf("at most 1000 timestamps can be analyzed at once")
+	}
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	analysis := passageoftime.AnalyzeTimestamps(args.Timestamps, options, now)
+
+	sorted := make([]map[string]interface{}, len(analysis.Sorted))
+	for i, entry := range analysis.Sorted {
+		info := map[string]interface{}{
+			"index": entry.Index,
+			"input": entry.Input,
+			"iso":   entry.Time.In(loc).Format(time.RFC3339),
+		}
+		if i > 0 {
+			info["gap_from_previous"] = formatIntervalDuration(analysis.Gaps[i-1])
+		}
+		if len(entry.Flags) > 0 {
+			info["flags"] = entry.Flags
+		}
+		sorted[i] = info
+	}
+
+	var problems, adjustments []map[string]interface{}
+	for _, entry := range analysis.Entries {
+		if adjustment := localTimeAdjustment(fmt.Sprintf("timestamps[%d]", entry.Index), entry.Resolution); adjustment != nil {
+			adjustments = append(adjustments, adjustment)
+		}
+		switch {
+		case !entry.Parsed():
+			problems = append(problems, map[string]interface{}{
+				"index":  entry.Index,
+				"input":  entry.Input,
+				"reason": "unparseable: " + entry.Error,
+			})
+		case len(entry.Flags) > 0:
+			problems = append(problems, map[string]interface{}{
+				"index":  entry.Index,
+				"input":  entry.Input,
+				"reason": strings.Join(entry.Flags, "; "),
+			})
+		}
+	}
+
+	result := map[string]interface{}{
+		"timezone":          timezone,
+		"count":             len(args.Timestamps),
+		"parsed_count":      analysis.ParsedCount,
+		"unparseable_count": analysis.UnparseableCount,
+		"duplicate_count":   analysis.DuplicateCount,
+		"future_count":      analysis.FutureCount,
+		"outlier_count":     analysis.OutlierCount,
+		"sorted":            sorted,
+	}
+	if len(problems) > 0 {
+		result["problems"] = problems
+	}
+	addLocalTimeAdjustments(result, adjustments)
+	if len(analysis.Sorted) > 0 {
+		result["earliest"] = analysis.Sorted[0].Time.In(loc).Format(time.RFC3339)
+		result["latest"] = analysis.Sorted[len(analysis.Sorted)-1].Time.In(loc).Format(time.RFC3339)
+		result["span"] = formatIntervalDuration(analysis.Span)
+	}
+	if len(analysis.Gaps) > 0 {
+		before := analysis.Sorted[analysis.MaxGapAfter]
+		after := analysis.Sorted[analysis.MaxGapAfter+1]
+		result["gaps"] = map[string]interface{}{
+			"min":    formatIntervalDuration(analysis.MinGap),
+			"max":    formatIntervalDuration(analysis.MaxGap),
+			"mean":   formatIntervalDuration(analysis.MeanGap),
+			"median": formatIntervalDuration(analysis.MedianGap),
+			"largest_gap": map[string]interface{}{
+				"from": before.Time.In(loc).Format(time.RFC3339),
+				"to":   after.Time.In(loc).Format(time.RFC3339),
+			},
+		}
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// scaleReadings converts a reading to every time scale, keyed by scale name
+func scaleReadings(reading passageoftime.ScaleTime) (map[passageoftime.TimeScale]passageoftime.ScaleTime, error) {
+	readings := map[passageoftime.TimeScale]passageoftime.ScaleTime{}
+	for _, scale := range []passageoftime.TimeScale{passageoftime.ScaleUTC, passageoftime.ScaleTAI, passageoftime.ScaleGPS, passageoftime.ScaleTT} {
+		converted, err := passageoftime.ConvertTimeScale(reading, scale)
+		if err != nil {
+			return nil, err
+		}
+		readings[scale] = converted
+	}
+	return readings, nil
+}
+
+func handleTimeScales(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TimeScalesArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	scale, err := passageoftime.ParseTimeScale(args.Scale)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	var reading passageoftime.ScaleTime
+	var resolutions []passageoftime.LocalTimeResolution
+	if args.Timestamp == "" {
+		reading, err = passageoftime.ConvertTimeScale(passageoftime.ScaleTime{Scale: passageoftime.ScaleUTC, Time: now.UTC()}, scale)
+	} else {
+		var resolution passageoftime.LocalTimeResolution
+		reading, resolution, err = passageoftime.ParseScaleTimeDetailed(args.Timestamp, scale, options)
+		resolutions = append(resolutions, resolution)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	readings, err := scaleReadings(reading)
+	if err != nil {
+		return nil, err
+	}
+	utc := readings[passageoftime.ScaleUTC]
+	offset, err := passageoftime.TAIMinusUTC(utc.Time)
+	if err != nil {
+		return nil, err
+	}
+	if utc.LeapSecond {
+		offset++
+	}
+	week, seconds := passageoftime.GPSWeekSeconds(readings[passageoftime.ScaleGPS].Time)
+
+	result := map[string]interface{}{
+		"input_scale":               string(scale),
+		"utc":                       utc.String(),
+		"tai":                       readings[passageoftime.ScaleTAI].String(),
+		"gps":                       readings[passageoftime.ScaleGPS].String(),
+		"tt":                        readings[passageoftime.ScaleTT].String(),
+		"tai_minus_utc":             offset,
+		"gps_minus_utc":             offset - 19,
+		"gps_week":                  week,
+		"gps_seconds_of_week":       seconds,
+		"leap_second_table_expires": passageoftime.LeapSecondTableExpires.Format("2006-01-02"),
+	}
+	if utc.LeapSecond {
+		result["leap_second"] = true
+	}
+
+	latest := utc.Time
+	if args.EndTimestamp != "" {
+		end, resolution, err := passageoftime.ParseScaleTimeDetailed(args.EndTimestamp, scale, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_timestamp: %w", err)
+		}
+		resolutions = append(resolutions, resolution)
+		endReadings, err := scaleReadings(end)
+		if err != nil {
+			return nil, err
+		}
+		endUTC := endReadings[passageoftime.ScaleUTC]
+		if endUTC.Time.After(latest) {
+			latest = endUTC.Time
+		}
+
+		// TAI counts every SI second, so its difference is the true elapsed time
+		elapsed := endReadings[passageoftime.ScaleTAI].Time.Sub(readings[passageoftime.ScaleTAI].Time)
+		naive := endUTC.Time.Sub(utc.Time)
+		_, applied, err := passageoftime.LeapSecondElapsed(utc.Time, endUTC.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		appliedLabels := []string{}
+		for _, ls := range applied {
+			appliedLabels = append(appliedLabels, passageoftime.ScaleTime{Scale: passageoftime.ScaleUTC, Time: ls.Effective.Add(-time.Second), LeapSecond: true}.String())
+		}
+		result["end_utc"] = endUTC.String()
+		result["elapsed_si_seconds"] = elapsed.Seconds()
+		result["elapsed_ignoring_leap_seconds"] = naive.Seconds()
+		result["elapsed"] = formatIntervalDuration(elapsed)
+		result["leap_seconds_applied"] = appliedLabels
+		if leap := elapsed - naive; leap != 0 {
+			result["note"] = fmt.Sprintf("%d leap second(s) were inserted between the instants, so %v SI seconds elapsed rather than the %v a plain UTC difference gives", int(math.Abs(leap.Seconds())), elapsed.Seconds(), naive.Seconds())
+		} else {
+			result["note"] = "No leap seconds were inserted between the instants; the SI and UTC differences agree"
+		}
+	}
+
+	addLocalTimeFlags(result, resolutions...)
+
+	if latest.After(passageoftime.LeapSecondTableExpires) || now.After(passageoftime.LeapSecondTableExpires) {
+		result["warning"] = fmt.Sprintf("The embedded leap-second table expired on %s; leap seconds announced since then are not applied", passageoftime.LeapSecondTableExpires.Format("2006-01-02"))
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleDecodeIDTimestamp(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[DecodeIDTimestampArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	if strings.TrimSpace(args.ID) == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	kind, err := passageoftime.ParseIDKind(args.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		Timezone:      timezone,
+		ReferenceTime: now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+	epoch, err := passageoftime.ParseSnowflakeEpochDetailed(args.SnowflakeEpoch, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := passageoftime.DecodeIDTimestamp(args.ID, kind, epoch.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	elapsed := now.Sub(decoded.Timestamp).Seconds()
+	result := map[string]interface{}{
+		"id":        args.ID,
+		"kind":      string(decoded.Kind),
+		"timestamp": decoded.Timestamp.In(loc).Format(time.RFC3339Nano),
+		"utc":       decoded.Timestamp.Format(time.RFC3339Nano),
+		"unix_ms":   decoded.Timestamp.UnixMilli(),
+		"precision": decoded.Precision,
+		"timezone":  timezone,
+		"time_since": map[string]interface{}{
+			"seconds":   elapsed,
+			"formatted": passageoftime.FormatDuration(math.Abs(elapsed), "full", elapsed < 0),
+			"context":   passageoftime.GetTimeContext(decoded.Timestamp, now, elapsed),
+		},
+	}
+	if decoded.Version != 0 {
+		result["version"] = decoded.Version
+	}
+	for key, value := range decoded.Details {
+		result[key] = value
+	}
+	if args.Kind == "" && decoded.Kind == passageoftime.IDKindSnowflake && args.SnowflakeEpoch == "" {
+		result["note"] = "Assumed the Twitter Snowflake epoch; pass snowflake_epoch (e.g. discord) for IDs from other systems"
+	}
+	addLocalTimeFlags(result, epoch.Resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// workingTimeOptions builds the schedule, breaks and holidays shared by the
+// working-time tools
+func workingTimeOptions(schedule, breaks, holidays []string, loc *time.Location, options passageoftime.ParseOptions) (passageoftime.WorkingTimeOptions, error) {
+	result := passageoftime.WorkingTimeOptions{Location: loc, Hours: passageoftime.DefaultWorkingHours()}
+	if len(schedule) > 0 {
+		hours, err := passageoftime.ParseWorkingHours(schedule)
+		if err != nil {
+			return result, err
+		}
+		if len(hours) == 0 {
+			return result, fmt.Errorf("schedule has no working hours")
+		}
+		result.Hours = hours
+	}
+	for _, text := range breaks {
+		r, err := passageoftime.ParseClockRange(text)
+		if err != nil {
+			return result, fmt.Errorf("invalid break: %w", err)
+		}
+		result.Breaks = append(result.Breaks, r)
+	}
+	for _, text := range holidays {
+		t, err := passageoftime.ParseFuzzyTimestamp(text, options)
+		if err != nil {
+			return result, fmt.Errorf("invalid holiday '%s': %w", text, err)
+		}
+		result.Holidays = append(result.Holidays, t)
+	}
+	return result, nil
+}
+
+// describeWorkingHours lists a schedule as "Monday 09:00-17:00" entries
+func describeWorkingHours(hours passageoftime.WorkingHours) []string {
+	var lines []string
+	for _, d := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday} {
+		var ranges []string
+		for _, r := range hours[d] {
+			ranges = append(ranges, r.String())
+		}
+		if len(ranges) > 0 {
+			lines = append(lines, d.String()+" "+strings.Join(ranges, ", "))
+		}
+	}
+	return lines
+}
+
+func handleWorkingTimeBetween(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[WorkingTimeBetweenArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	parsedStart, err := passageoftime.ParseFuzzyTimestampDetailed(args.Start, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+	start := parsedStart.Time
+	resolutions := []passageoftime.LocalTimeResolution{parsedStart.Resolution}
+	end := now
+	if args.End != "" {
+		parsedEnd, err := passageoftime.ParseFuzzyTimestampDetailed(args.End, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end: %w", err)
+		}
+		end = parsedEnd.Time
+		resolutions = append(resolutions, parsedEnd.Resolution)
+	}
+	if end.Sub(start) > 400*24*time.Hour {
+		return nil, fmt.Errorf("period is limited to 400 days")
+	}
+
+	workingOptions, err := workingTimeOptions(args.Schedule, args.Breaks, args.Holidays, loc, options)
+	if err != nil {
+		return nil, err
+	}
+	workingOptions.Start, workingOptions.End = start, end
+
+	working, err := passageoftime.WorkingTimeBetween(workingOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]map[string]interface{}, len(working.Days))
+	for i, day := range working.Days {
+		info := map[string]interface{}{
+			"date":            day.Date.Format("2006-01-02"),
+			"weekday":         day.Date.Weekday().String(),
+			"working_seconds": day.Worked.Seconds(),
+			"worked":          formatIntervalDuration(day.Worked),
+		}
+		if day.Holiday {
+			info["holiday"] = true
+		} else {
+			var periods []string
+			for _, iv := range day.Intervals {
+				periods = append(periods, iv.Start.In(loc).Format("15:04")+"-"+iv.End.In(loc).Format("15:04"))
+			}
+			info["periods"] = periods
+		}
+		days[i] = info
+	}
+
+	result := map[string]interface{}{
+		"start":           start.In(loc).Format(time.RFC3339),
+		"end":             end.In(loc).Format(time.RFC3339),
+		"timezone":        timezone,
+		"working_seconds": working.Total.Seconds(),
+		"working_hours":   math.Round(working.Total.Hours()*100) / 100,
+		"working_time":    formatIntervalDuration(working.Total),
+		"elapsed":         formatIntervalDuration(end.Sub(start)),
+		"schedule":        describeWorkingHours(workingOptions.Hours),
+		"days":            days,
+	}
+	addLocalTimeFlags(result, resolutions...)
+	if len(workingOptions.Breaks) > 0 {
+		var breaks []string
+		for _, r := range workingOptions.Breaks {
+			breaks = append(breaks, r.String())
+		}
+		result["breaks"] = breaks
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// businessDaysPattern matches an SLA given in business days, e.g. "2d" or "3 business days"
+var businessDaysPattern = regexp.MustCompile(`(?i)^(\d+)\s*(d|days?|business\s+days?|working\s+days?)$`)
+
+func handleSLADeadline(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[SLADeadlineArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	parsedStart, err := passageoftime.ParseFuzzyTimestampDetailed(args.Start, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+	start := parsedStart.Time
+
+	workingOptions, err := workingTimeOptions(args.Schedule, args.Breaks, args.Holidays, loc, options)
+	if err != nil {
+		return nil, err
+	}
+	var adjustments []map[string]interface{}
+	workingOptions.Pauses, adjustments, err = parseIntervals(args.Pauses, "pauses", options)
+	if err != nil {
+		return nil, err
+	}
+	workingOptions.Start = start
+
+	durationText := strings.TrimSpace(args.Duration)
+	var due time.Time
+	if m := businessDaysPattern.FindStringSubmatch(durationText); m != nil {
+		days, _ := strconv.Atoi(m[1])
+		due, err = passageoftime.AddWorkingDays(workingOptions, days)
+	} else if d, parseErr := time.ParseDuration(durationText); parseErr == nil {
+		due, err = passageoftime.AddWorkingTime(workingOptions, d)
+	} else {
+		return nil, fmt.Errorf("invalid duration '%s': use hours/minutes such as '6h' or '90m', or business days such as '2d'", args.Duration)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dueIn := map[string]interface{}{timezone: due.In(loc).Format(time.RFC3339)}
+	for _, name := range args.DisplayTimezones {
+		displayLoc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid display timezone '%s': %w", name, err)
+		}
+		dueIn[name] = due.In(displayLoc).Format(time.RFC3339)
+	}
+
+	result := map[string]interface{}{
+		"start":    start.In(loc).Format(time.RFC3339),
+		"duration": durationText,
+		"due":      due.In(loc).Format(time.RFC3339),
+		"due_utc":  due.UTC().Format(time.RFC3339),
+		"due_in":   dueIn,
+		"due_day":  due.In(loc).Weekday().String(),
+		"elapsed":  formatIntervalDuration(due.Sub(start)),
+		"timezone": timezone,
+		"schedule": describeWorkingHours(workingOptions.Hours),
+	}
+	addLocalTimeFlags(result, parsedStart.Resolution)
+	addLocalTimeAdjustments(result, adjustments)
+	if now.After(due) {
+		result["status"] = "overdue"
+		result["overdue_by"] = formatIntervalDuration(now.Sub(due))
+	} else {
+		result["status"] = "open"
+		result["remaining"] = formatIntervalDuration(due.Sub(now))
+		from := now
+		if start.After(now) {
+			from = start
+		}
+		workingOptions.Start, workingOptions.End = from, due
+		if remaining, err := passageoftime.WorkingTimeBetween(workingOptions); err == nil {
+			result["remaining_working_time"] = formatIntervalDuration(remaining.Total)
+		}
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleTimezoneInfo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TimezoneInfoArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := strings.TrimSpace(args.Timezone)
+	if timezone == "" {
+		return nil, fmt.Errorf("timezone is required")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	now := time.Now()
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      now,
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	at := now
+	var resolutions []passageoftime.LocalTimeResolution
+	if args.At != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.At, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid at: %w", err)
+		}
+		at = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+
+	// The tz database starts every zone in local mean time, well after 1800
+	historyStart := time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)
+	if args.HistoryStart != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.HistoryStart, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history_start: %w", err)
+		}
+		historyStart = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+	historyEnd := now.AddDate(1, 0, 0)
+	if at.After(now) {
+		historyEnd = at.AddDate(1, 0, 0)
+	}
+	if args.HistoryEnd != "" {
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.HistoryEnd, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history_end: %w", err)
+		}
+		historyEnd = parsed.Time
+		resolutions = append(resolutions, parsed.Resolution)
+	}
+
+	info, err := passageoftime.GetZoneInfo(timezone, at, historyStart, historyEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]map[string]interface{}, len(info.Report.Transitions))
+	for i, tr := range info.Report.Transitions {
+		transitions[i] = transitionInfo(tr, now)
+	}
+
+	result := map[string]interface{}{
+		"timezone":         timezone,
+		"canonical_id":     info.Canonical,
+		"is_alias":         info.Canonical != timezone,
+		"aliases":          info.Aliases,
+		"at":               info.At.Format(time.RFC3339),
+		"at_utc":           info.At.UTC().Format(time.RFC3339),
+		"offset":           passageoftime.FormatOffset(info.Offset),
+		"offset_seconds":   info.Offset,
+		"abbreviation":     info.Abbreviation,
+		"is_dst":           info.IsDST,
+		"observes_dst":     info.Report.ObservesDST,
+		"rule_at":          info.Report.CurrentRule,
+		"history_start":    historyStart.UTC().Format(time.RFC3339),
+		"history_end":      historyEnd.UTC().Format(time.RFC3339),
+		"transition_count": len(transitions),
+		"transitions":      transitions,
+		"tzdata_version":   info.TzdataVersion,
+		"tzdata_source":    info.TzdataSource,
+	}
+	if !info.PeriodStart.IsZero() {
+		result["offset_in_effect_since"] = info.PeriodStart.In(info.At.Location()).Format(time.RFC3339)
+	}
+	if !info.PeriodEnd.IsZero() {
+		result["offset_in_effect_until"] = info.PeriodEnd.In(info.At.Location()).Format(time.RFC3339)
+	}
+	if info.LastDSTEnd != nil {
+		result["last_dst_end"] = transitionInfo(*info.LastDSTEnd, now)
+	}
+	addLocalTimeFlags(result, resolutions...)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+// relativeTimeOptions builds the duration rendering options shared by
+// format_duration, time_since and time_difference
+func relativeTimeOptions(locale *passageoftime.Locale, maxUnits int, rounding, largestUnit, smallestUnit, phrasing string, approximate bool) (passageoftime.RelativeTimeOptions, error) {
+	options := passageoftime.RelativeTimeOptions{MaxUnits: maxUnits, Approximate: approximate, Locale: locale}
+	if maxUnits < 0 {
+		return options, fmt.Errorf("max_units must not be negative")
+	}
+	var err error
+	if options.Rounding, err = passageoftime.ParseRoundMode(rounding); err != nil {
+		return options, err
+	}
+	if options.Phrasing, err = passageoftime.ParsePhrasing(phrasing); err != nil {
+		return options, err
+	}
+	if largestUnit != "" {
+		if options.LargestUnit, err = passageoftime.ParseDurationUnit(largestUnit); err != nil {
+			return options, fmt.Errorf("invalid largest_unit: %w", err)
+		}
+	}
+	if smallestUnit != "" {
+		if options.SmallestUnit, err = passageoftime.ParseDurationUnit(smallestUnit); err != nil {
+			return options, fmt.Errorf("invalid smallest_unit: %w", err)
+		}
+	}
+	largest, smallest := options.LargestUnit, options.SmallestUnit
+	if largest == "" {
+		largest = passageoftime.UnitDay
+	}
+	if smallest == "" {
+		smallest = passageoftime.UnitSecond
+	}
+	if passageoftime.CompareDurationUnits(largest, smallest) < 0 {
+		return options, fmt.Errorf("largest_unit %s is smaller than smallest_unit %s", largest, smallest)
+	}
+	return options, nil
+}
+
+func handleFormatTimestamp(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[FormatTimestampArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	timezone := args.Timezone
+	if timezone == "" {
+		if args.AutodetectAndUseUserTimezone {
+			timezone = passageoftime.GetSystemTimezone()
+		} else {
+			timezone = defaultTimezone
+		}
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	options := passageoftime.ParseOptions{
+		EnableFuzzyParsing: args.EnableFuzzyParsing,
+		Timezone:           timezone,
+		ReferenceTime:      time.Now(),
+	}
+	if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+		return nil, err
+	}
+
+	parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Timestamp, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	t := parsed.Time.In(loc)
+
+	precision := -1
+	if args.Precision != nil {
+		precision = *args.Precision
+		if precision < 0 {
+			return nil, fmt.Errorf("precision must not be negative")
+		}
+	}
+
+	var formats []passageoftime.TimestampFormat
+	if len(args.Formats) == 0 {
+		formats = passageoftime.TimestampFormats()
+	}
+	for _, name := range args.Formats {
+		f, err := passageoftime.LookupTimestampFormat(name)
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, f)
+	}
+
+	rendered := map[string]interface{}{}
+	for _, f := range formats {
+		text, err := f.Format(t, precision)
+		if err != nil {
+			return nil, err
+		}
+		rendered[f.Name] = text
+	}
+
+	result := map[string]interface{}{
+		"timestamp": args.Timestamp,
+		"timezone":  timezone,
+		"formats":   rendered,
+	}
+	addLocalTimeFlags(result, parsed.Resolution)
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleParseDuration(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[ParseDurationArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	duration, err := passageoftime.ParseDuration(args.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := duration.Seconds()
+	clock := duration.Clock
+	result := map[string]interface{}{
+		"input":    args.Duration,
+		"syntax":   duration.Syntax,
+		"seconds":  seconds,
+		"negative": duration.Negative,
+		"iso8601":  duration.ISO8601(),
+		"components": map[string]interface{}{
+			"years":   duration.Years,
+			"months":  duration.Months,
+			"days":    duration.Days,
+			"hours":   int(clock / time.Hour),
+			"minutes": int(clock % time.Hour / time.Minute),
+			"seconds": (clock % time.Minute).Seconds(),
+		},
+		"formatted": passageoftime.FormatRelativeTime(seconds, passageoftime.RelativeTimeOptions{LargestUnit: passageoftime.UnitYear}),
+		"exact":     duration.Exact(),
+	}
+	if !duration.Exact() {
+		result["note"] = "months and years have no fixed length; seconds counts them as Gregorian averages (30.436875 and 365.2425 days). Pass reference for the exact length from a given start."
+	}
+
+	if args.Reference != "" {
+		timezone := args.Timezone
+		if timezone == "" {
+			if args.AutodetectAndUseUserTimezone {
+				timezone = passageoftime.GetSystemTimezone()
+			} else {
+				timezone = defaultTimezone
+			}
+		}
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone: %w", err)
+		}
+		options := passageoftime.ParseOptions{
+			EnableFuzzyParsing: args.EnableFuzzyParsing,
+			Timezone:           timezone,
+			ReferenceTime:      time.Now(),
+		}
+		if err := applyLocalTimePolicies(&options, args.NonexistentTime, args.AmbiguousTime); err != nil {
+			return nil, err
+		}
+		parsed, err := passageoftime.ParseFuzzyTimestampDetailed(args.Reference, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reference: %w", err)
+		}
+		reference := parsed.Time.In(loc)
+		end := duration.AddTo(reference)
+		result["reference"] = reference.Format(time.RFC3339)
+		result["end"] = end.Format(time.RFC3339)
+		result["seconds_from_reference"] = end.Sub(reference).Seconds()
+		addLocalTimeFlags(result, parsed.Resolution)
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
+
+func handleConvertZoneID(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[ConvertZoneIDArgs]) (*mcp.CallToolResultFor[struct{}], error) {
+	args := params.Arguments
+
+	zoneID := strings.TrimSpace(args.ZoneID)
+	if zoneID == "" {
+		return nil, fmt.Errorf("zone_id is required")
+	}
+
+	direction := strings.ToLower(strings.TrimSpace(args.Direction))
+	switch direction {
+	case "", "auto":
+		direction = "iana_to_windows"
+		if passageoftime.IsWindowsZoneID(zoneID) {
+			direction = "windows_to_iana"
+		}
+	case "windows_to_iana", "iana_to_windows":
+	default:
+		return nil, fmt.Errorf("invalid direction '%s' (use auto, windows_to_iana or iana_to_windows)", args.Direction)
+	}
+
+	var conversion *passageoftime.ZoneIDConversion
+	var err error
+	if direction == "windows_to_iana" {
+		conversion, err = passageoftime.WindowsToIANAZone(zoneID, args.Territory)
+	} else {
+		conversion, err = passageoftime.IANAToWindowsZone(zoneID, args.Territory)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"direction":  direction,
+		"input":      zoneID,
+		"windows":    conversion.Windows,
+		"iana":       conversion.IANA,
+		"iana_zones": conversion.IANAZones,
+		"canonical":  conversion.Canonical,
+		"territory":  conversion.Territory,
+	}
+	if conversion.TerritoryName != "" {
+		result["territory_name"] = conversion.TerritoryName
+	}
+	if conversion.Fallback {
+		result["note"] = fmt.Sprintf("CLDR has no %s entry for territory %s; using its default zone", conversion.Windows, strings.ToUpper(args.Territory))
+	}
+
+	return &mcp.CallToolResultFor[struct{}]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("%v", result)},
+		},
+	}, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestAddWorkingTime tests deadlines in business hours and business days
+func TestAddWorkingTime(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	options := passageoftime.WorkingTimeOptions{
+		Start:    time.Date(2025, 3, 7, 16, 30, 0, 0, paris), // Friday
+		Location: paris,
+	}
+
+	// 30 minutes on Friday, then 5.5 hours on Monday
+	due, err := passageoftime.AddWorkingTime(options, 6*time.Hour)
+	if err != nil {
+		t.Fatalf("AddWorkingTime() error = %v", err)
+	}
+	if want := time.Date(2025, 3, 10, 14, 30, 0, 0, paris); !due.Equal(want) {
+		t.Errorf("AddWorkingTime(6h) = %v, want %v", due, want)
+	}
+
+	lunch, _ := passageoftime.ParseClockRange("12:00-13:00")
+	options.Breaks = []passageoftime.ClockRange{lunch}
+	options.Pauses = []passageoftime.Interval{{Start: time.Date(2025, 3, 10, 9, 30, 0, 0, paris), End: time.Date(2025, 3, 10, 10, 30, 0, 0, paris)}}
+	due, _ = passageoftime.AddWorkingTime(options, 6*time.Hour)
+	if want := time.Date(2025, 3, 10, 16, 30, 0, 0, paris); !due.Equal(want) {
+		t.Errorf("AddWorkingTime(6h) with lunch and a pause = %v, want %v", due, want)
+	}
+
+	options.Holidays = []time.Time{time.Date(2025, 3, 10, 0, 0, 0, 0, paris)}
+	due, err = passageoftime.AddWorkingDays(options, 2)
+	if err != nil {
+		t.Fatalf("AddWorkingDays() error = %v", err)
+	}
+	if want := time.Date(2025, 3, 12, 17, 0, 0, 0, paris); !due.Equal(want) {
+		t.Errorf("AddWorkingDays(2) = %v, want %v", due, want)
+	}
+
+	if _, err := passageoftime.AddWorkingTime(passageoftime.WorkingTimeOptions{Hours: passageoftime.WorkingHours{}}, time.Hour); err == nil {
+		t.Error("AddWorkingTime() should fail when the schedule has no working hours")
+	}
+}
+
+// TestHandleSLADeadline tests the sla_deadline handler
+func TestHandleSLADeadline(t *testing.T) {
+	args := SLADeadlineArgs{
+		Start:            "2025-03-07 16:30",
+		Duration:         "6h",
+		Timezone:         "Europe/Paris",
+		DisplayTimezones: []string{"America/New_York"},
+	}
+	got, err := handleSLADeadline(context.Background(), nil, &mcp.CallToolParamsFor[SLADeadlineArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleSLADeadline() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"due:2025-03-10T14:30:00+01:00", "America/New_York:2025-03-10T09:30:00-04:00", "due_day:Monday", "status:overdue",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleSLADeadline() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args.Duration = "soon"
+	if _, err := handleSLADeadline(context.Background(), nil, &mcp.CallToolParamsFor[SLADeadlineArgs]{Arguments: args}); err == nil {
+		t.Error("handleSLADeadline() should reject an invalid duration")
+	}
+}
+
+// TestHandleSLADeadlineLocalTime tests that a start in the DST overlap is flagged
+func TestHandleSLADeadlineLocalTime(t *testing.T) {
+	args := SLADeadlineArgs{
+		Start:    "2025-10-26 02:30",
+		Duration: "6h",
+		Timezone: "Europe/Paris",
+	}
+	got, err := handleSLADeadline(context.Background(), nil, &mcp.CallToolParamsFor[SLADeadlineArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleSLADeadline() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"ambiguous_local_time:true", "local_time_note:", "due:2025-10-27T15:00:00+01:00"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleSLADeadline() = %v, want to contain %v", text, want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestGetSolarTimes tests sunrise and sunset against published NOAA values (±2 minutes)
+func TestGetSolarTimes(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		lat, lon float64
+		date     string
+		sunrise  string
+		sunset   string
+	}{
+		{"New York summer solstice", "America/New_York", 40.7128, -74.0060, "2025-06-21", "05:25", "20:31"},
+		{"Sydney new year", "Australia/Sydney", -33.8688, 151.2093, "2025-01-01", "05:47", "20:09"},
+		{"London winter solstice", "Europe/London", 51.5074, -0.1278, "2025-12-21", "08:04", "15:53"},
+		{"Kiritimati far from its meridian", "Pacific/Kiritimati", 1.87, -157.4, "2025-03-20", "06:33", "18:40"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, _ := time.LoadLocation(tt.timezone)
+			date, _ := time.ParseInLocation("2006-01-02", tt.date, loc)
+
+			st, err := passageoftime.GetSolarTimes(date, tt.lat, tt.lon)
+			if err != nil {
+				t.Fatalf("GetSolarTimes() error = %v", err)
+			}
+			if st.Sunrise == nil || st.Sunset == nil {
+				t.Fatalf("GetSolarTimes() has no sunrise/sunset: %+v", st)
+			}
+			checkClose(t, "sunrise", *st.Sunrise, tt.date, tt.sunrise, loc)
+			checkClose(t, "sunset", *st.Sunset, tt.date, tt.sunset, loc)
+
+			if !st.CivilDawn.Before(*st.Sunrise) || !st.NauticalDawn.Before(*st.CivilDawn) || !st.AstronomicalDawn.Before(*st.NauticalDawn) {
+				t.Errorf("dawns out of order: %v %v %v %v", st.AstronomicalDawn, st.NauticalDawn, st.CivilDawn, st.Sunrise)
+			}
+			if !st.GoldenHourMorningEnd.After(*st.Sunrise) || !st.GoldenHourEveningStart.Before(*st.Sunset) {
+				t.Errorf("golden hours out of order: %v %v", st.GoldenHourMorningEnd, st.GoldenHourEveningStart)
+			}
+			if st.SolarNoon.Format("2006-01-02") != tt.date {
+				t.Errorf("SolarNoon = %v, want on %s", st.SolarNoon, tt.date)
+			}
+		})
+	}
+}
+
+func checkClose(t *testing.T, name string, got time.Time, date, want string, loc *time.Location) {
+	t.Helper()
+	expected, _ := time.ParseInLocation("2006-01-02 15:04", date+" "+want, loc)
+	if diff := got.Sub(expected); diff < -2*time.Minute || diff > 2*time.Minute {
+		t.Errorf("%s = %s, want %s", name, got.Format("2006-01-02 15:04"), want)
+	}
+}
+
+// TestPolarDayAndNight tests Tromsø in midwinter and midsummer
+func TestPolarDayAndNight(t *testing.T) {
+	oslo, _ := time.LoadLocation("Europe/Oslo")
+
+	winter, err := passageoftime.GetSolarTimes(time.Date(2025, 12, 21, 0, 0, 0, 0, oslo), 69.6492, 18.9553)
+	if err != nil {
+		t.Fatalf("GetSolarTimes() error = %v", err)
+	}
+	if !winter.PolarNight || winter.Sunrise != nil || winter.DayLength != 0 || winter.CivilDawn == nil {
+		t.Errorf("winter = %+v, want polar night with civil twilight", winter)
+	}
+
+	summer, err := passageoftime.GetSolarTimes(time.Date(2025, 6, 21, 0, 0, 0, 0, oslo), 69.6492, 18.9553)
+	if err != nil {
+		t.Fatalf("GetSolarTimes() error = %v", err)
+	}
+	if !summer.MidnightSun || summer.Sunset != nil || summer.DayLength != 24*time.Hour {
+		t.Errorf("summer = %+v, want midnight sun", summer)
+	}
+
+	if _, err := passageoftime.GetSolarTimes(time.Now(), 91, 0); err == nil {
+		t.Error("GetSolarTimes() should reject latitude 91")
+	}
+}
+
+// TestHandleSolarTimesAndDaylight tests solar_times and the timestamp_context daylight option
+func TestHandleSolarTimesAndDaylight(t *testing.T) {
+	params := &mcp.CallToolParamsFor[SolarTimesArgs]{
+		Arguments: SolarTimesArgs{
+			Latitude:  69.6492,
+			Longitude: 18.9553,
+			Date:      "2025-12-21",
+			Timezone:  "Europe/Oslo",
+		},
+	}
+	got, err := handleSolarTimes(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("handleSolarTimes() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"sunrise:none", "polar_night:true", "civil_dawn:2025-12-21"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleSolarTimes() = %v, want to contain %v", text, want)
+		}
+	}
+
+	// 10:00 in Tromsø in December is "morning" by the clock but the sun is below the horizon
+	lat, lon := 69.6492, 18.9553
+	contextParams := &mcp.CallToolParamsFor[TimestampContextArgs]{
+		Arguments: TimestampContextArgs{
+			Timestamp: "2025-12-21 10:00:00",
+			Timezone:  "Europe/Oslo",
+			Latitude:  &lat,
+			Longitude: &lon,
+		},
+	}
+	got, err = handleTimestampContext(context.Background(), nil, contextParams)
+	if err != nil {
+		t.Fatalf("handleTimestampContext() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"time_of_day:morning", "is_daylight:false", "daylight_phase:civil_twilight"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimestampContext() = %v, want to contain %v", text, want)
+		}
+	}
+
+	contextParams.Arguments.Longitude = nil
+	if _, err := handleTimestampContext(context.Background(), nil, contextParams); err == nil {
+		t.Error("handleTimestampContext() should require longitude with latitude")
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestConvertTimeScale tests conversions around the leap second at the end of 2016
+func TestConvertTimeScale(t *testing.T) {
+	options := passageoftime.ParseOptions{Timezone: "UTC"}
+	tests := []struct {
+		input string
+		scale passageoftime.TimeScale
+		to    passageoftime.TimeScale
+		want  string
+	}{
+		{"2016-12-31T23:59:59Z", passageoftime.ScaleUTC, passageoftime.ScaleTAI, "2017-01-01T00:00:35 TAI"},
+		{"2016-12-31T23:59:60Z", passageoftime.ScaleUTC, passageoftime.ScaleTAI, "2017-01-01T00:00:36 TAI"},
+		{"2017-01-01T00:00:00Z", passageoftime.ScaleUTC, passageoftime.ScaleTAI, "2017-01-01T00:00:37 TAI"},
+		{"2017-01-01T00:00:36", passageoftime.ScaleTAI, passageoftime.ScaleUTC, "2016-12-31T23:59:60Z"},
+		{"2017-01-01T00:00:36.5", passageoftime.ScaleTAI, passageoftime.ScaleUTC, "2016-12-31T23:59:60.5Z"},
+		{"2025-03-01T12:00:00Z", passageoftime.ScaleUTC, passageoftime.ScaleGPS, "2025-03-01T12:00:18 GPS"},
+		{"2025-03-01T12:00:00Z", passageoftime.ScaleUTC, passageoftime.ScaleTT, "2025-03-01T12:01:09.184 TT"},
+		{"1980-01-06T00:00:00", passageoftime.ScaleGPS, passageoftime.ScaleUTC, "1980-01-06T00:00:00Z"},
+		{"0", passageoftime.ScaleGPS, passageoftime.ScaleTAI, "1980-01-06T00:00:19 TAI"},
+	}
+
+	for _, tt := range tests {
+		reading, err := passageoftime.ParseScaleTime(tt.input, tt.scale, options)
+		if err != nil {
+			t.Errorf("ParseScaleTime(%q, %s) error = %v", tt.input, tt.scale, err)
+			continue
+		}
+		got, err := passageoftime.ConvertTimeScale(reading, tt.to)
+		if err != nil {
+			t.Errorf("ConvertTimeScale(%q, %s) error = %v", tt.input, tt.to, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ConvertTimeScale(%q, %s) = %s, want %s", tt.input, tt.to, got, tt.want)
+		}
+	}
+
+	if _, err := passageoftime.ParseScaleTime("2018-12-31T23:59:60Z", passageoftime.ScaleUTC, options); err == nil {
+		t.Error("ParseScaleTime() should reject 23:59:60 on a day without a leap second")
+	}
+	if _, err := passageoftime.TAIMinusUTC(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("TAIMinusUTC() should reject instants before 1972")
+	}
+}
+
+// TestLeapSecondElapsed tests elapsed time across inserted leap seconds
+func TestLeapSecondElapsed(t *testing.T) {
+	from := time.Date(2015, 6, 30, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)
+	elapsed, applied, err := passageoftime.LeapSecondElapsed(from, to)
+	if err != nil {
+		t.Fatalf("LeapSecondElapsed() error = %v", err)
+	}
+	if want := to.Sub(from) + 2*time.Second; elapsed != want {
+		t.Errorf("elapsed = %v, want %v", elapsed, want)
+	}
+	if len(applied) != 2 || applied[1].TAIMinusUTC != 37 {
+		t.Errorf("applied = %v, want the 2015 and 2016 leap seconds", applied)
+	}
+
+	week, seconds := passageoftime.GPSWeekSeconds(time.Date(2025, 3, 2, 0, 0, 18, 0, time.UTC))
+	if week != 2356 || seconds != 18 {
+		t.Errorf("GPSWeekSeconds() = %d, %v, want 2356, 18", week, seconds)
+	}
+}
+
+// TestHandleTimeScales tests the time_scales handler
+func TestHandleTimeScales(t *testing.T) {
+	args := TimeScalesArgs{Timestamp: "2016-12-31T23:59:59Z", EndTimestamp: "2017-01-01T00:00:01Z"}
+	got, err := handleTimeScales(context.Background(), nil, &mcp.CallToolParamsFor[TimeScalesArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleTimeScales() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"tai:2017-01-01T00:00:35 TAI", "tai_minus_utc:36",
+		"elapsed_si_seconds:3", "elapsed_ignoring_leap_seconds:2", "leap_seconds_applied:[2016-12-31T23:59:60Z]",
+		"leap_second_table_expires:2026-06-28",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimeScales() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args = TimeScalesArgs{Timestamp: "2025-11-02 01:30", EndTimestamp: "2025-11-02 03:00", Timezone: "America/New_York", AmbiguousTime: "later"}
+	got, err = handleTimeScales(context.Background(), nil, &mcp.CallToolParamsFor[TimeScalesArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleTimeScales() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"ambiguous_local_time:true", "utc:2025-11-02T06:30:00Z", "elapsed_si_seconds:5400"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimeScales() = %v, want to contain %v", text, want)
+		}
+	}
+
+	if _, err := handleTimeScales(context.Background(), nil, &mcp.CallToolParamsFor[TimeScalesArgs]{Arguments: TimeScalesArgs{Scale: "julian"}}); err == nil {
+		t.Error("handleTimeScales() should reject an unknown scale")
+	}
+}
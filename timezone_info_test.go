@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestGetZoneInfo tests historical offsets, aliases and the end of DST
+func TestGetZoneInfo(t *testing.T) {
+	from := time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	info, err := passageoftime.GetZoneInfo("Asia/Calcutta", time.Date(1942, 9, 1, 0, 0, 0, 0, time.UTC), from, to)
+	if err != nil {
+		t.Fatalf("GetZoneInfo() error = %v", err)
+	}
+	// India kept war time, UTC+06:30, from 1942 to 1945
+	if info.Offset != 6*3600+1800 || info.Canonical != "Asia/Kolkata" {
+		t.Errorf("Asia/Calcutta in 1942 = offset %d, canonical %s; want 23400, Asia/Kolkata", info.Offset, info.Canonical)
+	}
+	if len(info.Aliases) == 0 || info.Aliases[0] != "Asia/Kolkata" {
+		t.Errorf("Aliases = %v, want to include Asia/Kolkata", info.Aliases)
+	}
+
+	info, err = passageoftime.GetZoneInfo("Europe/Moscow", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), from, to)
+	if err != nil {
+		t.Fatalf("GetZoneInfo() error = %v", err)
+	}
+	if info.Report.ObservesDST || info.LastDSTEnd == nil || info.LastDSTEnd.At.Year() != 2010 {
+		t.Errorf("Europe/Moscow last DST end = %+v, want October 2010", info.LastDSTEnd)
+	}
+	if info.TzdataVersion == "" || info.TzdataSource == "" {
+		t.Error("GetZoneInfo() should report the tzdata version and source")
+	}
+}
+
+// TestHandleTimezoneInfo tests the timezone_info handler
+func TestHandleTimezoneInfo(t *testing.T) {
+	args := TimezoneInfoArgs{Timezone: "US/Eastern", At: "2025-07-04 12:00", HistoryStart: "2024-01-01", HistoryEnd: "2025-12-31"}
+	got, err := handleTimezoneInfo(context.Background(), nil, &mcp.CallToolParamsFor[TimezoneInfoArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleTimezoneInfo() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"canonical_id:America/New_York", "is_alias:true", "abbreviation:EDT", "offset:-04:00", "is_dst:true",
+		"transition_count:4", "offset_in_effect_since:2025-03-09T03:00:00-04:00", "tzdata_version:",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimezoneInfo() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args = TimezoneInfoArgs{Timezone: "America/New_York", At: "2025-11-02 01:30", AmbiguousTime: "later"}
+	got, err = handleTimezoneInfo(context.Background(), nil, &mcp.CallToolParamsFor[TimezoneInfoArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleTimezoneInfo() error = %v", err)
+	}
+	text = got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"ambiguous_local_time:true", "at:2025-11-02T01:30:00-05:00", "is_dst:false"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimezoneInfo() = %v, want to contain %v", text, want)
+		}
+	}
+
+	if _, err := handleTimezoneInfo(context.Background(), nil, &mcp.CallToolParamsFor[TimezoneInfoArgs]{Arguments: TimezoneInfoArgs{Timezone: "Mars/Olympus"}}); err == nil {
+		t.Error("handleTimezoneInfo() should reject an unknown timezone")
+	}
+}
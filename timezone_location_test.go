@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestGetTimezoneInfoLocation tests the country, coordinates and comment of zones and aliases
+func TestGetTimezoneInfoLocation(t *testing.T) {
+	tests := []struct {
+		id        string
+		name      string
+		countries string
+		latitude  float64
+		comment   string
+	}{
+		{"America/Denver", "Denver, United States", "US", 39.7392, "Mountain (most areas)"},
+		{"US/Pacific", "Los Angeles, United States", "US", 34.0522, "Pacific"},
+		{"Europe/Zurich", "Zurich, Switzerland", "CH,DE,LI", 47.3833, "Büsingen"},
+		{"America/St_Johns", "St Johns, Canada", "CA", 47.5667, "Newfoundland, Labrador (SE)"},
+		{"UTC", "UTC", "", 0, ""},
+	}
+	for _, tt := range tests {
+		info, err := passageoftime.GetTimezoneInfo(tt.id, time.Now())
+		if err != nil {
+			t.Errorf("GetTimezoneInfo(%s) error = %v", tt.id, err)
+			continue
+		}
+		if info.Name != tt.name || strings.Join(info.CountryCodes, ",") != tt.countries || info.Latitude != tt.latitude || info.Comment != tt.comment {
+			t.Errorf("GetTimezoneInfo(%s) = %+v", tt.id, info)
+		}
+	}
+
+	if _, err := passageoftime.GetCountryTimezoneIDs("XX"); err == nil {
+		t.Error("GetCountryTimezoneIDs() should reject an unknown country code")
+	}
+}
+
+// TestHandleListTimezonesCountry tests filtering list_timezones by country code
+func TestHandleListTimezonesCountry(t *testing.T) {
+	args := ListTimezonesArgs{Country: "de"}
+	got, err := handleListTimezones(context.Background(), nil, &mcp.CallToolParamsFor[ListTimezonesArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleListTimezones() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"id:Europe/Berlin", "name:Berlin, Germany", "countries:[DE]", "latitude:52.5", "id:Europe/Busingen", "total_filtered:3", "country:DE"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleListTimezones() = %v, want to contain %v", text, want)
+		}
+	}
+
+	args = ListTimezonesArgs{Filter: "Mountain", Country: "US"}
+	got, err = handleListTimezones(context.Background(), nil, &mcp.CallToolParamsFor[ListTimezonesArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleListTimezones() error = %v", err)
+	}
+	if text := got.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "id:America/Denver") || strings.Contains(text, "Canada") {
+		t.Errorf("handleListTimezones() = %v, want only US zones", text)
+	}
+
+	if _, err := handleListTimezones(context.Background(), nil, &mcp.CallToolParamsFor[ListTimezonesArgs]{Arguments: ListTimezonesArgs{Country: "ZZ"}}); err == nil {
+		t.Error("handleListTimezones() should reject an unknown country code")
+	}
+}
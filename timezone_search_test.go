@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestSearchTimezones tests city, country, abbreviation, Windows and misspelled searches
+func TestSearchTimezones(t *testing.T) {
+	tests := []struct {
+		query  string
+		want   string
+		reason string
+	}{
+		{"Mumbai", "Asia/Kolkata", `city "Mumbai"`},
+		{"Germany", "Europe/Berlin", "country Germany (DE)"},
+		{"PST", "America/Los_Angeles", "abbreviation PST"},
+		{"Eastern Standard Time", "America/New_York", `Windows zone "Eastern Standard Time"`},
+		{"new yrok", "America/New_York", `city "New York" (1 typo)`},
+		{"london", "Europe/London", `city "London"`},
+		{"United Kingdom", "Europe/London", "country Britain (UK) (GB)"},
+		{"Europe/Paris", "Europe/Paris", `ID "Europe/Paris"`},
+	}
+	for _, tt := range tests {
+		matches := passageoftime.SearchTimezones(tt.query)
+		if len(matches) == 0 {
+			t.Errorf("SearchTimezones(%q) found nothing", tt.query)
+			continue
+		}
+		if matches[0].ID != tt.want || matches[0].Reasons[0] != tt.reason {
+			t.Errorf("SearchTimezones(%q)[0] = %+v, want %s because %s", tt.query, matches[0], tt.want, tt.reason)
+		}
+	}
+
+	if matches := passageoftime.SearchTimezones("Germany"); matches[len(matches)-1].Score >= matches[0].Score {
+		t.Errorf("SearchTimezones(Germany) should rank zones shared with other countries lower: %+v", matches)
+	}
+	if matches := passageoftime.SearchTimezones("xqzv"); len(matches) != 0 {
+		t.Errorf("SearchTimezones(xqzv) = %+v, want no matches", matches)
+	}
+}
+
+// TestHandleListTimezonesSearch tests that list_timezones reports scores and match reasons
+func TestHandleListTimezonesSearch(t *testing.T) {
+	args := ListTimezonesArgs{Filter: "Mumbai"}
+	got, err := handleListTimezones(context.Background(), nil, &mcp.CallToolParamsFor[ListTimezonesArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleListTimezones() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"id:Asia/Kolkata", "score:0.9", `match_reasons:[city "Mumbai"]`, "total_filtered:1"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleListTimezones() = %v, want to contain %v", text, want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestGetZoneTransitions tests transition discovery and gap/overlap classification
+func TestGetZoneTransitions(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reference := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		zone         string
+		observesDST  bool
+		want         []string // "RFC3339 kind"
+		ruleContains string
+	}{
+		{
+			zone:         "America/New_York",
+			observesDST:  true,
+			want:         []string{"2025-03-09T07:00:00Z gap", "2025-11-02T06:00:00Z overlap"},
+			ruleContains: "second Sunday of March at 02:00",
+		},
+		{
+			zone:         "Europe/Berlin",
+			observesDST:  true,
+			want:         []string{"2025-03-30T01:00:00Z gap", "2025-10-26T01:00:00Z overlap"},
+			ruleContains: "last Sunday of October at 03:00",
+		},
+		{
+			zone:         "Australia/Lord_Howe",
+			observesDST:  true,
+			want:         []string{"2025-04-05T15:00:00Z overlap", "2025-10-04T15:30:00Z gap"},
+			ruleContains: "+11:00",
+		},
+		{
+			zone:         "Asia/Tokyo",
+			observesDST:  false,
+			want:         nil,
+			ruleContains: "No DST; JST (+09:00) all year",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			report, err := passageoftime.GetZoneTransitions(tt.zone, from, to, reference)
+			if err != nil {
+				t.Fatalf("GetZoneTransitions() error = %v", err)
+			}
+			if report.ObservesDST != tt.observesDST {
+				t.Errorf("ObservesDST = %v, want %v", report.ObservesDST, tt.observesDST)
+			}
+			if !strings.Contains(report.CurrentRule, tt.ruleContains) {
+				t.Errorf("CurrentRule = %q, want to contain %q", report.CurrentRule, tt.ruleContains)
+			}
+
+			var got []string
+			for _, tr := range report.Transitions {
+				got = append(got, tr.At.UTC().Format(time.RFC3339)+" "+tr.Kind())
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("transitions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestZoneTransitionWallClock tests the reported local gap and overlap
+func TestZoneTransitionWallClock(t *testing.T) {
+	report, err := passageoftime.GetZoneTransitions("America/New_York",
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Now())
+	if err != nil {
+		t.Fatalf("GetZoneTransitions() error = %v", err)
+	}
+
+	spring, fall := report.Transitions[0], report.Transitions[1]
+	if spring.LocalBefore.Format("15:04") != "02:00" || spring.LocalAfter.Format("15:04 MST") != "03:00 EDT" {
+		t.Errorf("spring forward wall clock = %s -> %s", spring.LocalBefore.Format("15:04"), spring.LocalAfter.Format("15:04 MST"))
+	}
+	if spring.Change() != time.Hour || spring.AbbreviationBefore != "EST" || spring.AbbreviationAfter != "EDT" {
+		t.Errorf("spring forward = %+v", spring)
+	}
+	if !strings.Contains(fall.Description(), "from 2025-11-02 01:00 to 02:00 occur twice") {
+		t.Errorf("fall back description = %s", fall.Description())
+	}
+
+	if _, err := passageoftime.GetZoneTransitions("Invalid/Zone", time.Now(), time.Now(), time.Now()); err == nil {
+		t.Error("GetZoneTransitions() should reject unknown timezones")
+	}
+}
+
+// TestHandleTimezoneTransitions tests the timezone_transitions handler
+func TestHandleTimezoneTransitions(t *testing.T) {
+	params := &mcp.CallToolParamsFor[TimezoneTransitionsArgs]{
+		Arguments: TimezoneTransitionsArgs{
+			Timezone: "Europe/London",
+			Start:    "2025-01-01",
+			End:      "2025-12-31",
+		},
+	}
+
+	got, err := handleTimezoneTransitions(context.Background(), nil, params)
+	if err != nil {
+		t.Fatalf("handleTimezoneTransitions() error = %v", err)
+	}
+
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"transition_count:2", "abbreviation_after:BST", "kind:gap", "kind:overlap", "change_minutes:60", "observes_dst:true"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleTimezoneTransitions() = %v, want to contain %v", text, want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestWindowsToIANAZone tests territory-aware Windows to IANA conversion
+func TestWindowsToIANAZone(t *testing.T) {
+	tests := []struct {
+		windows   string
+		territory string
+		want      string
+		used      string
+	}{
+		{"Romance Standard Time", "", "Europe/Paris", "001"},
+		{"Romance Standard Time", "BE", "Europe/Brussels", "BE"},
+		{"pacific standard time", "ca", "America/Vancouver", "CA"},
+		{"W. Europe Standard Time", "CH", "Europe/Zurich", "CH"},
+		{"Eastern Standard Time", "JP", "America/New_York", "001"},
+		{"UTC-11", "ZZ", "Etc/GMT+11", "ZZ"},
+	}
+	for _, tt := range tests {
+		got, err := passageoftime.WindowsToIANAZone(tt.windows, tt.territory)
+		if err != nil {
+			t.Errorf("WindowsToIANAZone(%q, %q) error = %v", tt.windows, tt.territory, err)
+			continue
+		}
+		if got.IANA != tt.want || got.Territory != tt.used {
+			t.Errorf("WindowsToIANAZone(%q, %q) = %s in %s, want %s in %s", tt.windows, tt.territory, got.IANA, got.Territory, tt.want, tt.used)
+		}
+	}
+
+	if _, err := passageoftime.WindowsToIANAZone("Mars Standard Time", ""); err == nil {
+		t.Error("WindowsToIANAZone() should reject an unknown Windows ID")
+	}
+}
+
+// TestIANAToWindowsZone tests IANA to Windows conversion, including aliases CLDR spells differently
+func TestIANAToWindowsZone(t *testing.T) {
+	tests := []struct {
+		iana      string
+		country   string
+		windows   string
+		territory string
+	}{
+		{"Europe/Berlin", "", "W. Europe Standard Time", "DE"},
+		{"Europe/Brussels", "", "Romance Standard Time", "BE"},
+		{"Asia/Kolkata", "", "India Standard Time", "IN"},
+		{"America/Indiana/Indianapolis", "", "US Eastern Standard Time", "US"},
+		{"US/Pacific", "", "Pacific Standard Time", "US"},
+		{"Etc/GMT-3", "", "E. Africa Standard Time", "ZZ"},
+		{"Europe/Berlin", "de", "W. Europe Standard Time", "DE"},
+		{"Europe/Berlin", "SJ", "W. Europe Standard Time", "SJ"},
+	}
+	for _, tt := range tests {
+		got, err := passageoftime.IANAToWindowsZone(tt.iana, tt.country)
+		if err != nil {
+			t.Errorf("IANAToWindowsZone(%s, %q) error = %v", tt.iana, tt.country, err)
+			continue
+		}
+		if got.Windows != tt.windows || got.Territory != tt.territory {
+			t.Errorf("IANAToWindowsZone(%s, %q) = %s in %s, want %s in %s", tt.iana, tt.country, got.Windows, got.Territory, tt.windows, tt.territory)
+		}
+	}
+
+	if _, err := passageoftime.IANAToWindowsZone("Europe/Berlin", "FR"); err == nil || !strings.Contains(err.Error(), "in territory FR") {
+		t.Errorf("IANAToWindowsZone(Europe/Berlin, FR) error = %v, want a territory mismatch", err)
+	}
+	if _, err := passageoftime.IANAToWindowsZone("Europe/Berlin", "XX"); err == nil {
+		t.Error("IANAToWindowsZone() should reject an unknown territory")
+	}
+}
+
+// TestHandleConvertZoneID tests the convert_zone_id handler in both directions
+func TestHandleConvertZoneID(t *testing.T) {
+	tests := []struct {
+		args ConvertZoneIDArgs
+		want []string
+	}{
+		{ConvertZoneIDArgs{ZoneID: "India Standard Time"}, []string{"direction:windows_to_iana", "iana:Asia/Calcutta", "canonical:Asia/Kolkata"}},
+		{ConvertZoneIDArgs{ZoneID: "Central Standard Time", Territory: "MX"}, []string{"iana:America/Matamoros", "territory:MX", "territory_name:Mexico"}},
+		{ConvertZoneIDArgs{ZoneID: "Tokyo Standard Time", Territory: "FR"}, []string{"iana:Asia/Tokyo", "territory:001", "note:CLDR has no Tokyo Standard Time entry for territory FR"}},
+		{ConvertZoneIDArgs{ZoneID: "Europe/Lisbon"}, []string{"direction:iana_to_windows", "windows:GMT Standard Time", "territory:PT"}},
+		{ConvertZoneIDArgs{ZoneID: "Europe/Berlin", Territory: "DE"}, []string{"windows:W. Europe Standard Time", "territory:DE", "territory_name:Germany"}},
+	}
+	for _, tt := range tests {
+		got, err := handleConvertZoneID(context.Background(), nil, &mcp.CallToolParamsFor[ConvertZoneIDArgs]{Arguments: tt.args})
+		if err != nil {
+			t.Errorf("handleConvertZoneID(%+v) error = %v", tt.args, err)
+			continue
+		}
+		text := got.Content[0].(*mcp.TextContent).Text
+		for _, want := range tt.want {
+			if !strings.Contains(text, want) {
+				t.Errorf("handleConvertZoneID(%+v) = %v, want to contain %v", tt.args, text, want)
+			}
+		}
+	}
+
+	for _, args := range []ConvertZoneIDArgs{
+		{ZoneID: "Europe/Atlantis"},
+		{ZoneID: "Europe/Berlin", Territory: "FR"},
+		{ZoneID: "Europe/Berlin", Direction: "sideways"},
+		{ZoneID: "Europe/Berlin", Direction: "windows_to_iana"},
+	} {
+		if _, err := handleConvertZoneID(context.Background(), nil, &mcp.CallToolParamsFor[ConvertZoneIDArgs]{Arguments: args}); err == nil {
+			t.Errorf("handleConvertZoneID(%+v) should fail", args)
+		}
+	}
+}
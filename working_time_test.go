@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justcfx2u/passage-of-time-mcp-go/passageoftime"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// TestWorkingTimeBetween tests working time across a weekend, a holiday and lunch breaks
+func TestWorkingTimeBetween(t *testing.T) {
+	loc, _ := time.LoadLocation("Europe/London")
+	hours, err := passageoftime.ParseWorkingHours([]string{"mon-fri 09:00-17:00", "fri 09:00-13:00"})
+	if err != nil {
+		t.Fatalf("ParseWorkingHours() error = %v", err)
+	}
+	lunch, _ := passageoftime.ParseClockRange("12:00-13:00")
+
+	// Thursday 2025-03-06 15:00 to Tuesday 2025-03-11 10:30, with Monday off
+	working, err := passageoftime.WorkingTimeBetween(passageoftime.WorkingTimeOptions{
+		Start:    time.Date(2025, 3, 6, 15, 0, 0, 0, loc),
+		End:      time.Date(2025, 3, 11, 10, 30, 0, 0, loc),
+		Location: loc,
+		Hours:    hours,
+		Breaks:   []passageoftime.ClockRange{lunch},
+		Holidays: []time.Time{time.Date(2025, 3, 10, 0, 0, 0, 0, loc)},
+	})
+	if err != nil {
+		t.Fatalf("WorkingTimeBetween() error = %v", err)
+	}
+
+	// Thursday 2h, Friday 3h (09-12), Monday holiday, Tuesday 1.5h
+	if want := 6*time.Hour + 30*time.Minute; working.Total != want {
+		t.Errorf("Total = %v, want %v", working.Total, want)
+	}
+	if len(working.Days) != 4 || !working.Days[2].Holiday || working.Days[1].Worked != 3*time.Hour {
+		t.Errorf("Days = %+v, want Thu, Fri (3h), Mon (holiday), Tue", working.Days)
+	}
+
+	// A night shift that crosses midnight counts towards the day it starts
+	night, _ := passageoftime.ParseWorkingHours([]string{"daily 22:00-06:00"})
+	working, err = passageoftime.WorkingTimeBetween(passageoftime.WorkingTimeOptions{
+		Start: time.Date(2025, 3, 6, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC),
+		Hours: night,
+	})
+	if err != nil {
+		t.Fatalf("WorkingTimeBetween() error = %v", err)
+	}
+	if working.Total != 8*time.Hour || working.Days[0].Date.Day() != 5 || working.Days[0].Worked != 6*time.Hour {
+		t.Errorf("night shift = %v over %+v, want 8h with 6h from the 5th", working.Total, working.Days)
+	}
+
+	if _, err := passageoftime.ParseWorkingHours([]string{"someday 09:00-17:00"}); err == nil {
+		t.Error("ParseWorkingHours() should reject an unknown weekday")
+	}
+}
+
+// TestHandleWorkingTimeBetween tests the working_time_between handler
+func TestHandleWorkingTimeBetween(t *testing.T) {
+	args := WorkingTimeBetweenArgs{
+		Start:    "2025-03-07 16:00",
+		End:      "2025-03-10 10:00",
+		Timezone: "America/New_York",
+		Breaks:   []string{"12:00-13:00"},
+	}
+	got, err := handleWorkingTimeBetween(context.Background(), nil, &mcp.CallToolParamsFor[WorkingTimeBetweenArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleWorkingTimeBetween() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"working_seconds:7200", "working_hours:2", "elapsed:2d 17h" /* clocks went forward on the 9th */, "weekday:Friday", "periods:[16:00-17:00]",
+		"schedule:[Monday 09:00-17:00", "breaks:[12:00-13:00]",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleWorkingTimeBetween() = %v, want to contain %v", text, want)
+		}
+	}
+}
+
+// TestHandleWorkingTimeBetweenLocalTime tests that a start in the DST gap is flagged
+func TestHandleWorkingTimeBetweenLocalTime(t *testing.T) {
+	args := WorkingTimeBetweenArgs{
+		Start:    "2025-03-09 02:30",
+		End:      "2025-03-10 10:00",
+		Timezone: "America/New_York",
+	}
+	got, err := handleWorkingTimeBetween(context.Background(), nil, &mcp.CallToolParamsFor[WorkingTimeBetweenArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("handleWorkingTimeBetween() error = %v", err)
+	}
+	text := got.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"nonexistent_local_time:true", "start:2025-03-09T03:30:00-04:00", "working_seconds:3600"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("handleWorkingTimeBetween() = %v, want to contain %v", text, want)
+		}
+	}
+	if strings.Contains(text, "ambiguous_local_time") {
+		t.Errorf("handleWorkingTimeBetween() = %v, want no ambiguous_local_time", text)
+	}
+}